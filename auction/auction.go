@@ -1,6 +1,7 @@
 package auction
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -35,85 +36,106 @@ type LotDetails struct {
 	GGPRecovered        bool     `json:"ggpRecovered"`
 }
 
+// The result of a mined lot creation
+type CreateLotResult struct {
+	gogopool.TransactionResult
+	LotIndex uint64 `json:"lotIndex"`
+}
+
+// The result of a mined bid
+type PlaceBidResult struct {
+	gogopool.TransactionResult
+	LotIndex  uint64   `json:"lotIndex"`
+	BidAmount *big.Int `json:"bidAmount"`
+}
+
 // Get all lot details
 func GetLots(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]LotDetails, error) {
+	return GetLotsContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLotsContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]LotDetails, error) {
 
 	// Get lot count
-	lotCount, err := GetLotCount(ggp, opts)
+	lotCount, err := GetLotCountContext(ctx, ggp, opts)
 	if err != nil {
 		return []LotDetails{}, err
 	}
 
-	// Load lot details in batches
-	details := make([]LotDetails, lotCount)
-	for bsi := uint64(0); bsi < lotCount; bsi += LotDetailsBatchSize {
+	// Load lot details
+	return loadLotDetails(ctx, ggp, lotCount, nil, opts)
 
-		// Get batch start & end index
-		lsi := bsi
-		lei := bsi + LotDetailsBatchSize
-		if lei > lotCount {
-			lei = lotCount
-		}
+}
 
-		// Load details
-		var wg errgroup.Group
-		for li := lsi; li < lei; li++ {
-			li := li
-			wg.Go(func() error {
-				lotDetails, err := GetLotDetails(ggp, li, opts)
-				if err == nil {
-					details[li] = lotDetails
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
-			return []LotDetails{}, err
-		}
+// Get all lot details with bids from an address
+func GetLotsWithBids(ggp *gogopool.GoGoPool, bidder common.Address, opts *bind.CallOpts) ([]LotDetails, error) {
+	return GetLotsWithBidsContext(gogopool.CallOptsContext(opts), ggp, bidder, opts)
+}
+func GetLotsWithBidsContext(ctx context.Context, ggp *gogopool.GoGoPool, bidder common.Address, opts *bind.CallOpts) ([]LotDetails, error) {
 
+	// Get lot count
+	lotCount, err := GetLotCountContext(ctx, ggp, opts)
+	if err != nil {
+		return []LotDetails{}, err
 	}
 
-	// Return
-	return details, nil
+	// Load lot details
+	return loadLotDetails(ctx, ggp, lotCount, &bidder, opts)
 
 }
 
-// Get all lot details with bids from an address
-func GetLotsWithBids(ggp *gogopool.GoGoPool, bidder common.Address, opts *bind.CallOpts) ([]LotDetails, error) {
-
-	// Get lot count
-	lotCount, err := GetLotCount(ggp, opts)
+// Load the details of all lots through a multicaller, including address bid amounts if a bidder is given
+func loadLotDetails(ctx context.Context, ggp *gogopool.GoGoPool, lotCount uint64, bidder *common.Address, opts *bind.CallOpts) ([]LotDetails, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return []LotDetails{}, err
 	}
 
-	// Load lot details in batches
+	// Queue lot detail calls
 	details := make([]LotDetails, lotCount)
-	for bsi := uint64(0); bsi < lotCount; bsi += LotDetailsBatchSize {
-
-		// Get batch start & end index
-		lsi := bsi
-		lei := bsi + LotDetailsBatchSize
-		if lei > lotCount {
-			lei = lotCount
+	startBlocks := make([]*big.Int, lotCount)
+	endBlocks := make([]*big.Int, lotCount)
+	mc := ggp.NewMultiCaller()
+	for li := uint64(0); li < lotCount; li++ {
+		lotIndex := big.NewInt(int64(li))
+		calls := []struct {
+			result interface{}
+			method string
+		}{
+			{&details[li].Exists, "getLotExists"},
+			{&startBlocks[li], "getLotStartBlock"},
+			{&endBlocks[li], "getLotEndBlock"},
+			{&details[li].StartPrice, "getLotStartPrice"},
+			{&details[li].ReservePrice, "getLotReservePrice"},
+			{&details[li].PriceAtCurrentBlock, "getLotPriceAtCurrentBlock"},
+			{&details[li].PriceByTotalBids, "getLotPriceByTotalBids"},
+			{&details[li].CurrentPrice, "getLotCurrentPrice"},
+			{&details[li].TotalGGPAmount, "getLotTotalGGPAmount"},
+			{&details[li].ClaimedGGPAmount, "getLotClaimedGGPAmount"},
+			{&details[li].RemainingGGPAmount, "getLotRemainingGGPAmount"},
+			{&details[li].TotalBidAmount, "getLotTotalBidAmount"},
+			{&details[li].Cleared, "getLotIsCleared"},
+			{&details[li].GGPRecovered, "getLotGGPRecovered"},
 		}
-
-		// Load details
-		var wg errgroup.Group
-		for li := lsi; li < lei; li++ {
-			li := li
-			wg.Go(func() error {
-				lotDetails, err := GetLotDetailsWithBids(ggp, li, bidder, opts)
-				if err == nil {
-					details[li] = lotDetails
-				}
-				return err
-			})
+		for _, call := range calls {
+			if err := mc.AddCall(gogoAuctionManager, call.result, call.method, lotIndex); err != nil {
+				return []LotDetails{}, err
+			}
 		}
-		if err := wg.Wait(); err != nil {
-			return []LotDetails{}, err
+		if bidder != nil {
+			if err := mc.AddCall(gogoAuctionManager, &details[li].AddressBidAmount, "getLotAddressBidAmount", lotIndex, *bidder); err != nil {
+				return []LotDetails{}, err
+			}
 		}
+	}
 
+	// Load details
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []LotDetails{}, fmt.Errorf("Could not get lot details: %w", err)
+	}
+	for li := range details {
+		details[li].Index = uint64(li)
+		details[li].StartBlock = startBlocks[li].Uint64()
+		details[li].EndBlock = endBlocks[li].Uint64()
 	}
 
 	// Return
@@ -123,6 +145,9 @@ func GetLotsWithBids(ggp *gogopool.GoGoPool, bidder common.Address, opts *bind.C
 
 // Get a lot's details
 func GetLotDetails(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (LotDetails, error) {
+	return GetLotDetailsContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotDetailsContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (LotDetails, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -144,72 +169,72 @@ func GetLotDetails(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts)
 	// Load data
 	wg.Go(func() error {
 		var err error
-		exists, err = GetLotExists(ggp, lotIndex, opts)
+		exists, err = GetLotExistsContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		startBlock, err = GetLotStartBlock(ggp, lotIndex, opts)
+		startBlock, err = GetLotStartBlockContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		endBlock, err = GetLotEndBlock(ggp, lotIndex, opts)
+		endBlock, err = GetLotEndBlockContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		startPrice, err = GetLotStartPrice(ggp, lotIndex, opts)
+		startPrice, err = GetLotStartPriceContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		reservePrice, err = GetLotReservePrice(ggp, lotIndex, opts)
+		reservePrice, err = GetLotReservePriceContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		priceAtCurrentBlock, err = GetLotPriceAtCurrentBlock(ggp, lotIndex, opts)
+		priceAtCurrentBlock, err = GetLotPriceAtCurrentBlockContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		priceByTotalBids, err = GetLotPriceByTotalBids(ggp, lotIndex, opts)
+		priceByTotalBids, err = GetLotPriceByTotalBidsContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		currentPrice, err = GetLotCurrentPrice(ggp, lotIndex, opts)
+		currentPrice, err = GetLotCurrentPriceContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		totalGgpAmount, err = GetLotTotalGGPAmount(ggp, lotIndex, opts)
+		totalGgpAmount, err = GetLotTotalGGPAmountContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		claimedGgpAmount, err = GetLotClaimedGGPAmount(ggp, lotIndex, opts)
+		claimedGgpAmount, err = GetLotClaimedGGPAmountContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		remainingGgpAmount, err = GetLotRemainingGGPAmount(ggp, lotIndex, opts)
+		remainingGgpAmount, err = GetLotRemainingGGPAmountContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		totalBidAmount, err = GetLotTotalBidAmount(ggp, lotIndex, opts)
+		totalBidAmount, err = GetLotTotalBidAmountContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		cleared, err = GetLotIsCleared(ggp, lotIndex, opts)
+		cleared, err = GetLotIsClearedContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		ggpRecovered, err = GetLotGGPRecovered(ggp, lotIndex, opts)
+		ggpRecovered, err = GetLotGGPRecoveredContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 
@@ -241,6 +266,9 @@ func GetLotDetails(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts)
 
 // Get a lot's details with address bid amounts
 func GetLotDetailsWithBids(ggp *gogopool.GoGoPool, lotIndex uint64, bidder common.Address, opts *bind.CallOpts) (LotDetails, error) {
+	return GetLotDetailsWithBidsContext(gogopool.CallOptsContext(opts), ggp, lotIndex, bidder, opts)
+}
+func GetLotDetailsWithBidsContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, bidder common.Address, opts *bind.CallOpts) (LotDetails, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -250,12 +278,12 @@ func GetLotDetailsWithBids(ggp *gogopool.GoGoPool, lotIndex uint64, bidder commo
 	// Load data
 	wg.Go(func() error {
 		var err error
-		details, err = GetLotDetails(ggp, lotIndex, opts)
+		details, err = GetLotDetailsContext(ctx, ggp, lotIndex, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		addressBidAmount, err = GetLotAddressBidAmount(ggp, lotIndex, bidder, opts)
+		addressBidAmount, err = GetLotAddressBidAmountContext(ctx, ggp, lotIndex, bidder, opts)
 		return err
 	})
 
@@ -272,12 +300,15 @@ func GetLotDetailsWithBids(ggp *gogopool.GoGoPool, lotIndex uint64, bidder commo
 
 // Get the total GGP balance of the auction contract
 func GetTotalGGPBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetTotalGGPBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTotalGGPBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	totalGgpBalance := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, totalGgpBalance, "getTotalGGPBalance"); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, totalGgpBalance, "getTotalGGPBalance"); err != nil {
 		return nil, fmt.Errorf("Could not get auction contract total GGP balance: %w", err)
 	}
 	return *totalGgpBalance, nil
@@ -285,12 +316,15 @@ func GetTotalGGPBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int,
 
 // Get the allotted GGP balance of the auction contract
 func GetAllottedGGPBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetAllottedGGPBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetAllottedGGPBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	allottedGgpBalance := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, allottedGgpBalance, "getAllottedGGPBalance"); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, allottedGgpBalance, "getAllottedGGPBalance"); err != nil {
 		return nil, fmt.Errorf("Could not get auction contract allotted GGP balance: %w", err)
 	}
 	return *allottedGgpBalance, nil
@@ -298,12 +332,15 @@ func GetAllottedGGPBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.In
 
 // Get the remaining GGP balance of the auction contract
 func GetRemainingGGPBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetRemainingGGPBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetRemainingGGPBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	remainingGgpBalance := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, remainingGgpBalance, "getRemainingGGPBalance"); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, remainingGgpBalance, "getRemainingGGPBalance"); err != nil {
 		return nil, fmt.Errorf("Could not get auction contract remaining GGP balance: %w", err)
 	}
 	return *remainingGgpBalance, nil
@@ -311,12 +348,15 @@ func GetRemainingGGPBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.I
 
 // Get the number of lots for auction
 func GetLotCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotCountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLotCountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	lotCount := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotCount, "getLotCount"); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotCount, "getLotCount"); err != nil {
 		return 0, fmt.Errorf("Could not get lot count: %w", err)
 	}
 	return (*lotCount).Uint64(), nil
@@ -324,155 +364,197 @@ func GetLotCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
 
 // Lot details
 func GetLotExists(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (bool, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotExistsContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotExistsContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (bool, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	lotExists := new(bool)
-	if err := gogoAuctionManager.Call(opts, lotExists, "getLotExists", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotExists, "getLotExists", big.NewInt(int64(lotIndex))); err != nil {
 		return false, fmt.Errorf("Could not get lot %d exists status: %w", lotIndex, err)
 	}
 	return *lotExists, nil
 }
 func GetLotStartBlock(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (uint64, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotStartBlockContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotStartBlockContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (uint64, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	lotStartBlock := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotStartBlock, "getLotStartBlock", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotStartBlock, "getLotStartBlock", big.NewInt(int64(lotIndex))); err != nil {
 		return 0, fmt.Errorf("Could not get lot %d start block: %w", lotIndex, err)
 	}
 	return (*lotStartBlock).Uint64(), nil
 }
 func GetLotEndBlock(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (uint64, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotEndBlockContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotEndBlockContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (uint64, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	lotEndBlock := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotEndBlock, "getLotEndBlock", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotEndBlock, "getLotEndBlock", big.NewInt(int64(lotIndex))); err != nil {
 		return 0, fmt.Errorf("Could not get lot %d end block: %w", lotIndex, err)
 	}
 	return (*lotEndBlock).Uint64(), nil
 }
 func GetLotStartPrice(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotStartPriceContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotStartPriceContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotStartPrice := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotStartPrice, "getLotStartPrice", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotStartPrice, "getLotStartPrice", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d start price: %w", lotIndex, err)
 	}
 	return *lotStartPrice, nil
 }
 func GetLotReservePrice(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotReservePriceContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotReservePriceContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotReservePrice := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotReservePrice, "getLotReservePrice", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotReservePrice, "getLotReservePrice", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d reserve price: %w", lotIndex, err)
 	}
 	return *lotReservePrice, nil
 }
 func GetLotTotalGGPAmount(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotTotalGGPAmountContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotTotalGGPAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotTotalGgpAmount := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotTotalGgpAmount, "getLotTotalGGPAmount", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotTotalGgpAmount, "getLotTotalGGPAmount", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d total GGP amount: %w", lotIndex, err)
 	}
 	return *lotTotalGgpAmount, nil
 }
 func GetLotTotalBidAmount(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotTotalBidAmountContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotTotalBidAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotTotalBidAmount := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotTotalBidAmount, "getLotTotalBidAmount", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotTotalBidAmount, "getLotTotalBidAmount", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d total ETH bid amount: %w", lotIndex, err)
 	}
 	return *lotTotalBidAmount, nil
 }
 func GetLotGGPRecovered(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (bool, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotGGPRecoveredContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotGGPRecoveredContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (bool, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	lotGgpRecovered := new(bool)
-	if err := gogoAuctionManager.Call(opts, lotGgpRecovered, "getLotGGPRecovered", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotGgpRecovered, "getLotGGPRecovered", big.NewInt(int64(lotIndex))); err != nil {
 		return false, fmt.Errorf("Could not get lot %d GGP recovered status: %w", lotIndex, err)
 	}
 	return *lotGgpRecovered, nil
 }
 func GetLotPriceAtCurrentBlock(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotPriceAtCurrentBlockContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotPriceAtCurrentBlockContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotPriceAtCurrentBlock := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotPriceAtCurrentBlock, "getLotPriceAtCurrentBlock", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotPriceAtCurrentBlock, "getLotPriceAtCurrentBlock", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d price by current block: %w", lotIndex, err)
 	}
 	return *lotPriceAtCurrentBlock, nil
 }
 func GetLotPriceByTotalBids(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotPriceByTotalBidsContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotPriceByTotalBidsContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotPriceByTotalBids := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotPriceByTotalBids, "getLotPriceByTotalBids", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotPriceByTotalBids, "getLotPriceByTotalBids", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d price by total bids: %w", lotIndex, err)
 	}
 	return *lotPriceByTotalBids, nil
 }
 func GetLotCurrentPrice(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotCurrentPriceContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotCurrentPriceContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotCurrentPrice := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotCurrentPrice, "getLotCurrentPrice", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotCurrentPrice, "getLotCurrentPrice", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d current price: %w", lotIndex, err)
 	}
 	return *lotCurrentPrice, nil
 }
 func GetLotClaimedGGPAmount(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotClaimedGGPAmountContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotClaimedGGPAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotClaimedGgpAmount := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotClaimedGgpAmount, "getLotClaimedGGPAmount", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotClaimedGgpAmount, "getLotClaimedGGPAmount", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d claimed GGP amount: %w", lotIndex, err)
 	}
 	return *lotClaimedGgpAmount, nil
 }
 func GetLotRemainingGGPAmount(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotRemainingGGPAmountContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotRemainingGGPAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotRemainingGgpAmount := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotRemainingGgpAmount, "getLotRemainingGGPAmount", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotRemainingGgpAmount, "getLotRemainingGGPAmount", big.NewInt(int64(lotIndex))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d remaining GGP amount: %w", lotIndex, err)
 	}
 	return *lotRemainingGgpAmount, nil
 }
 func GetLotIsCleared(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (bool, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotIsClearedContext(gogopool.CallOptsContext(opts), ggp, lotIndex, opts)
+}
+func GetLotIsClearedContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpts) (bool, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	lotIsCleared := new(bool)
-	if err := gogoAuctionManager.Call(opts, lotIsCleared, "getLotIsCleared", big.NewInt(int64(lotIndex))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotIsCleared, "getLotIsCleared", big.NewInt(int64(lotIndex))); err != nil {
 		return false, fmt.Errorf("Could not get lot %d cleared status: %w", lotIndex, err)
 	}
 	return *lotIsCleared, nil
@@ -480,12 +562,15 @@ func GetLotIsCleared(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.CallOpt
 
 // Get the price of a lot at a specific block
 func GetLotPriceAtBlock(ggp *gogopool.GoGoPool, lotIndex, blockNumber uint64, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotPriceAtBlockContext(gogopool.CallOptsContext(opts), ggp, lotIndex, blockNumber, opts)
+}
+func GetLotPriceAtBlockContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex, blockNumber uint64, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lotPriceAtBlock := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lotPriceAtBlock, "getLotPriceAtBlock", big.NewInt(int64(lotIndex)), big.NewInt(int64(blockNumber))); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lotPriceAtBlock, "getLotPriceAtBlock", big.NewInt(int64(lotIndex)), big.NewInt(int64(blockNumber))); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d price at block: %w", lotIndex, err)
 	}
 	return *lotPriceAtBlock, nil
@@ -493,12 +578,15 @@ func GetLotPriceAtBlock(ggp *gogopool.GoGoPool, lotIndex, blockNumber uint64, op
 
 // Get the ETH amount bid on a lot by an address
 func GetLotAddressBidAmount(ggp *gogopool.GoGoPool, lotIndex uint64, bidder common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return GetLotAddressBidAmountContext(gogopool.CallOptsContext(opts), ggp, lotIndex, bidder, opts)
+}
+func GetLotAddressBidAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, bidder common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	lot := new(*big.Int)
-	if err := gogoAuctionManager.Call(opts, lot, "getLotAddressBidAmount", big.NewInt(int64(lotIndex)), bidder); err != nil {
+	if err := gogoAuctionManager.CallContext(ctx, opts, lot, "getLotAddressBidAmount", big.NewInt(int64(lotIndex)), bidder); err != nil {
 		return nil, fmt.Errorf("Could not get lot %d address ETH bid amount: %w", lotIndex, err)
 	}
 	return *lot, nil
@@ -506,68 +594,159 @@ func GetLotAddressBidAmount(ggp *gogopool.GoGoPool, lotIndex uint64, bidder comm
 
 // Estimate the gas of CreateLot
 func EstimateCreateLotGas(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return EstimateCreateLotGasContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func EstimateCreateLotGasContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoAuctionManager.GetTransactionGasInfo(opts, "createLot")
+	return gogoAuctionManager.GetTransactionGasInfoContext(ctx, opts, "createLot")
 }
 
 // Create a new lot
 func CreateLot(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return CreateLotContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func CreateLotContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
-	lotCount, err := GetLotCount(ggp, nil)
+	lotCount, err := GetLotCountContext(ctx, ggp, nil)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
-	hash, err := gogoAuctionManager.Transact(opts, "createLot")
+	hash, err := gogoAuctionManager.TransactContext(ctx, opts, "createLot")
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not create lot: %w", err)
 	}
 	return lotCount, hash, nil
 }
 
+// Create a new lot and wait for it to be mined
+// Returns the index of the new lot
+func CreateLotAndWait(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (CreateLotResult, error) {
+	return CreateLotAndWaitContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func CreateLotAndWaitContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (CreateLotResult, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
+	if err != nil {
+		return CreateLotResult{}, err
+	}
+	_, hash, err := CreateLotContext(ctx, ggp, opts)
+	if err != nil {
+		return CreateLotResult{}, err
+	}
+	txResult, err := gogopool.WaitForResultContext(ctx, ggp.Client, hash)
+	result := CreateLotResult{TransactionResult: txResult}
+	if err != nil {
+		return result, err
+	}
+	events, err := gogoAuctionManager.GetTransactionEventArgs(txResult.Receipt, "LotCreated")
+	if err != nil {
+		return result, fmt.Errorf("Could not get new lot index: %w", err)
+	}
+	lotIndex, ok := events[0]["lotIndex"].(*big.Int)
+	if !ok {
+		return result, fmt.Errorf("Could not get new lot index: invalid LotCreated event")
+	}
+	result.LotIndex = lotIndex.Uint64()
+	return result, nil
+}
+
 // Estimate the gas of PlaceBid
 func EstimatePlaceBidGas(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return EstimatePlaceBidGasContext(gogopool.TransactOptsContext(opts), ggp, lotIndex, opts)
+}
+func EstimatePlaceBidGasContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoAuctionManager.GetTransactionGasInfo(opts, "placeBid", big.NewInt(int64(lotIndex)))
+	return gogoAuctionManager.GetTransactionGasInfoContext(ctx, opts, "placeBid", big.NewInt(int64(lotIndex)))
 }
 
 // Place a bid on a lot
 func PlaceBid(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return PlaceBidContext(gogopool.TransactOptsContext(opts), ggp, lotIndex, opts)
+}
+func PlaceBidContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoAuctionManager.Transact(opts, "placeBid", big.NewInt(int64(lotIndex)))
+	hash, err := gogoAuctionManager.TransactContext(ctx, opts, "placeBid", big.NewInt(int64(lotIndex)))
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not place bid on lot %d: %w", lotIndex, err)
 	}
 	return hash, nil
 }
 
+// Place a bid on a lot and wait for it to be mined
+func PlaceBidAndWait(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (PlaceBidResult, error) {
+	return PlaceBidAndWaitContext(gogopool.TransactOptsContext(opts), ggp, lotIndex, opts)
+}
+func PlaceBidAndWaitContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (PlaceBidResult, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
+	if err != nil {
+		return PlaceBidResult{}, err
+	}
+	hash, err := PlaceBidContext(ctx, ggp, lotIndex, opts)
+	if err != nil {
+		return PlaceBidResult{}, err
+	}
+	txResult, err := gogopool.WaitForResultContext(ctx, ggp.Client, hash)
+	result := PlaceBidResult{TransactionResult: txResult, LotIndex: lotIndex}
+	if err != nil {
+		return result, err
+	}
+	events, err := gogoAuctionManager.GetTransactionEventArgs(txResult.Receipt, "BidPlaced")
+	if err != nil {
+		return result, fmt.Errorf("Could not get bid amount on lot %d: %w", lotIndex, err)
+	}
+	bidAmount, ok := events[0]["bidAmount"].(*big.Int)
+	if !ok {
+		return result, fmt.Errorf("Could not get bid amount on lot %d: invalid BidPlaced event", lotIndex)
+	}
+	result.BidAmount = bidAmount
+	return result, nil
+}
+
 // Estimate the gas of ClaimBid
 func EstimateClaimBidGas(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return EstimateClaimBidGasContext(gogopool.TransactOptsContext(opts), ggp, lotIndex, opts)
+}
+func EstimateClaimBidGasContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoAuctionManager.GetTransactionGasInfo(opts, "claimBid", big.NewInt(int64(lotIndex)))
+	return gogoAuctionManager.GetTransactionGasInfoContext(ctx, opts, "claimBid", big.NewInt(int64(lotIndex)))
+}
+
+// Simulate ClaimBid without sending it
+func SimulateClaimBid(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (*gogopool.SimulationResult, error) {
+	return SimulateClaimBidContext(gogopool.TransactOptsContext(opts), ggp, lotIndex, opts)
+}
+func SimulateClaimBidContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (*gogopool.SimulationResult, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoAuctionManager.SimulateContext(ctx, opts, "claimBid", big.NewInt(int64(lotIndex)))
 }
 
 // Claim GGP from a lot that was bid on
 func ClaimBid(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return ClaimBidContext(gogopool.TransactOptsContext(opts), ggp, lotIndex, opts)
+}
+func ClaimBidContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoAuctionManager.Transact(opts, "claimBid", big.NewInt(int64(lotIndex)))
+	hash, err := gogoAuctionManager.TransactContext(ctx, opts, "claimBid", big.NewInt(int64(lotIndex)))
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not claim bid from lot %d: %w", lotIndex, err)
 	}
@@ -576,20 +755,26 @@ func ClaimBid(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts)
 
 // Estimate the gas of RecoverUnclaimedGGP
 func EstimateRecoverUnclaimedGGPGas(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return EstimateRecoverUnclaimedGGPGasContext(gogopool.TransactOptsContext(opts), ggp, lotIndex, opts)
+}
+func EstimateRecoverUnclaimedGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoAuctionManager.GetTransactionGasInfo(opts, "recoverUnclaimedGGP", big.NewInt(int64(lotIndex)))
+	return gogoAuctionManager.GetTransactionGasInfoContext(ctx, opts, "recoverUnclaimedGGP", big.NewInt(int64(lotIndex)))
 }
 
 // Recover unclaimed GGP from a lot
 func RecoverUnclaimedGGP(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoAuctionManager, err := getGoGoAuctionManager(ggp)
+	return RecoverUnclaimedGGPContext(gogopool.TransactOptsContext(opts), ggp, lotIndex, opts)
+}
+func RecoverUnclaimedGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoAuctionManager, err := getGoGoAuctionManager(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoAuctionManager.Transact(opts, "recoverUnclaimedGGP", big.NewInt(int64(lotIndex)))
+	hash, err := gogoAuctionManager.TransactContext(ctx, opts, "recoverUnclaimedGGP", big.NewInt(int64(lotIndex)))
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not recover unclaimed GGP from lot %d: %w", lotIndex, err)
 	}
@@ -599,8 +784,8 @@ func RecoverUnclaimedGGP(ggp *gogopool.GoGoPool, lotIndex uint64, opts *bind.Tra
 // Get contracts
 var gogoAuctionManagerLock sync.Mutex
 
-func getGoGoAuctionManager(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoAuctionManager(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoAuctionManagerLock.Lock()
 	defer gogoAuctionManagerLock.Unlock()
-	return ggp.GetContract("rocketAuctionManager")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractAuctionManager))
 }
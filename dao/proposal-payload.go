@@ -1,6 +1,7 @@
 package dao
 
 import (
+    "context"
     "encoding/hex"
     "fmt"
     "strings"
@@ -17,13 +18,16 @@ import (
 var getProposalPayloadStringLock sync.Mutex
 
 func GetProposalPayloadString(ggp *gogopool.GoGoPool, daoName string, payload []byte) (string, error) {
+    return GetProposalPayloadStringContext(context.Background(), ggp, daoName, payload)
+}
+func GetProposalPayloadStringContext(ctx context.Context, ggp *gogopool.GoGoPool, daoName string, payload []byte) (string, error) {
 
     // Lock while getting proposal payload string
     getProposalPayloadStringLock.Lock()
     defer getProposalPayloadStringLock.Unlock()
 
     // Get proposal DAO contract ABI
-    daoContractAbi, err := ggp.GetABI(daoName)
+    daoContractAbi, err := ggp.GetABIContext(ctx, daoName)
     if err != nil {
         return "", fmt.Errorf("Could not get '%s' DAO contract ABI: %w", daoName, err)
     }
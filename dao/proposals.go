@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -45,169 +46,152 @@ type ProposalDetails struct {
 
 // Get all proposal details
 func GetProposals(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]ProposalDetails, error) {
+	return GetProposalsContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetProposalsContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]ProposalDetails, error) {
 
 	// Get proposal count
-	proposalCount, err := GetProposalCount(ggp, opts)
+	proposalCount, err := GetProposalCountContext(ctx, ggp, opts)
 	if err != nil {
 		return []ProposalDetails{}, err
 	}
 
-	// Load proposal details in batches
-	details := make([]ProposalDetails, proposalCount)
-	for bsi := uint64(0); bsi < proposalCount; bsi += ProposalDetailsBatchSize {
-
-		// Get batch start & end index
-		psi := bsi
-		pei := bsi + ProposalDetailsBatchSize
-		if pei > proposalCount {
-			pei = proposalCount
-		}
-
-		// Load details
-		var wg errgroup.Group
-		for pi := psi; pi < pei; pi++ {
-			pi := pi
-			wg.Go(func() error {
-				proposalDetails, err := GetProposalDetails(ggp, pi+1, opts) // Proposals are 1-indexed
-				if err == nil {
-					details[pi] = proposalDetails
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
-			return []ProposalDetails{}, err
-		}
-
+	// Load proposal details
+	proposalIds := make([]uint64, proposalCount)
+	for pi := uint64(0); pi < proposalCount; pi++ {
+		proposalIds[pi] = pi + 1 // Proposals are 1-indexed
 	}
-
-	// Return
-	return details, nil
+	return loadProposalDetails(ctx, ggp, proposalIds, nil, opts)
 
 }
 
 // Get all proposal details with member data
 func GetProposalsWithMember(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) ([]ProposalDetails, error) {
+	return GetProposalsWithMemberContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetProposalsWithMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) ([]ProposalDetails, error) {
 
 	// Get proposal count
-	proposalCount, err := GetProposalCount(ggp, opts)
+	proposalCount, err := GetProposalCountContext(ctx, ggp, opts)
 	if err != nil {
 		return []ProposalDetails{}, err
 	}
 
-	// Load proposal details in batches
-	details := make([]ProposalDetails, proposalCount)
-	for bsi := uint64(0); bsi < proposalCount; bsi += ProposalDetailsBatchSize {
-
-		// Get batch start & end index
-		psi := bsi
-		pei := bsi + ProposalDetailsBatchSize
-		if pei > proposalCount {
-			pei = proposalCount
-		}
-
-		// Load details
-		var wg errgroup.Group
-		for pi := psi; pi < pei; pi++ {
-			pi := pi
-			wg.Go(func() error {
-				proposalDetails, err := GetProposalDetailsWithMember(ggp, pi+1, memberAddress, opts) // Proposals are 1-indexed
-				if err == nil {
-					details[pi] = proposalDetails
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
-			return []ProposalDetails{}, err
-		}
-
+	// Load proposal details
+	proposalIds := make([]uint64, proposalCount)
+	for pi := uint64(0); pi < proposalCount; pi++ {
+		proposalIds[pi] = pi + 1 // Proposals are 1-indexed
 	}
-
-	// Return
-	return details, nil
+	return loadProposalDetails(ctx, ggp, proposalIds, &memberAddress, opts)
 
 }
 
 // Get DAO proposal details
 func GetDAOProposals(ggp *gogopool.GoGoPool, daoName string, opts *bind.CallOpts) ([]ProposalDetails, error) {
+	return GetDAOProposalsContext(gogopool.CallOptsContext(opts), ggp, daoName, opts)
+}
+func GetDAOProposalsContext(ctx context.Context, ggp *gogopool.GoGoPool, daoName string, opts *bind.CallOpts) ([]ProposalDetails, error) {
 
 	// Get DAO proposal IDs
-	proposalIds, err := GetDAOProposalIDs(ggp, daoName, opts)
+	proposalIds, err := GetDAOProposalIDsContext(ctx, ggp, daoName, opts)
 	if err != nil {
 		return []ProposalDetails{}, err
 	}
 
-	// Load proposal details in batches
-	details := make([]ProposalDetails, len(proposalIds))
-	for bsi := 0; bsi < len(proposalIds); bsi += ProposalDetailsBatchSize {
+	// Load proposal details
+	return loadProposalDetails(ctx, ggp, proposalIds, nil, opts)
 
-		// Get batch start & end index
-		psi := bsi
-		pei := bsi + ProposalDetailsBatchSize
-		if pei > len(proposalIds) {
-			pei = len(proposalIds)
-		}
+}
 
-		// Load details
-		var wg errgroup.Group
-		for pi := psi; pi < pei; pi++ {
-			pi := pi
-			wg.Go(func() error {
-				proposalDetails, err := GetProposalDetails(ggp, proposalIds[pi], opts)
-				if err == nil {
-					details[pi] = proposalDetails
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
-			return []ProposalDetails{}, err
-		}
+// Get DAO proposal details with member data
+func GetDAOProposalsWithMember(ggp *gogopool.GoGoPool, daoName string, memberAddress common.Address, opts *bind.CallOpts) ([]ProposalDetails, error) {
+	return GetDAOProposalsWithMemberContext(gogopool.CallOptsContext(opts), ggp, daoName, memberAddress, opts)
+}
+func GetDAOProposalsWithMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, daoName string, memberAddress common.Address, opts *bind.CallOpts) ([]ProposalDetails, error) {
 
+	// Get DAO proposal IDs
+	proposalIds, err := GetDAOProposalIDsContext(ctx, ggp, daoName, opts)
+	if err != nil {
+		return []ProposalDetails{}, err
 	}
 
-	// Return
-	return details, nil
+	// Load proposal details
+	return loadProposalDetails(ctx, ggp, proposalIds, &memberAddress, opts)
 
 }
 
-// Get DAO proposal details with member data
-func GetDAOProposalsWithMember(ggp *gogopool.GoGoPool, daoName string, memberAddress common.Address, opts *bind.CallOpts) ([]ProposalDetails, error) {
-
-	// Get DAO proposal IDs
-	proposalIds, err := GetDAOProposalIDs(ggp, daoName, opts)
+// Load the details of a set of proposals through a multicaller, including member data if a member is given
+func loadProposalDetails(ctx context.Context, ggp *gogopool.GoGoPool, proposalIds []uint64, memberAddress *common.Address, opts *bind.CallOpts) ([]ProposalDetails, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return []ProposalDetails{}, err
 	}
 
-	// Load proposal details in batches
+	// Queue proposal detail calls
 	details := make([]ProposalDetails, len(proposalIds))
-	for bsi := 0; bsi < len(proposalIds); bsi += ProposalDetailsBatchSize {
-
-		// Get batch start & end index
-		psi := bsi
-		pei := bsi + ProposalDetailsBatchSize
-		if pei > len(proposalIds) {
-			pei = len(proposalIds)
+	times := make([][4]*big.Int, len(proposalIds))
+	votes := make([][3]*big.Int, len(proposalIds))
+	states := make([]uint8, len(proposalIds))
+	mc := ggp.NewMultiCaller()
+	for pi, proposalId := range proposalIds {
+		id := big.NewInt(int64(proposalId))
+		calls := []struct {
+			result interface{}
+			method string
+		}{
+			{&details[pi].DAO, "getDAO"},
+			{&details[pi].ProposerAddress, "getProposer"},
+			{&details[pi].Message, "getMessage"},
+			{&times[pi][0], "getCreated"},
+			{&times[pi][1], "getStart"},
+			{&times[pi][2], "getEnd"},
+			{&times[pi][3], "getExpires"},
+			{&votes[pi][0], "getVotesRequired"},
+			{&votes[pi][1], "getVotesFor"},
+			{&votes[pi][2], "getVotesAgainst"},
+			{&details[pi].IsCancelled, "getCancelled"},
+			{&details[pi].IsExecuted, "getExecuted"},
+			{&details[pi].Payload, "getPayload"},
+			{&states[pi], "getState"},
 		}
-
-		// Load details
-		var wg errgroup.Group
-		for pi := psi; pi < pei; pi++ {
-			pi := pi
-			wg.Go(func() error {
-				proposalDetails, err := GetProposalDetailsWithMember(ggp, proposalIds[pi], memberAddress, opts)
-				if err == nil {
-					details[pi] = proposalDetails
-				}
-				return err
-			})
+		for _, call := range calls {
+			if err := mc.AddCall(gogoDAOProposal, call.result, call.method, id); err != nil {
+				return []ProposalDetails{}, err
+			}
 		}
-		if err := wg.Wait(); err != nil {
-			return []ProposalDetails{}, err
+		if memberAddress != nil {
+			if err := mc.AddCall(gogoDAOProposal, &details[pi].MemberVoted, "getReceiptHasVoted", id, *memberAddress); err != nil {
+				return []ProposalDetails{}, err
+			}
+			if err := mc.AddCall(gogoDAOProposal, &details[pi].MemberSupported, "getReceiptSupported", id, *memberAddress); err != nil {
+				return []ProposalDetails{}, err
+			}
 		}
+	}
 
+	// Load details
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []ProposalDetails{}, fmt.Errorf("Could not get proposal details: %w", err)
+	}
+	for pi, proposalId := range proposalIds {
+		details[pi].ID = proposalId
+		details[pi].DAO = strings.Sanitize(details[pi].DAO)
+		details[pi].Message = strings.Sanitize(details[pi].Message)
+		details[pi].CreatedTime = times[pi][0].Uint64()
+		details[pi].StartTime = times[pi][1].Uint64()
+		details[pi].EndTime = times[pi][2].Uint64()
+		details[pi].ExpiryTime = times[pi][3].Uint64()
+		details[pi].VotesRequired = avax.WeiToEth(votes[pi][0])
+		details[pi].VotesFor = avax.WeiToEth(votes[pi][1])
+		details[pi].VotesAgainst = avax.WeiToEth(votes[pi][2])
+		details[pi].State = ggptypes.ProposalState(states[pi])
+
+		// Get proposal payload string
+		payloadStr, err := GetProposalPayloadStringContext(ctx, ggp, details[pi].DAO, details[pi].Payload)
+		if err != nil {
+			payloadStr = "(unknown)"
+		}
+		details[pi].PayloadStr = payloadStr
 	}
 
 	// Return
@@ -217,46 +201,36 @@ func GetDAOProposalsWithMember(ggp *gogopool.GoGoPool, daoName string, memberAdd
 
 // Get the IDs of proposals filtered by a DAO
 func GetDAOProposalIDs(ggp *gogopool.GoGoPool, daoName string, opts *bind.CallOpts) ([]uint64, error) {
+	return GetDAOProposalIDsContext(gogopool.CallOptsContext(opts), ggp, daoName, opts)
+}
+func GetDAOProposalIDsContext(ctx context.Context, ggp *gogopool.GoGoPool, daoName string, opts *bind.CallOpts) ([]uint64, error) {
 
 	// Get proposal count
-	proposalCount, err := GetProposalCount(ggp, opts)
+	proposalCount, err := GetProposalCountContext(ctx, ggp, opts)
 	if err != nil {
 		return []uint64{}, err
 	}
 
-	// Load proposal DAO names in batches
+	// Load proposal DAO names
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
+	if err != nil {
+		return []uint64{}, err
+	}
 	proposalDaoNames := make([]string, proposalCount)
-	for bsi := uint64(0); bsi < proposalCount; bsi += ProposalDAONamesBatchSize {
-
-		// Get batch start & end index
-		psi := bsi
-		pei := bsi + ProposalDAONamesBatchSize
-		if pei > proposalCount {
-			pei = proposalCount
-		}
-
-		// Load details
-		var wg errgroup.Group
-		for pi := psi; pi < pei; pi++ {
-			pi := pi
-			wg.Go(func() error {
-				proposalDaoName, err := GetProposalDAO(ggp, pi+1, opts) // Proposals are 1-indexed
-				if err == nil {
-					proposalDaoNames[pi] = proposalDaoName
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
+	mc := ggp.NewMultiCaller()
+	for pi := uint64(0); pi < proposalCount; pi++ {
+		if err := mc.AddCall(gogoDAOProposal, &proposalDaoNames[pi], "getDAO", big.NewInt(int64(pi+1))); err != nil { // Proposals are 1-indexed
 			return []uint64{}, err
 		}
-
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []uint64{}, fmt.Errorf("Could not get proposal DAOs: %w", err)
 	}
 
 	// Get & return IDs for DAO proposals
 	ids := []uint64{}
 	for pi, proposalDaoName := range proposalDaoNames {
-		if proposalDaoName == daoName {
+		if strings.Sanitize(proposalDaoName) == daoName {
 			ids = append(ids, uint64(pi+1)) // Proposals are 1-indexed
 		}
 	}
@@ -266,6 +240,9 @@ func GetDAOProposalIDs(ggp *gogopool.GoGoPool, daoName string, opts *bind.CallOp
 
 // Get a proposal's details
 func GetProposalDetails(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (ProposalDetails, error) {
+	return GetProposalDetailsContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalDetailsContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (ProposalDetails, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -287,72 +264,72 @@ func GetProposalDetails(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.Ca
 	// Load data
 	wg.Go(func() error {
 		var err error
-		dao, err = GetProposalDAO(ggp, proposalId, opts)
+		dao, err = GetProposalDAOContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		proposerAddress, err = GetProposalProposerAddress(ggp, proposalId, opts)
+		proposerAddress, err = GetProposalProposerAddressContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		message, err = GetProposalMessage(ggp, proposalId, opts)
+		message, err = GetProposalMessageContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		createdTime, err = GetProposalCreatedTime(ggp, proposalId, opts)
+		createdTime, err = GetProposalCreatedTimeContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		startTime, err = GetProposalStartTime(ggp, proposalId, opts)
+		startTime, err = GetProposalStartTimeContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		endTime, err = GetProposalEndTime(ggp, proposalId, opts)
+		endTime, err = GetProposalEndTimeContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		expiryTime, err = GetProposalExpiryTime(ggp, proposalId, opts)
+		expiryTime, err = GetProposalExpiryTimeContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		votesRequired, err = GetProposalVotesRequired(ggp, proposalId, opts)
+		votesRequired, err = GetProposalVotesRequiredContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		votesFor, err = GetProposalVotesFor(ggp, proposalId, opts)
+		votesFor, err = GetProposalVotesForContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		votesAgainst, err = GetProposalVotesAgainst(ggp, proposalId, opts)
+		votesAgainst, err = GetProposalVotesAgainstContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		isCancelled, err = GetProposalIsCancelled(ggp, proposalId, opts)
+		isCancelled, err = GetProposalIsCancelledContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		isExecuted, err = GetProposalIsExecuted(ggp, proposalId, opts)
+		isExecuted, err = GetProposalIsExecutedContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		payload, err = GetProposalPayload(ggp, proposalId, opts)
+		payload, err = GetProposalPayloadContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		state, err = GetProposalState(ggp, proposalId, opts)
+		state, err = GetProposalStateContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 
@@ -362,7 +339,7 @@ func GetProposalDetails(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.Ca
 	}
 
 	// Get proposal payload string
-	payloadStr, err := GetProposalPayloadString(ggp, dao, payload)
+	payloadStr, err := GetProposalPayloadStringContext(ctx, ggp, dao, payload)
 	if err != nil {
 		payloadStr = "(unknown)"
 	}
@@ -391,6 +368,9 @@ func GetProposalDetails(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.Ca
 
 // Get a proposal's details with member data
 func GetProposalDetailsWithMember(ggp *gogopool.GoGoPool, proposalId uint64, memberAddress common.Address, opts *bind.CallOpts) (ProposalDetails, error) {
+	return GetProposalDetailsWithMemberContext(gogopool.CallOptsContext(opts), ggp, proposalId, memberAddress, opts)
+}
+func GetProposalDetailsWithMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, memberAddress common.Address, opts *bind.CallOpts) (ProposalDetails, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -401,17 +381,17 @@ func GetProposalDetailsWithMember(ggp *gogopool.GoGoPool, proposalId uint64, mem
 	// Load data
 	wg.Go(func() error {
 		var err error
-		details, err = GetProposalDetails(ggp, proposalId, opts)
+		details, err = GetProposalDetailsContext(ctx, ggp, proposalId, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		memberVoted, err = GetProposalMemberVoted(ggp, proposalId, memberAddress, opts)
+		memberVoted, err = GetProposalMemberVotedContext(ctx, ggp, proposalId, memberAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		memberSupported, err = GetProposalMemberSupported(ggp, proposalId, memberAddress, opts)
+		memberSupported, err = GetProposalMemberSupportedContext(ctx, ggp, proposalId, memberAddress, opts)
 		return err
 	})
 
@@ -429,12 +409,15 @@ func GetProposalDetailsWithMember(ggp *gogopool.GoGoPool, proposalId uint64, mem
 
 // Get the proposal count
 func GetProposalCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalCountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetProposalCountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	proposalCount := new(*big.Int)
-	if err := gogoDAOProposal.Call(opts, proposalCount, "getTotal"); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, proposalCount, "getTotal"); err != nil {
 		return 0, fmt.Errorf("Could not get proposal count: %w", err)
 	}
 	return (*proposalCount).Uint64(), nil
@@ -442,170 +425,215 @@ func GetProposalCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, erro
 
 // Proposal details
 func GetProposalDAO(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (string, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalDAOContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalDAOContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (string, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return "", err
 	}
 	daoName := new(string)
-	if err := gogoDAOProposal.Call(opts, daoName, "getDAO", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, daoName, "getDAO", big.NewInt(int64(proposalId))); err != nil {
 		return "", fmt.Errorf("Could not get proposal %d DAO: %w", proposalId, err)
 	}
 	return strings.Sanitize(*daoName), nil
 }
 func GetProposalProposerAddress(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (common.Address, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalProposerAddressContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalProposerAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (common.Address, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
 	proposerAddress := new(common.Address)
-	if err := gogoDAOProposal.Call(opts, proposerAddress, "getProposer", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, proposerAddress, "getProposer", big.NewInt(int64(proposalId))); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get proposal %d proposer address: %w", proposalId, err)
 	}
 	return *proposerAddress, nil
 }
 func GetProposalMessage(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (string, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalMessageContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalMessageContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (string, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return "", err
 	}
 	message := new(string)
-	if err := gogoDAOProposal.Call(opts, message, "getMessage", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, message, "getMessage", big.NewInt(int64(proposalId))); err != nil {
 		return "", fmt.Errorf("Could not get proposal %d message: %w", proposalId, err)
 	}
 	return strings.Sanitize(*message), nil
 }
 func GetProposalCreatedTime(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (uint64, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalCreatedTimeContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalCreatedTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (uint64, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	createdTime := new(*big.Int)
-	if err := gogoDAOProposal.Call(opts, createdTime, "getCreated", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, createdTime, "getCreated", big.NewInt(int64(proposalId))); err != nil {
 		return 0, fmt.Errorf("Could not get proposal %d created time: %w", proposalId, err)
 	}
 	return (*createdTime).Uint64(), nil
 }
 func GetProposalStartTime(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (uint64, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalStartTimeContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalStartTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (uint64, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	startTime := new(*big.Int)
-	if err := gogoDAOProposal.Call(opts, startTime, "getStart", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, startTime, "getStart", big.NewInt(int64(proposalId))); err != nil {
 		return 0, fmt.Errorf("Could not get proposal %d start time: %w", proposalId, err)
 	}
 	return (*startTime).Uint64(), nil
 }
 func GetProposalEndTime(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (uint64, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalEndTimeContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalEndTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (uint64, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	endTime := new(*big.Int)
-	if err := gogoDAOProposal.Call(opts, endTime, "getEnd", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, endTime, "getEnd", big.NewInt(int64(proposalId))); err != nil {
 		return 0, fmt.Errorf("Could not get proposal %d end time: %w", proposalId, err)
 	}
 	return (*endTime).Uint64(), nil
 }
 func GetProposalExpiryTime(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (uint64, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalExpiryTimeContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalExpiryTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (uint64, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	expiryTime := new(*big.Int)
-	if err := gogoDAOProposal.Call(opts, expiryTime, "getExpires", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, expiryTime, "getExpires", big.NewInt(int64(proposalId))); err != nil {
 		return 0, fmt.Errorf("Could not get proposal %d expiry time: %w", proposalId, err)
 	}
 	return (*expiryTime).Uint64(), nil
 }
 func GetProposalVotesRequired(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (float64, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalVotesRequiredContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalVotesRequiredContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (float64, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	votesRequired := new(*big.Int)
-	if err := gogoDAOProposal.Call(opts, votesRequired, "getVotesRequired", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, votesRequired, "getVotesRequired", big.NewInt(int64(proposalId))); err != nil {
 		return 0, fmt.Errorf("Could not get proposal %d votes required: %w", proposalId, err)
 	}
 	return avax.WeiToEth(*votesRequired), nil
 }
 func GetProposalVotesFor(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (float64, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalVotesForContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalVotesForContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (float64, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	votesFor := new(*big.Int)
-	if err := gogoDAOProposal.Call(opts, votesFor, "getVotesFor", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, votesFor, "getVotesFor", big.NewInt(int64(proposalId))); err != nil {
 		return 0, fmt.Errorf("Could not get proposal %d votes for: %w", proposalId, err)
 	}
 	return avax.WeiToEth(*votesFor), nil
 }
 func GetProposalVotesAgainst(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (float64, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalVotesAgainstContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalVotesAgainstContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (float64, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	votesAgainst := new(*big.Int)
-	if err := gogoDAOProposal.Call(opts, votesAgainst, "getVotesAgainst", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, votesAgainst, "getVotesAgainst", big.NewInt(int64(proposalId))); err != nil {
 		return 0, fmt.Errorf("Could not get proposal %d votes against: %w", proposalId, err)
 	}
 	return avax.WeiToEth(*votesAgainst), nil
 }
 func GetProposalIsCancelled(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (bool, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalIsCancelledContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalIsCancelledContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (bool, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	cancelled := new(bool)
-	if err := gogoDAOProposal.Call(opts, cancelled, "getCancelled", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, cancelled, "getCancelled", big.NewInt(int64(proposalId))); err != nil {
 		return false, fmt.Errorf("Could not get proposal %d cancelled status: %w", proposalId, err)
 	}
 	return *cancelled, nil
 }
 func GetProposalIsExecuted(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (bool, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalIsExecutedContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalIsExecutedContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (bool, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	executed := new(bool)
-	if err := gogoDAOProposal.Call(opts, executed, "getExecuted", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, executed, "getExecuted", big.NewInt(int64(proposalId))); err != nil {
 		return false, fmt.Errorf("Could not get proposal %d executed status: %w", proposalId, err)
 	}
 	return *executed, nil
 }
 func GetProposalPayload(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) ([]byte, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalPayloadContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalPayloadContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) ([]byte, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return []byte{}, err
 	}
 	payload := new([]byte)
-	if err := gogoDAOProposal.Call(opts, payload, "getPayload", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, payload, "getPayload", big.NewInt(int64(proposalId))); err != nil {
 		return []byte{}, fmt.Errorf("Could not get proposal %d payload: %w", proposalId, err)
 	}
 	return *payload, nil
 }
 func GetProposalPayloadStr(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (string, error) {
-	dao, err := GetProposalDAO(ggp, proposalId, opts)
+	return GetProposalPayloadStrContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalPayloadStrContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (string, error) {
+	dao, err := GetProposalDAOContext(ctx, ggp, proposalId, opts)
 	if err != nil {
 		return "", err
 	}
-	payload, err := GetProposalPayload(ggp, proposalId, opts)
+	payload, err := GetProposalPayloadContext(ctx, ggp, proposalId, opts)
 	if err != nil {
 		return "", err
 	}
-	payloadStr, err := GetProposalPayloadString(ggp, dao, payload)
+	payloadStr, err := GetProposalPayloadStringContext(ctx, ggp, dao, payload)
 	if err != nil {
 		payloadStr = "(unknown)"
 	}
 	return payloadStr, nil
 }
 func GetProposalState(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (ggptypes.ProposalState, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalStateContext(gogopool.CallOptsContext(opts), ggp, proposalId, opts)
+}
+func GetProposalStateContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.CallOpts) (ggptypes.ProposalState, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	state := new(uint8)
-	if err := gogoDAOProposal.Call(opts, state, "getState", big.NewInt(int64(proposalId))); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, state, "getState", big.NewInt(int64(proposalId))); err != nil {
 		return 0, fmt.Errorf("Could not get proposal %d state: %w", proposalId, err)
 	}
 	return ggptypes.ProposalState(*state), nil
@@ -613,12 +641,15 @@ func GetProposalState(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.Call
 
 // Get whether a member has voted on a proposal
 func GetProposalMemberVoted(ggp *gogopool.GoGoPool, proposalId uint64, memberAddress common.Address, opts *bind.CallOpts) (bool, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalMemberVotedContext(gogopool.CallOptsContext(opts), ggp, proposalId, memberAddress, opts)
+}
+func GetProposalMemberVotedContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, memberAddress common.Address, opts *bind.CallOpts) (bool, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	voted := new(bool)
-	if err := gogoDAOProposal.Call(opts, voted, "getReceiptHasVoted", big.NewInt(int64(proposalId)), memberAddress); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, voted, "getReceiptHasVoted", big.NewInt(int64(proposalId)), memberAddress); err != nil {
 		return false, fmt.Errorf("Could not get proposal %d member %s voted status: %w", proposalId, memberAddress.Hex(), err)
 	}
 	return *voted, nil
@@ -626,12 +657,15 @@ func GetProposalMemberVoted(ggp *gogopool.GoGoPool, proposalId uint64, memberAdd
 
 // Get whether a member has voted in support of a proposal
 func GetProposalMemberSupported(ggp *gogopool.GoGoPool, proposalId uint64, memberAddress common.Address, opts *bind.CallOpts) (bool, error) {
-	gogoDAOProposal, err := getGoGoDAOProposal(ggp)
+	return GetProposalMemberSupportedContext(gogopool.CallOptsContext(opts), ggp, proposalId, memberAddress, opts)
+}
+func GetProposalMemberSupportedContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, memberAddress common.Address, opts *bind.CallOpts) (bool, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	supported := new(bool)
-	if err := gogoDAOProposal.Call(opts, supported, "getReceiptSupported", big.NewInt(int64(proposalId)), memberAddress); err != nil {
+	if err := gogoDAOProposal.CallContext(ctx, opts, supported, "getReceiptSupported", big.NewInt(int64(proposalId)), memberAddress); err != nil {
 		return false, fmt.Errorf("Could not get proposal %d member %s supported status: %w", proposalId, memberAddress.Hex(), err)
 	}
 	return *supported, nil
@@ -640,8 +674,8 @@ func GetProposalMemberSupported(ggp *gogopool.GoGoPool, proposalId uint64, membe
 // Get contracts
 var gogoDAOProposalLock sync.Mutex
 
-func getGoGoDAOProposal(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoDAOProposal(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoDAOProposalLock.Lock()
 	defer gogoDAOProposalLock.Unlock()
-	return ggp.GetContract("rocketDAOProposal")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProposal))
 }
@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,20 +15,38 @@ import (
 
 // Estimate the gas of BootstrapBool
 func EstimateBootstrapBoolGas(ggp *gogopool.GoGoPool, contractName, settingPath string, value bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAOProtocol, err := getGoGoDAOProtocol(ggp)
+	return EstimateBootstrapBoolGasContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func EstimateBootstrapBoolGasContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAOProtocol.GetTransactionGasInfo(opts, "bootstrapSettingBool", contractName, settingPath, value)
+	return gogoDAOProtocol.GetTransactionGasInfoContext(ctx, opts, "bootstrapSettingBool", contractName, settingPath, value)
+}
+
+// Add a BootstrapBool call to a Safe batch
+func BatchBootstrapBool(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value bool) error {
+	return BatchBootstrapBoolContext(context.Background(), ggp, batch, contractName, settingPath, value)
+}
+func BatchBootstrapBoolContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value bool) error {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAOProtocol, "bootstrapSettingBool", contractName, settingPath, value)
 }
 
 // Bootstrap a bool setting
 func BootstrapBool(ggp *gogopool.GoGoPool, contractName, settingPath string, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAOProtocol, err := getGoGoDAOProtocol(ggp)
+	return BootstrapBoolContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func BootstrapBoolContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAOProtocol.Transact(opts, "bootstrapSettingBool", contractName, settingPath, value)
+	hash, err := gogoDAOProtocol.TransactContext(ctx, opts, "bootstrapSettingBool", contractName, settingPath, value)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not bootstrap protocol setting %s.%s: %w", contractName, settingPath, err)
 	}
@@ -36,20 +55,38 @@ func BootstrapBool(ggp *gogopool.GoGoPool, contractName, settingPath string, val
 
 // Estimate the gas of BootstrapUint
 func EstimateBootstrapUintGas(ggp *gogopool.GoGoPool, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAOProtocol, err := getGoGoDAOProtocol(ggp)
+	return EstimateBootstrapUintGasContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func EstimateBootstrapUintGasContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAOProtocol.GetTransactionGasInfo(opts, "bootstrapSettingUint", contractName, settingPath, value)
+	return gogoDAOProtocol.GetTransactionGasInfoContext(ctx, opts, "bootstrapSettingUint", contractName, settingPath, value)
+}
+
+// Add a BootstrapUint call to a Safe batch
+func BatchBootstrapUint(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value *big.Int) error {
+	return BatchBootstrapUintContext(context.Background(), ggp, batch, contractName, settingPath, value)
+}
+func BatchBootstrapUintContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value *big.Int) error {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAOProtocol, "bootstrapSettingUint", contractName, settingPath, value)
 }
 
 // Bootstrap a uint256 setting
 func BootstrapUint(ggp *gogopool.GoGoPool, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAOProtocol, err := getGoGoDAOProtocol(ggp)
+	return BootstrapUintContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func BootstrapUintContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAOProtocol.Transact(opts, "bootstrapSettingUint", contractName, settingPath, value)
+	hash, err := gogoDAOProtocol.TransactContext(ctx, opts, "bootstrapSettingUint", contractName, settingPath, value)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not bootstrap protocol setting %s.%s: %w", contractName, settingPath, err)
 	}
@@ -58,20 +95,38 @@ func BootstrapUint(ggp *gogopool.GoGoPool, contractName, settingPath string, val
 
 // Estimate the gas of BootstrapAddress
 func EstimateBootstrapAddressGas(ggp *gogopool.GoGoPool, contractName, settingPath string, value common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAOProtocol, err := getGoGoDAOProtocol(ggp)
+	return EstimateBootstrapAddressGasContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func EstimateBootstrapAddressGasContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAOProtocol.GetTransactionGasInfo(opts, "bootstrapSettingAddress", contractName, settingPath, value)
+	return gogoDAOProtocol.GetTransactionGasInfoContext(ctx, opts, "bootstrapSettingAddress", contractName, settingPath, value)
+}
+
+// Add a BootstrapAddress call to a Safe batch
+func BatchBootstrapAddress(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value common.Address) error {
+	return BatchBootstrapAddressContext(context.Background(), ggp, batch, contractName, settingPath, value)
+}
+func BatchBootstrapAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value common.Address) error {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAOProtocol, "bootstrapSettingAddress", contractName, settingPath, value)
 }
 
 // Bootstrap an address setting
 func BootstrapAddress(ggp *gogopool.GoGoPool, contractName, settingPath string, value common.Address, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAOProtocol, err := getGoGoDAOProtocol(ggp)
+	return BootstrapAddressContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func BootstrapAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAOProtocol.Transact(opts, "bootstrapSettingAddress", contractName, settingPath, value)
+	hash, err := gogoDAOProtocol.TransactContext(ctx, opts, "bootstrapSettingAddress", contractName, settingPath, value)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not bootstrap protocol setting %s.%s: %w", contractName, settingPath, err)
 	}
@@ -80,20 +135,38 @@ func BootstrapAddress(ggp *gogopool.GoGoPool, contractName, settingPath string,
 
 // Estimate the gas of BootstrapClaimer
 func EstimateBootstrapClaimerGas(ggp *gogopool.GoGoPool, contractName string, amount float64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAOProtocol, err := getGoGoDAOProtocol(ggp)
+	return EstimateBootstrapClaimerGasContext(gogopool.TransactOptsContext(opts), ggp, contractName, amount, opts)
+}
+func EstimateBootstrapClaimerGasContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName string, amount float64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAOProtocol.GetTransactionGasInfo(opts, "bootstrapSettingClaimer", contractName, avax.EthToWei(amount))
+	return gogoDAOProtocol.GetTransactionGasInfoContext(ctx, opts, "bootstrapSettingClaimer", contractName, avax.EthToWei(amount))
+}
+
+// Add a BootstrapClaimer call to a Safe batch
+func BatchBootstrapClaimer(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName string, amount float64) error {
+	return BatchBootstrapClaimerContext(context.Background(), ggp, batch, contractName, amount)
+}
+func BatchBootstrapClaimerContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName string, amount float64) error {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAOProtocol, "bootstrapSettingClaimer", contractName, avax.EthToWei(amount))
 }
 
 // Bootstrap a rewards claimer
 func BootstrapClaimer(ggp *gogopool.GoGoPool, contractName string, amount float64, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAOProtocol, err := getGoGoDAOProtocol(ggp)
+	return BootstrapClaimerContext(gogopool.TransactOptsContext(opts), ggp, contractName, amount, opts)
+}
+func BootstrapClaimerContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName string, amount float64, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAOProtocol, err := getGoGoDAOProtocol(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAOProtocol.Transact(opts, "bootstrapSettingClaimer", contractName, avax.EthToWei(amount))
+	hash, err := gogoDAOProtocol.TransactContext(ctx, opts, "bootstrapSettingClaimer", contractName, avax.EthToWei(amount))
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not bootstrap protocol rewards claimer %s: %w", contractName, err)
 	}
@@ -103,8 +176,8 @@ func BootstrapClaimer(ggp *gogopool.GoGoPool, contractName string, amount float6
 // Get contracts
 var gogoDAOProtocolLock sync.Mutex
 
-func getGoGoDAOProtocol(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoDAOProtocol(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoDAOProtocolLock.Lock()
 	defer gogoDAOProtocolLock.Unlock()
-	return ggp.GetContract("rocketDAOProtocol")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProtocol))
 }
@@ -1,6 +1,7 @@
 package trustednode
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -12,21 +13,39 @@ import (
 
 // Estimate the gas of Join
 func EstimateJoinGas(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	return EstimateJoinGasContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func EstimateJoinGasContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrustedActions.GetTransactionGasInfo(opts, "actionJoin")
+	return gogoDAONodeTrustedActions.GetTransactionGasInfoContext(ctx, opts, "actionJoin")
+}
+
+// Build an unsigned Join transaction for external signing
+func BuildJoinTransaction(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildJoinTransactionContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func BuildJoinTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedActions.BuildTransactionContext(ctx, opts, "actionJoin")
 }
 
 // Join the trusted node DAO
 // Requires an executed invite proposal
 func Join(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	return JoinContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func JoinContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrustedActions.Transact(opts, "actionJoin")
+	hash, err := gogoDAONodeTrustedActions.TransactContext(ctx, opts, "actionJoin")
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not join the trusted node DAO: %w", err)
 	}
@@ -35,21 +54,39 @@ func Join(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error)
 
 // Estimate the gas of Leave
 func EstimateLeaveGas(ggp *gogopool.GoGoPool, ggpBondRefundAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	return EstimateLeaveGasContext(gogopool.TransactOptsContext(opts), ggp, ggpBondRefundAddress, opts)
+}
+func EstimateLeaveGasContext(ctx context.Context, ggp *gogopool.GoGoPool, ggpBondRefundAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrustedActions.GetTransactionGasInfo(opts, "actionLeave", ggpBondRefundAddress)
+	return gogoDAONodeTrustedActions.GetTransactionGasInfoContext(ctx, opts, "actionLeave", ggpBondRefundAddress)
+}
+
+// Build an unsigned Leave transaction for external signing
+func BuildLeaveTransaction(ggp *gogopool.GoGoPool, ggpBondRefundAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildLeaveTransactionContext(gogopool.TransactOptsContext(opts), ggp, ggpBondRefundAddress, opts)
+}
+func BuildLeaveTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, ggpBondRefundAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedActions.BuildTransactionContext(ctx, opts, "actionLeave", ggpBondRefundAddress)
 }
 
 // Leave the trusted node DAO
 // Requires an executed leave proposal
 func Leave(ggp *gogopool.GoGoPool, ggpBondRefundAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	return LeaveContext(gogopool.TransactOptsContext(opts), ggp, ggpBondRefundAddress, opts)
+}
+func LeaveContext(ctx context.Context, ggp *gogopool.GoGoPool, ggpBondRefundAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrustedActions.Transact(opts, "actionLeave", ggpBondRefundAddress)
+	hash, err := gogoDAONodeTrustedActions.TransactContext(ctx, opts, "actionLeave", ggpBondRefundAddress)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not leave the trusted node DAO: %w", err)
 	}
@@ -58,20 +95,38 @@ func Leave(ggp *gogopool.GoGoPool, ggpBondRefundAddress common.Address, opts *bi
 
 // Estimate the gas of MakeChallenge
 func EstimateMakeChallengeGas(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	return EstimateMakeChallengeGasContext(gogopool.TransactOptsContext(opts), ggp, memberAddress, opts)
+}
+func EstimateMakeChallengeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrustedActions.GetTransactionGasInfo(opts, "actionChallengeMake", memberAddress)
+	return gogoDAONodeTrustedActions.GetTransactionGasInfoContext(ctx, opts, "actionChallengeMake", memberAddress)
+}
+
+// Build an unsigned MakeChallenge transaction for external signing
+func BuildMakeChallengeTransaction(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildMakeChallengeTransactionContext(gogopool.TransactOptsContext(opts), ggp, memberAddress, opts)
+}
+func BuildMakeChallengeTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedActions.BuildTransactionContext(ctx, opts, "actionChallengeMake", memberAddress)
 }
 
 // Make a challenge against a node
 func MakeChallenge(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	return MakeChallengeContext(gogopool.TransactOptsContext(opts), ggp, memberAddress, opts)
+}
+func MakeChallengeContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrustedActions.Transact(opts, "actionChallengeMake", memberAddress)
+	hash, err := gogoDAONodeTrustedActions.TransactContext(ctx, opts, "actionChallengeMake", memberAddress)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not challenge trusted node DAO member %s: %w", memberAddress.Hex(), err)
 	}
@@ -80,20 +135,38 @@ func MakeChallenge(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *b
 
 // Estimate the gas of DecideChallenge
 func EstimateDecideChallengeGas(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	return EstimateDecideChallengeGasContext(gogopool.TransactOptsContext(opts), ggp, memberAddress, opts)
+}
+func EstimateDecideChallengeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrustedActions.GetTransactionGasInfo(opts, "actionChallengeDecide", memberAddress)
+	return gogoDAONodeTrustedActions.GetTransactionGasInfoContext(ctx, opts, "actionChallengeDecide", memberAddress)
+}
+
+// Build an unsigned DecideChallenge transaction for external signing
+func BuildDecideChallengeTransaction(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildDecideChallengeTransactionContext(gogopool.TransactOptsContext(opts), ggp, memberAddress, opts)
+}
+func BuildDecideChallengeTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedActions.BuildTransactionContext(ctx, opts, "actionChallengeDecide", memberAddress)
 }
 
 // Decide a challenge against a node
 func DecideChallenge(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	return DecideChallengeContext(gogopool.TransactOptsContext(opts), ggp, memberAddress, opts)
+}
+func DecideChallengeContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrustedActions.Transact(opts, "actionChallengeDecide", memberAddress)
+	hash, err := gogoDAONodeTrustedActions.TransactContext(ctx, opts, "actionChallengeDecide", memberAddress)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not decide the challenge against trusted node DAO member %s: %w", memberAddress.Hex(), err)
 	}
@@ -103,8 +176,8 @@ func DecideChallenge(ggp *gogopool.GoGoPool, memberAddress common.Address, opts
 // Get contracts
 var gogoDAONodeTrustedActionsLock sync.Mutex
 
-func getGoGoDAONodeTrustedActions(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoDAONodeTrustedActions(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoDAONodeTrustedActionsLock.Lock()
 	defer gogoDAONodeTrustedActionsLock.Unlock()
-	return ggp.GetContract("rocketDAONodeTrustedActions")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAONodeTrustedActions))
 }
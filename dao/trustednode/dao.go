@@ -1,6 +1,7 @@
 package trustednode
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -33,41 +34,53 @@ type MemberDetails struct {
 
 // Get all member details
 func GetMembers(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]MemberDetails, error) {
+	return GetMembersContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMembersContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]MemberDetails, error) {
 
 	// Get member addresses
-	memberAddresses, err := GetMemberAddresses(ggp, opts)
+	memberAddresses, err := GetMemberAddressesContext(ctx, ggp, opts)
 	if err != nil {
 		return []MemberDetails{}, err
 	}
 
-	// Load member details in batches
+	// Load member details
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
+	if err != nil {
+		return []MemberDetails{}, err
+	}
 	details := make([]MemberDetails, len(memberAddresses))
-	for bsi := 0; bsi < len(memberAddresses); bsi += MemberDetailsBatchSize {
-
-		// Get batch start & end index
-		msi := bsi
-		mei := bsi + MemberDetailsBatchSize
-		if mei > len(memberAddresses) {
-			mei = len(memberAddresses)
+	counts := make([][3]*big.Int, len(memberAddresses))
+	mc := ggp.NewMultiCaller()
+	for mi, memberAddress := range memberAddresses {
+		calls := []struct {
+			result interface{}
+			method string
+		}{
+			{&details[mi].Exists, "getMemberIsValid"},
+			{&details[mi].ID, "getMemberID"},
+			{&details[mi].Url, "getMemberUrl"},
+			{&counts[mi][0], "getMemberJoinedTime"},
+			{&counts[mi][1], "getMemberLastProposalTime"},
+			{&details[mi].GGPBondAmount, "getMemberGGPBondAmount"},
+			{&counts[mi][2], "getMemberUnbondedValidatorCount"},
 		}
-
-		// Load details
-		var wg errgroup.Group
-		for mi := msi; mi < mei; mi++ {
-			mi := mi
-			wg.Go(func() error {
-				memberAddress := memberAddresses[mi]
-				memberDetails, err := GetMemberDetails(ggp, memberAddress, opts)
-				if err == nil {
-					details[mi] = memberDetails
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
-			return []MemberDetails{}, err
+		for _, call := range calls {
+			if err := mc.AddCall(gogoDAONodeTrusted, call.result, call.method, memberAddress); err != nil {
+				return []MemberDetails{}, err
+			}
 		}
-
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []MemberDetails{}, fmt.Errorf("Could not get trusted node DAO member details: %w", err)
+	}
+	for mi, memberAddress := range memberAddresses {
+		details[mi].Address = memberAddress
+		details[mi].ID = strings.Sanitize(details[mi].ID)
+		details[mi].Url = strings.Sanitize(details[mi].Url)
+		details[mi].JoinedTime = counts[mi][0].Uint64()
+		details[mi].LastProposalTime = counts[mi][1].Uint64()
+		details[mi].UnbondedValidatorCount = counts[mi][2].Uint64()
 	}
 
 	// Return
@@ -77,40 +90,30 @@ func GetMembers(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]MemberDetails, e
 
 // Get all member addresses
 func GetMemberAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Address, error) {
+	return GetMemberAddressesContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMemberAddressesContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Address, error) {
 
 	// Get member count
-	memberCount, err := GetMemberCount(ggp, opts)
+	memberCount, err := GetMemberCountContext(ctx, ggp, opts)
 	if err != nil {
 		return []common.Address{}, err
 	}
 
-	// Load member addresses in batches
+	// Load member addresses
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
+	if err != nil {
+		return []common.Address{}, err
+	}
 	addresses := make([]common.Address, memberCount)
-	for bsi := uint64(0); bsi < memberCount; bsi += MemberAddressBatchSize {
-
-		// Get batch start & end index
-		msi := bsi
-		mei := bsi + MemberAddressBatchSize
-		if mei > memberCount {
-			mei = memberCount
-		}
-
-		// Load addresses
-		var wg errgroup.Group
-		for mi := msi; mi < mei; mi++ {
-			mi := mi
-			wg.Go(func() error {
-				address, err := GetMemberAt(ggp, mi, opts)
-				if err == nil {
-					addresses[mi] = address
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
+	mc := ggp.NewMultiCaller()
+	for mi := uint64(0); mi < memberCount; mi++ {
+		if err := mc.AddCall(gogoDAONodeTrusted, &addresses[mi], "getMemberAt", big.NewInt(int64(mi))); err != nil {
 			return []common.Address{}, err
 		}
-
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []common.Address{}, fmt.Errorf("Could not get trusted node DAO member addresses: %w", err)
 	}
 
 	// Return
@@ -120,6 +123,9 @@ func GetMemberAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.A
 
 // Get a member's details
 func GetMemberDetails(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (MemberDetails, error) {
+	return GetMemberDetailsContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberDetailsContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (MemberDetails, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -134,37 +140,37 @@ func GetMemberDetails(ggp *gogopool.GoGoPool, memberAddress common.Address, opts
 	// Load data
 	wg.Go(func() error {
 		var err error
-		exists, err = GetMemberExists(ggp, memberAddress, opts)
+		exists, err = GetMemberExistsContext(ctx, ggp, memberAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		id, err = GetMemberID(ggp, memberAddress, opts)
+		id, err = GetMemberIDContext(ctx, ggp, memberAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		url, err = GetMemberUrl(ggp, memberAddress, opts)
+		url, err = GetMemberUrlContext(ctx, ggp, memberAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		joinedTime, err = GetMemberJoinedTime(ggp, memberAddress, opts)
+		joinedTime, err = GetMemberJoinedTimeContext(ctx, ggp, memberAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		lastProposalTime, err = GetMemberLastProposalTime(ggp, memberAddress, opts)
+		lastProposalTime, err = GetMemberLastProposalTimeContext(ctx, ggp, memberAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		ggpBondAmount, err = GetMemberGGPBondAmount(ggp, memberAddress, opts)
+		ggpBondAmount, err = GetMemberGGPBondAmountContext(ctx, ggp, memberAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		unbondedValidatorCount, err = GetMemberUnbondedValidatorCount(ggp, memberAddress, opts)
+		unbondedValidatorCount, err = GetMemberUnbondedValidatorCountContext(ctx, ggp, memberAddress, opts)
 		return err
 	})
 
@@ -189,12 +195,15 @@ func GetMemberDetails(ggp *gogopool.GoGoPool, memberAddress common.Address, opts
 
 // Get the minimum member count
 func GetMinimumMemberCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMinimumMemberCountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinimumMemberCountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minMemberCount := new(*big.Int)
-	if err := gogoDAONodeTrusted.Call(opts, minMemberCount, "getMemberMinRequired"); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, minMemberCount, "getMemberMinRequired"); err != nil {
 		return 0, fmt.Errorf("Could not get trusted node DAO minimum member count: %w", err)
 	}
 	return (*minMemberCount).Uint64(), nil
@@ -202,12 +211,15 @@ func GetMinimumMemberCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64,
 
 // Get the member count
 func GetMemberCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberCountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMemberCountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	memberCount := new(*big.Int)
-	if err := gogoDAONodeTrusted.Call(opts, memberCount, "getMemberCount"); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, memberCount, "getMemberCount"); err != nil {
 		return 0, fmt.Errorf("Could not get trusted node DAO member count: %w", err)
 	}
 	return (*memberCount).Uint64(), nil
@@ -215,12 +227,15 @@ func GetMemberCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error)
 
 // Get a member address by index
 func GetMemberAt(ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (common.Address, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberAtContext(gogopool.CallOptsContext(opts), ggp, index, opts)
+}
+func GetMemberAtContext(ctx context.Context, ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (common.Address, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
 	memberAddress := new(common.Address)
-	if err := gogoDAONodeTrusted.Call(opts, memberAddress, "getMemberAt", big.NewInt(int64(index))); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, memberAddress, "getMemberAt", big.NewInt(int64(index))); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get trusted node DAO member %d address: %w", index, err)
 	}
 	return *memberAddress, nil
@@ -228,78 +243,99 @@ func GetMemberAt(ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (com
 
 // Member details
 func GetMemberExists(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (bool, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberExistsContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberExistsContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (bool, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	exists := new(bool)
-	if err := gogoDAONodeTrusted.Call(opts, exists, "getMemberIsValid", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, exists, "getMemberIsValid", memberAddress); err != nil {
 		return false, fmt.Errorf("Could not get trusted node DAO member %s exists status: %w", memberAddress.Hex(), err)
 	}
 	return *exists, nil
 }
 func GetMemberID(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (string, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberIDContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberIDContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (string, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return "", err
 	}
 	id := new(string)
-	if err := gogoDAONodeTrusted.Call(opts, id, "getMemberID", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, id, "getMemberID", memberAddress); err != nil {
 		return "", fmt.Errorf("Could not get trusted node DAO member %s ID: %w", memberAddress.Hex(), err)
 	}
 	return strings.Sanitize(*id), nil
 }
 func GetMemberUrl(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (string, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberUrlContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberUrlContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (string, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return "", err
 	}
 	url := new(string)
-	if err := gogoDAONodeTrusted.Call(opts, url, "getMemberUrl", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, url, "getMemberUrl", memberAddress); err != nil {
 		return "", fmt.Errorf("Could not get trusted node DAO member %s URL: %w", memberAddress.Hex(), err)
 	}
 	return strings.Sanitize(*url), nil
 }
 func GetMemberJoinedTime(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberJoinedTimeContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberJoinedTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	joinedTime := new(*big.Int)
-	if err := gogoDAONodeTrusted.Call(opts, joinedTime, "getMemberJoinedTime", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, joinedTime, "getMemberJoinedTime", memberAddress); err != nil {
 		return 0, fmt.Errorf("Could not get trusted node DAO member %s joined time: %w", memberAddress.Hex(), err)
 	}
 	return (*joinedTime).Uint64(), nil
 }
 func GetMemberLastProposalTime(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberLastProposalTimeContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberLastProposalTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	lastProposalTime := new(*big.Int)
-	if err := gogoDAONodeTrusted.Call(opts, lastProposalTime, "getMemberLastProposalTime", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, lastProposalTime, "getMemberLastProposalTime", memberAddress); err != nil {
 		return 0, fmt.Errorf("Could not get trusted node DAO member %s last proposal time: %w", memberAddress.Hex(), err)
 	}
 	return (*lastProposalTime).Uint64(), nil
 }
 func GetMemberGGPBondAmount(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberGGPBondAmountContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberGGPBondAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	ggpBondAmount := new(*big.Int)
-	if err := gogoDAONodeTrusted.Call(opts, ggpBondAmount, "getMemberGGPBondAmount", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, ggpBondAmount, "getMemberGGPBondAmount", memberAddress); err != nil {
 		return nil, fmt.Errorf("Could not get trusted node DAO member %s GGP bond amount: %w", memberAddress.Hex(), err)
 	}
 	return *ggpBondAmount, nil
 }
 func GetMemberUnbondedValidatorCount(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberUnbondedValidatorCountContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberUnbondedValidatorCountContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	unbondedValidatorCount := new(*big.Int)
-	if err := gogoDAONodeTrusted.Call(opts, unbondedValidatorCount, "getMemberUnbondedValidatorCount", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, unbondedValidatorCount, "getMemberUnbondedValidatorCount", memberAddress); err != nil {
 		return 0, fmt.Errorf("Could not get trusted node DAO member %s unbonded validator count: %w", memberAddress.Hex(), err)
 	}
 	return (*unbondedValidatorCount).Uint64(), nil
@@ -307,21 +343,33 @@ func GetMemberUnbondedValidatorCount(ggp *gogopool.GoGoPool, memberAddress commo
 
 // Get the time that a proposal for a member was executed at
 func GetMemberInviteProposalExecutedTime(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	return GetMemberProposalExecutedTime(ggp, "invited", memberAddress, opts)
+	return GetMemberInviteProposalExecutedTimeContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberInviteProposalExecutedTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	return GetMemberProposalExecutedTimeContext(ctx, ggp, "invited", memberAddress, opts)
 }
 func GetMemberLeaveProposalExecutedTime(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	return GetMemberProposalExecutedTime(ggp, "leave", memberAddress, opts)
+	return GetMemberLeaveProposalExecutedTimeContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberLeaveProposalExecutedTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	return GetMemberProposalExecutedTimeContext(ctx, ggp, "leave", memberAddress, opts)
 }
 func GetMemberReplaceProposalExecutedTime(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	return GetMemberProposalExecutedTime(ggp, "replace", memberAddress, opts)
+	return GetMemberReplaceProposalExecutedTimeContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberReplaceProposalExecutedTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	return GetMemberProposalExecutedTimeContext(ctx, ggp, "replace", memberAddress, opts)
 }
 func GetMemberProposalExecutedTime(ggp *gogopool.GoGoPool, proposalType string, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberProposalExecutedTimeContext(gogopool.CallOptsContext(opts), ggp, proposalType, memberAddress, opts)
+}
+func GetMemberProposalExecutedTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalType string, memberAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	proposalExecutedTime := new(*big.Int)
-	if err := gogoDAONodeTrusted.Call(opts, proposalExecutedTime, "getMemberProposalExecutedTime", proposalType, memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, proposalExecutedTime, "getMemberProposalExecutedTime", proposalType, memberAddress); err != nil {
 		return 0, fmt.Errorf("Could not get trusted node DAO %s proposal executed time for member %s: %w", proposalType, memberAddress.Hex(), err)
 	}
 	return (*proposalExecutedTime).Uint64(), nil
@@ -329,12 +377,15 @@ func GetMemberProposalExecutedTime(ggp *gogopool.GoGoPool, proposalType string,
 
 // Get a member's replacement address if being replaced
 func GetMemberReplacementAddress(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (common.Address, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberReplacementAddressContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberReplacementAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (common.Address, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
 	replacementAddress := new(common.Address)
-	if err := gogoDAONodeTrusted.Call(opts, replacementAddress, "getMemberReplacedAddress", "new", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, replacementAddress, "getMemberReplacedAddress", "new", memberAddress); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get trusted node DAO member %s replacement address: %w", memberAddress.Hex(), err)
 	}
 	return *replacementAddress, nil
@@ -342,12 +393,15 @@ func GetMemberReplacementAddress(ggp *gogopool.GoGoPool, memberAddress common.Ad
 
 // Get whether a member has an active challenge against them
 func GetMemberIsChallenged(ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (bool, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return GetMemberIsChallengedContext(gogopool.CallOptsContext(opts), ggp, memberAddress, opts)
+}
+func GetMemberIsChallengedContext(ctx context.Context, ggp *gogopool.GoGoPool, memberAddress common.Address, opts *bind.CallOpts) (bool, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	isChallenged := new(bool)
-	if err := gogoDAONodeTrusted.Call(opts, isChallenged, "getMemberIsChallenged", memberAddress); err != nil {
+	if err := gogoDAONodeTrusted.CallContext(ctx, opts, isChallenged, "getMemberIsChallenged", memberAddress); err != nil {
 		return false, fmt.Errorf("Could not get trusted node DAO member %s is challenged status: %w", memberAddress.Hex(), err)
 	}
 	return *isChallenged, nil
@@ -355,20 +409,38 @@ func GetMemberIsChallenged(ggp *gogopool.GoGoPool, memberAddress common.Address,
 
 // Estimate the gas of BootstrapBool
 func EstimateBootstrapBoolGas(ggp *gogopool.GoGoPool, contractName, settingPath string, value bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return EstimateBootstrapBoolGasContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func EstimateBootstrapBoolGasContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrusted.GetTransactionGasInfo(opts, "bootstrapSettingBool", contractName, settingPath, value)
+	return gogoDAONodeTrusted.GetTransactionGasInfoContext(ctx, opts, "bootstrapSettingBool", contractName, settingPath, value)
+}
+
+// Add a BootstrapBool call to a Safe batch
+func BatchBootstrapBool(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value bool) error {
+	return BatchBootstrapBoolContext(context.Background(), ggp, batch, contractName, settingPath, value)
+}
+func BatchBootstrapBoolContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value bool) error {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAONodeTrusted, "bootstrapSettingBool", contractName, settingPath, value)
 }
 
 // Bootstrap a bool setting
 func BootstrapBool(ggp *gogopool.GoGoPool, contractName, settingPath string, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return BootstrapBoolContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func BootstrapBoolContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrusted.Transact(opts, "bootstrapSettingBool", contractName, settingPath, value)
+	hash, err := gogoDAONodeTrusted.TransactContext(ctx, opts, "bootstrapSettingBool", contractName, settingPath, value)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not bootstrap trusted node setting %s.%s: %w", contractName, settingPath, err)
 	}
@@ -377,20 +449,38 @@ func BootstrapBool(ggp *gogopool.GoGoPool, contractName, settingPath string, val
 
 // Estimate the gas of BootstrapUint
 func EstimateBootstrapUintGas(ggp *gogopool.GoGoPool, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return EstimateBootstrapUintGasContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func EstimateBootstrapUintGasContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrusted.GetTransactionGasInfo(opts, "bootstrapSettingUint", contractName, settingPath, value)
+	return gogoDAONodeTrusted.GetTransactionGasInfoContext(ctx, opts, "bootstrapSettingUint", contractName, settingPath, value)
+}
+
+// Add a BootstrapUint call to a Safe batch
+func BatchBootstrapUint(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value *big.Int) error {
+	return BatchBootstrapUintContext(context.Background(), ggp, batch, contractName, settingPath, value)
+}
+func BatchBootstrapUintContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, contractName, settingPath string, value *big.Int) error {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAONodeTrusted, "bootstrapSettingUint", contractName, settingPath, value)
 }
 
 // Bootstrap a uint256 setting
 func BootstrapUint(ggp *gogopool.GoGoPool, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return BootstrapUintContext(gogopool.TransactOptsContext(opts), ggp, contractName, settingPath, value, opts)
+}
+func BootstrapUintContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrusted.Transact(opts, "bootstrapSettingUint", contractName, settingPath, value)
+	hash, err := gogoDAONodeTrusted.TransactContext(ctx, opts, "bootstrapSettingUint", contractName, settingPath, value)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not bootstrap trusted node setting %s.%s: %w", contractName, settingPath, err)
 	}
@@ -399,22 +489,41 @@ func BootstrapUint(ggp *gogopool.GoGoPool, contractName, settingPath string, val
 
 // Estimate the gas of BootstrapMember
 func EstimateBootstrapMemberGas(ggp *gogopool.GoGoPool, id, url string, nodeAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return EstimateBootstrapMemberGasContext(gogopool.TransactOptsContext(opts), ggp, id, url, nodeAddress, opts)
+}
+func EstimateBootstrapMemberGasContext(ctx context.Context, ggp *gogopool.GoGoPool, id, url string, nodeAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
 	url = strings.Sanitize(url)
-	return gogoDAONodeTrusted.GetTransactionGasInfo(opts, "bootstrapMember", id, url, nodeAddress)
+	return gogoDAONodeTrusted.GetTransactionGasInfoContext(ctx, opts, "bootstrapMember", id, url, nodeAddress)
+}
+
+// Add a BootstrapMember call to a Safe batch
+func BatchBootstrapMember(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, id, url string, nodeAddress common.Address) error {
+	return BatchBootstrapMemberContext(context.Background(), ggp, batch, id, url, nodeAddress)
+}
+func BatchBootstrapMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, id, url string, nodeAddress common.Address) error {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	url = strings.Sanitize(url)
+	return batch.AddCall(gogoDAONodeTrusted, "bootstrapMember", id, url, nodeAddress)
 }
 
 // Bootstrap a DAO member
 func BootstrapMember(ggp *gogopool.GoGoPool, id, url string, nodeAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	return BootstrapMemberContext(gogopool.TransactOptsContext(opts), ggp, id, url, nodeAddress, opts)
+}
+func BootstrapMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, id, url string, nodeAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
 	url = strings.Sanitize(url)
-	hash, err := gogoDAONodeTrusted.Transact(opts, "bootstrapMember", id, url, nodeAddress)
+	hash, err := gogoDAONodeTrusted.TransactContext(ctx, opts, "bootstrapMember", id, url, nodeAddress)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not bootstrap trusted node member %s: %w", id, err)
 	}
@@ -423,28 +532,50 @@ func BootstrapMember(ggp *gogopool.GoGoPool, id, url string, nodeAddress common.
 
 // Estimate the gas of BootstrapUpgrade
 func EstimateBootstrapUpgradeGas(ggp *gogopool.GoGoPool, upgradeType, contractName, contractAbi string, contractAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return EstimateBootstrapUpgradeGasContext(gogopool.TransactOptsContext(opts), ggp, upgradeType, contractName, contractAbi, contractAddress, opts)
+}
+func EstimateBootstrapUpgradeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, upgradeType, contractName, contractAbi string, contractAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
 	compressedAbi, err := gogopool.EncodeAbiStr(contractAbi)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrusted.GetTransactionGasInfo(opts, "bootstrapUpgrade", upgradeType, contractName, compressedAbi, contractAddress)
+	return gogoDAONodeTrusted.GetTransactionGasInfoContext(ctx, opts, "bootstrapUpgrade", upgradeType, contractName, compressedAbi, contractAddress)
+}
+
+// Add a BootstrapUpgrade call to a Safe batch
+func BatchBootstrapUpgrade(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, upgradeType, contractName, contractAbi string, contractAddress common.Address) error {
+	return BatchBootstrapUpgradeContext(context.Background(), ggp, batch, upgradeType, contractName, contractAbi, contractAddress)
+}
+func BatchBootstrapUpgradeContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, upgradeType, contractName, contractAbi string, contractAddress common.Address) error {
+	compressedAbi, err := gogopool.EncodeAbiStr(contractAbi)
+	if err != nil {
+		return err
+	}
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAONodeTrusted, "bootstrapUpgrade", upgradeType, contractName, compressedAbi, contractAddress)
 }
 
 // Bootstrap a contract upgrade
 func BootstrapUpgrade(ggp *gogopool.GoGoPool, upgradeType, contractName, contractAbi string, contractAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	return BootstrapUpgradeContext(gogopool.TransactOptsContext(opts), ggp, upgradeType, contractName, contractAbi, contractAddress, opts)
+}
+func BootstrapUpgradeContext(ctx context.Context, ggp *gogopool.GoGoPool, upgradeType, contractName, contractAbi string, contractAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
 	compressedAbi, err := gogopool.EncodeAbiStr(contractAbi)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ggp)
+	gogoDAONodeTrusted, err := getGoGoDAONodeTrusted(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrusted.Transact(opts, "bootstrapUpgrade", upgradeType, contractName, compressedAbi, contractAddress)
+	hash, err := gogoDAONodeTrusted.TransactContext(ctx, opts, "bootstrapUpgrade", upgradeType, contractName, compressedAbi, contractAddress)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not bootstrap contract '%s' upgrade (%s): %w", contractName, upgradeType, err)
 	}
@@ -454,8 +585,8 @@ func BootstrapUpgrade(ggp *gogopool.GoGoPool, upgradeType, contractName, contrac
 // Get contracts
 var gogoDAONodeTrustedLock sync.Mutex
 
-func getGoGoDAONodeTrusted(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoDAONodeTrusted(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoDAONodeTrustedLock.Lock()
 	defer gogoDAONodeTrustedLock.Unlock()
-	return ggp.GetContract("rocketDAONodeTrusted")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAONodeTrusted))
 }
@@ -1,6 +1,7 @@
 package trustednode
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -13,9 +14,18 @@ import (
 	"github.com/multisig-labs/gogopool-go/utils/strings"
 )
 
+// The result of a mined proposal submission
+type SubmitProposalResult struct {
+	gogopool.TransactionResult
+	ProposalID uint64 `json:"proposalId"`
+}
+
 // Estimate the gas of ProposeInviteMember
 func EstimateProposeInviteMemberGas(ggp *gogopool.GoGoPool, message string, newMemberAddress common.Address, newMemberId, newMemberUrl string, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateProposeInviteMemberGasContext(gogopool.TransactOptsContext(opts), ggp, message, newMemberAddress, newMemberId, newMemberUrl, opts)
+}
+func EstimateProposeInviteMemberGasContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, newMemberAddress common.Address, newMemberId, newMemberUrl string, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -24,12 +34,32 @@ func EstimateProposeInviteMemberGas(ggp *gogopool.GoGoPool, message string, newM
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not encode invite member proposal payload: %w", err)
 	}
-	return EstimateProposalGas(ggp, message, payload, opts)
+	return EstimateProposalGasContext(ctx, ggp, message, payload, opts)
+}
+
+// Add a ProposeInviteMember call to a Safe batch
+func BatchProposeInviteMember(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, newMemberAddress common.Address, newMemberId, newMemberUrl string) error {
+	return BatchProposeInviteMemberContext(context.Background(), ggp, batch, message, newMemberAddress, newMemberId, newMemberUrl)
+}
+func BatchProposeInviteMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, newMemberAddress common.Address, newMemberId, newMemberUrl string) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	newMemberUrl = strings.Sanitize(newMemberUrl)
+	payload, err := gogoDAONodeTrustedProposals.ABI.Pack("proposalInvite", newMemberId, newMemberUrl, newMemberAddress)
+	if err != nil {
+		return fmt.Errorf("Could not encode invite member proposal payload: %w", err)
+	}
+	return BatchProposalContext(ctx, ggp, batch, message, payload)
 }
 
 // Submit a proposal to invite a new member to the trusted node DAO
 func ProposeInviteMember(ggp *gogopool.GoGoPool, message string, newMemberAddress common.Address, newMemberId, newMemberUrl string, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return ProposeInviteMemberContext(gogopool.TransactOptsContext(opts), ggp, message, newMemberAddress, newMemberId, newMemberUrl, opts)
+}
+func ProposeInviteMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, newMemberAddress common.Address, newMemberId, newMemberUrl string, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -38,12 +68,15 @@ func ProposeInviteMember(ggp *gogopool.GoGoPool, message string, newMemberAddres
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not encode invite member proposal payload: %w", err)
 	}
-	return SubmitProposal(ggp, message, payload, opts)
+	return SubmitProposalContext(ctx, ggp, message, payload, opts)
 }
 
 // Estimate the gas of ProposeMemberLeave
 func EstimateProposeMemberLeaveGas(ggp *gogopool.GoGoPool, message string, memberAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateProposeMemberLeaveGasContext(gogopool.TransactOptsContext(opts), ggp, message, memberAddress, opts)
+}
+func EstimateProposeMemberLeaveGasContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, memberAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -51,12 +84,31 @@ func EstimateProposeMemberLeaveGas(ggp *gogopool.GoGoPool, message string, membe
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not encode member leave proposal payload: %w", err)
 	}
-	return EstimateProposalGas(ggp, message, payload, opts)
+	return EstimateProposalGasContext(ctx, ggp, message, payload, opts)
+}
+
+// Add a ProposeMemberLeave call to a Safe batch
+func BatchProposeMemberLeave(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, memberAddress common.Address) error {
+	return BatchProposeMemberLeaveContext(context.Background(), ggp, batch, message, memberAddress)
+}
+func BatchProposeMemberLeaveContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, memberAddress common.Address) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	payload, err := gogoDAONodeTrustedProposals.ABI.Pack("proposalLeave", memberAddress)
+	if err != nil {
+		return fmt.Errorf("Could not encode member leave proposal payload: %w", err)
+	}
+	return BatchProposalContext(ctx, ggp, batch, message, payload)
 }
 
 // Submit a proposal for a member to leave the trusted node DAO
 func ProposeMemberLeave(ggp *gogopool.GoGoPool, message string, memberAddress common.Address, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return ProposeMemberLeaveContext(gogopool.TransactOptsContext(opts), ggp, message, memberAddress, opts)
+}
+func ProposeMemberLeaveContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, memberAddress common.Address, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -64,12 +116,15 @@ func ProposeMemberLeave(ggp *gogopool.GoGoPool, message string, memberAddress co
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not encode member leave proposal payload: %w", err)
 	}
-	return SubmitProposal(ggp, message, payload, opts)
+	return SubmitProposalContext(ctx, ggp, message, payload, opts)
 }
 
 // Estimate the gas of ProposeReplaceMember
 func EstimateProposeReplaceMemberGas(ggp *gogopool.GoGoPool, message string, memberAddress, newMemberAddress common.Address, newMemberId, newMemberUrl string, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateProposeReplaceMemberGasContext(gogopool.TransactOptsContext(opts), ggp, message, memberAddress, newMemberAddress, newMemberId, newMemberUrl, opts)
+}
+func EstimateProposeReplaceMemberGasContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, memberAddress, newMemberAddress common.Address, newMemberId, newMemberUrl string, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -78,12 +133,32 @@ func EstimateProposeReplaceMemberGas(ggp *gogopool.GoGoPool, message string, mem
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not encode replace member proposal payload: %w", err)
 	}
-	return EstimateProposalGas(ggp, message, payload, opts)
+	return EstimateProposalGasContext(ctx, ggp, message, payload, opts)
+}
+
+// Add a ProposeReplaceMember call to a Safe batch
+func BatchProposeReplaceMember(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, memberAddress, newMemberAddress common.Address, newMemberId, newMemberUrl string) error {
+	return BatchProposeReplaceMemberContext(context.Background(), ggp, batch, message, memberAddress, newMemberAddress, newMemberId, newMemberUrl)
+}
+func BatchProposeReplaceMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, memberAddress, newMemberAddress common.Address, newMemberId, newMemberUrl string) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	newMemberUrl = strings.Sanitize(newMemberUrl)
+	payload, err := gogoDAONodeTrustedProposals.ABI.Pack("proposalReplace", memberAddress, newMemberId, newMemberUrl, newMemberAddress)
+	if err != nil {
+		return fmt.Errorf("Could not encode replace member proposal payload: %w", err)
+	}
+	return BatchProposalContext(ctx, ggp, batch, message, payload)
 }
 
 // Submit a proposal to replace a member in the trusted node DAO
 func ProposeReplaceMember(ggp *gogopool.GoGoPool, message string, memberAddress, newMemberAddress common.Address, newMemberId, newMemberUrl string, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return ProposeReplaceMemberContext(gogopool.TransactOptsContext(opts), ggp, message, memberAddress, newMemberAddress, newMemberId, newMemberUrl, opts)
+}
+func ProposeReplaceMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, memberAddress, newMemberAddress common.Address, newMemberId, newMemberUrl string, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -92,12 +167,15 @@ func ProposeReplaceMember(ggp *gogopool.GoGoPool, message string, memberAddress,
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not encode replace member proposal payload: %w", err)
 	}
-	return SubmitProposal(ggp, message, payload, opts)
+	return SubmitProposalContext(ctx, ggp, message, payload, opts)
 }
 
 // Estimate the gas of ProposeKickMember
 func EstimateProposeKickMemberGas(ggp *gogopool.GoGoPool, message string, memberAddress common.Address, ggpFineAmount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateProposeKickMemberGasContext(gogopool.TransactOptsContext(opts), ggp, message, memberAddress, ggpFineAmount, opts)
+}
+func EstimateProposeKickMemberGasContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, memberAddress common.Address, ggpFineAmount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -105,12 +183,31 @@ func EstimateProposeKickMemberGas(ggp *gogopool.GoGoPool, message string, member
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not encode kick member proposal payload: %w", err)
 	}
-	return EstimateProposalGas(ggp, message, payload, opts)
+	return EstimateProposalGasContext(ctx, ggp, message, payload, opts)
+}
+
+// Add a ProposeKickMember call to a Safe batch
+func BatchProposeKickMember(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, memberAddress common.Address, ggpFineAmount *big.Int) error {
+	return BatchProposeKickMemberContext(context.Background(), ggp, batch, message, memberAddress, ggpFineAmount)
+}
+func BatchProposeKickMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, memberAddress common.Address, ggpFineAmount *big.Int) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	payload, err := gogoDAONodeTrustedProposals.ABI.Pack("proposalKick", memberAddress, ggpFineAmount)
+	if err != nil {
+		return fmt.Errorf("Could not encode kick member proposal payload: %w", err)
+	}
+	return BatchProposalContext(ctx, ggp, batch, message, payload)
 }
 
 // Submit a proposal to kick a member from the trusted node DAO
 func ProposeKickMember(ggp *gogopool.GoGoPool, message string, memberAddress common.Address, ggpFineAmount *big.Int, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return ProposeKickMemberContext(gogopool.TransactOptsContext(opts), ggp, message, memberAddress, ggpFineAmount, opts)
+}
+func ProposeKickMemberContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, memberAddress common.Address, ggpFineAmount *big.Int, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -118,12 +215,15 @@ func ProposeKickMember(ggp *gogopool.GoGoPool, message string, memberAddress com
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not encode kick member proposal payload: %w", err)
 	}
-	return SubmitProposal(ggp, message, payload, opts)
+	return SubmitProposalContext(ctx, ggp, message, payload, opts)
 }
 
 // Estimate the gas of ProposeSetBool
 func EstimateProposeSetBoolGas(ggp *gogopool.GoGoPool, message, contractName, settingPath string, value bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateProposeSetBoolGasContext(gogopool.TransactOptsContext(opts), ggp, message, contractName, settingPath, value, opts)
+}
+func EstimateProposeSetBoolGasContext(ctx context.Context, ggp *gogopool.GoGoPool, message, contractName, settingPath string, value bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -131,12 +231,31 @@ func EstimateProposeSetBoolGas(ggp *gogopool.GoGoPool, message, contractName, se
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not encode set bool setting proposal payload: %w", err)
 	}
-	return EstimateProposalGas(ggp, message, payload, opts)
+	return EstimateProposalGasContext(ctx, ggp, message, payload, opts)
+}
+
+// Add a ProposeSetBool call to a Safe batch
+func BatchProposeSetBool(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message, contractName, settingPath string, value bool) error {
+	return BatchProposeSetBoolContext(context.Background(), ggp, batch, message, contractName, settingPath, value)
+}
+func BatchProposeSetBoolContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message, contractName, settingPath string, value bool) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	payload, err := gogoDAONodeTrustedProposals.ABI.Pack("proposalSettingBool", contractName, settingPath, value)
+	if err != nil {
+		return fmt.Errorf("Could not encode set bool setting proposal payload: %w", err)
+	}
+	return BatchProposalContext(ctx, ggp, batch, message, payload)
 }
 
 // Submit a proposal to update a bool trusted node DAO setting
 func ProposeSetBool(ggp *gogopool.GoGoPool, message, contractName, settingPath string, value bool, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return ProposeSetBoolContext(gogopool.TransactOptsContext(opts), ggp, message, contractName, settingPath, value, opts)
+}
+func ProposeSetBoolContext(ctx context.Context, ggp *gogopool.GoGoPool, message, contractName, settingPath string, value bool, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -144,12 +263,15 @@ func ProposeSetBool(ggp *gogopool.GoGoPool, message, contractName, settingPath s
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not encode set bool setting proposal payload: %w", err)
 	}
-	return SubmitProposal(ggp, message, payload, opts)
+	return SubmitProposalContext(ctx, ggp, message, payload, opts)
 }
 
 // Estimate the gas of ProposeSetUint
 func EstimateProposeSetUintGas(ggp *gogopool.GoGoPool, message, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateProposeSetUintGasContext(gogopool.TransactOptsContext(opts), ggp, message, contractName, settingPath, value, opts)
+}
+func EstimateProposeSetUintGasContext(ctx context.Context, ggp *gogopool.GoGoPool, message, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -157,12 +279,31 @@ func EstimateProposeSetUintGas(ggp *gogopool.GoGoPool, message, contractName, se
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not encode set uint setting proposal payload: %w", err)
 	}
-	return EstimateProposalGas(ggp, message, payload, opts)
+	return EstimateProposalGasContext(ctx, ggp, message, payload, opts)
+}
+
+// Add a ProposeSetUint call to a Safe batch
+func BatchProposeSetUint(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message, contractName, settingPath string, value *big.Int) error {
+	return BatchProposeSetUintContext(context.Background(), ggp, batch, message, contractName, settingPath, value)
+}
+func BatchProposeSetUintContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message, contractName, settingPath string, value *big.Int) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	payload, err := gogoDAONodeTrustedProposals.ABI.Pack("proposalSettingUint", contractName, settingPath, value)
+	if err != nil {
+		return fmt.Errorf("Could not encode set uint setting proposal payload: %w", err)
+	}
+	return BatchProposalContext(ctx, ggp, batch, message, payload)
 }
 
 // Submit a proposal to update a uint trusted node DAO setting
 func ProposeSetUint(ggp *gogopool.GoGoPool, message, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return ProposeSetUintContext(gogopool.TransactOptsContext(opts), ggp, message, contractName, settingPath, value, opts)
+}
+func ProposeSetUintContext(ctx context.Context, ggp *gogopool.GoGoPool, message, contractName, settingPath string, value *big.Int, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -170,16 +311,19 @@ func ProposeSetUint(ggp *gogopool.GoGoPool, message, contractName, settingPath s
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not encode set uint setting proposal payload: %w", err)
 	}
-	return SubmitProposal(ggp, message, payload, opts)
+	return SubmitProposalContext(ctx, ggp, message, payload, opts)
 }
 
 // Estimate the gas of ProposeUpgradeContract
 func EstimateProposeUpgradeContractGas(ggp *gogopool.GoGoPool, message, upgradeType, contractName, contractAbi string, contractAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return EstimateProposeUpgradeContractGasContext(gogopool.TransactOptsContext(opts), ggp, message, upgradeType, contractName, contractAbi, contractAddress, opts)
+}
+func EstimateProposeUpgradeContractGasContext(ctx context.Context, ggp *gogopool.GoGoPool, message, upgradeType, contractName, contractAbi string, contractAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
 	compressedAbi, err := gogopool.EncodeAbiStr(contractAbi)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -187,16 +331,39 @@ func EstimateProposeUpgradeContractGas(ggp *gogopool.GoGoPool, message, upgradeT
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not encode upgrade contract proposal payload: %w", err)
 	}
-	return EstimateProposalGas(ggp, message, payload, opts)
+	return EstimateProposalGasContext(ctx, ggp, message, payload, opts)
+}
+
+// Add a ProposeUpgradeContract call to a Safe batch
+func BatchProposeUpgradeContract(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message, upgradeType, contractName, contractAbi string, contractAddress common.Address) error {
+	return BatchProposeUpgradeContractContext(context.Background(), ggp, batch, message, upgradeType, contractName, contractAbi, contractAddress)
+}
+func BatchProposeUpgradeContractContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message, upgradeType, contractName, contractAbi string, contractAddress common.Address) error {
+	compressedAbi, err := gogopool.EncodeAbiStr(contractAbi)
+	if err != nil {
+		return err
+	}
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	payload, err := gogoDAONodeTrustedProposals.ABI.Pack("proposalUpgrade", upgradeType, contractName, compressedAbi, contractAddress)
+	if err != nil {
+		return fmt.Errorf("Could not encode upgrade contract proposal payload: %w", err)
+	}
+	return BatchProposalContext(ctx, ggp, batch, message, payload)
 }
 
 // Submit a proposal to upgrade a contract
 func ProposeUpgradeContract(ggp *gogopool.GoGoPool, message, upgradeType, contractName, contractAbi string, contractAddress common.Address, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return ProposeUpgradeContractContext(gogopool.TransactOptsContext(opts), ggp, message, upgradeType, contractName, contractAbi, contractAddress, opts)
+}
+func ProposeUpgradeContractContext(ctx context.Context, ggp *gogopool.GoGoPool, message, upgradeType, contractName, contractAbi string, contractAddress common.Address, opts *bind.TransactOpts) (uint64, common.Hash, error) {
 	compressedAbi, err := gogopool.EncodeAbiStr(contractAbi)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
@@ -204,52 +371,143 @@ func ProposeUpgradeContract(ggp *gogopool.GoGoPool, message, upgradeType, contra
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not encode upgrade contract proposal payload: %w", err)
 	}
-	return SubmitProposal(ggp, message, payload, opts)
+	return SubmitProposalContext(ctx, ggp, message, payload, opts)
 }
 
 // Estimate the gas of a proposal submission
 func EstimateProposalGas(ggp *gogopool.GoGoPool, message string, payload []byte, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateProposalGasContext(gogopool.TransactOptsContext(opts), ggp, message, payload, opts)
+}
+func EstimateProposalGasContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, payload []byte, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrustedProposals.GetTransactionGasInfo(opts, "propose", message, payload)
+	return gogoDAONodeTrustedProposals.GetTransactionGasInfoContext(ctx, opts, "propose", message, payload)
+}
+
+// Add a SubmitProposal call to a Safe batch
+func BatchProposal(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, payload []byte) error {
+	return BatchProposalContext(context.Background(), ggp, batch, message, payload)
+}
+func BatchProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, message string, payload []byte) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAONodeTrustedProposals, "propose", message, payload)
+}
+
+// Build an unsigned SubmitProposal transaction for external signing
+func BuildSubmitProposalTransaction(ggp *gogopool.GoGoPool, message string, payload []byte, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildSubmitProposalTransactionContext(gogopool.TransactOptsContext(opts), ggp, message, payload, opts)
+}
+func BuildSubmitProposalTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, payload []byte, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedProposals.BuildTransactionContext(ctx, opts, "propose", message, payload)
 }
 
 // Submit a trusted node DAO proposal
 // Returns the ID of the new proposal
 func SubmitProposal(ggp *gogopool.GoGoPool, message string, payload []byte, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return SubmitProposalContext(gogopool.TransactOptsContext(opts), ggp, message, payload, opts)
+}
+func SubmitProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, payload []byte, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
-	proposalCount, err := dao.GetProposalCount(ggp, nil)
+	proposalCount, err := dao.GetProposalCountContext(ctx, ggp, nil)
 	if err != nil {
 		return 0, common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrustedProposals.Transact(opts, "propose", message, payload)
+	hash, err := gogoDAONodeTrustedProposals.TransactContext(ctx, opts, "propose", message, payload)
 	if err != nil {
 		return 0, common.Hash{}, fmt.Errorf("Could not submit trusted node DAO proposal: %w", err)
 	}
 	return proposalCount + 1, hash, nil
 }
 
+// Submit a trusted node DAO proposal and wait for it to be mined
+// Returns the ID of the new proposal
+func SubmitProposalAndWait(ggp *gogopool.GoGoPool, message string, payload []byte, opts *bind.TransactOpts) (SubmitProposalResult, error) {
+	return SubmitProposalAndWaitContext(gogopool.TransactOptsContext(opts), ggp, message, payload, opts)
+}
+func SubmitProposalAndWaitContext(ctx context.Context, ggp *gogopool.GoGoPool, message string, payload []byte, opts *bind.TransactOpts) (SubmitProposalResult, error) {
+	gogoDAOProposal, err := getGoGoDAOProposal(ctx, ggp)
+	if err != nil {
+		return SubmitProposalResult{}, err
+	}
+	_, hash, err := SubmitProposalContext(ctx, ggp, message, payload, opts)
+	if err != nil {
+		return SubmitProposalResult{}, err
+	}
+	txResult, err := gogopool.WaitForResultContext(ctx, ggp.Client, hash)
+	result := SubmitProposalResult{TransactionResult: txResult}
+	if err != nil {
+		return result, err
+	}
+	events, err := gogoDAOProposal.GetTransactionEventArgs(txResult.Receipt, "ProposalAdded")
+	if err != nil {
+		return result, fmt.Errorf("Could not get new proposal ID: %w", err)
+	}
+	proposalId, ok := events[0]["proposalID"].(*big.Int)
+	if !ok {
+		return result, fmt.Errorf("Could not get new proposal ID: invalid ProposalAdded event")
+	}
+	result.ProposalID = proposalId.Uint64()
+	return result, nil
+}
+
 // Estimate the gas of CancelProposal
 func EstimateCancelProposalGas(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateCancelProposalGasContext(gogopool.TransactOptsContext(opts), ggp, proposalId, opts)
+}
+func EstimateCancelProposalGasContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrustedProposals.GetTransactionGasInfo(opts, "cancel", big.NewInt(int64(proposalId)))
+	return gogoDAONodeTrustedProposals.GetTransactionGasInfoContext(ctx, opts, "cancel", big.NewInt(int64(proposalId)))
+}
+
+// Add a CancelProposal call to a Safe batch
+func BatchCancelProposal(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, proposalId uint64) error {
+	return BatchCancelProposalContext(context.Background(), ggp, batch, proposalId)
+}
+func BatchCancelProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, proposalId uint64) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAONodeTrustedProposals, "cancel", big.NewInt(int64(proposalId)))
+}
+
+// Build an unsigned CancelProposal transaction for external signing
+func BuildCancelProposalTransaction(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildCancelProposalTransactionContext(gogopool.TransactOptsContext(opts), ggp, proposalId, opts)
+}
+func BuildCancelProposalTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedProposals.BuildTransactionContext(ctx, opts, "cancel", big.NewInt(int64(proposalId)))
 }
 
 // Cancel a submitted proposal
 func CancelProposal(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return CancelProposalContext(gogopool.TransactOptsContext(opts), ggp, proposalId, opts)
+}
+func CancelProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrustedProposals.Transact(opts, "cancel", big.NewInt(int64(proposalId)))
+	hash, err := gogoDAONodeTrustedProposals.TransactContext(ctx, opts, "cancel", big.NewInt(int64(proposalId)))
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not cancel trusted node DAO proposal %d: %w", proposalId, err)
 	}
@@ -258,20 +516,50 @@ func CancelProposal(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.Transa
 
 // Estimate the gas of VoteOnProposal
 func EstimateVoteOnProposalGas(ggp *gogopool.GoGoPool, proposalId uint64, support bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateVoteOnProposalGasContext(gogopool.TransactOptsContext(opts), ggp, proposalId, support, opts)
+}
+func EstimateVoteOnProposalGasContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, support bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrustedProposals.GetTransactionGasInfo(opts, "vote", big.NewInt(int64(proposalId)), support)
+	return gogoDAONodeTrustedProposals.GetTransactionGasInfoContext(ctx, opts, "vote", big.NewInt(int64(proposalId)), support)
+}
+
+// Add a VoteOnProposal call to a Safe batch
+func BatchVoteOnProposal(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, proposalId uint64, support bool) error {
+	return BatchVoteOnProposalContext(context.Background(), ggp, batch, proposalId, support)
+}
+func BatchVoteOnProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, proposalId uint64, support bool) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAONodeTrustedProposals, "vote", big.NewInt(int64(proposalId)), support)
+}
+
+// Build an unsigned VoteOnProposal transaction for external signing
+func BuildVoteOnProposalTransaction(ggp *gogopool.GoGoPool, proposalId uint64, support bool, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildVoteOnProposalTransactionContext(gogopool.TransactOptsContext(opts), ggp, proposalId, support, opts)
+}
+func BuildVoteOnProposalTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, support bool, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedProposals.BuildTransactionContext(ctx, opts, "vote", big.NewInt(int64(proposalId)), support)
 }
 
 // Vote on a submitted proposal
 func VoteOnProposal(ggp *gogopool.GoGoPool, proposalId uint64, support bool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return VoteOnProposalContext(gogopool.TransactOptsContext(opts), ggp, proposalId, support, opts)
+}
+func VoteOnProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, support bool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrustedProposals.Transact(opts, "vote", big.NewInt(int64(proposalId)), support)
+	hash, err := gogoDAONodeTrustedProposals.TransactContext(ctx, opts, "vote", big.NewInt(int64(proposalId)), support)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not vote on trusted node DAO proposal %d: %w", proposalId, err)
 	}
@@ -280,20 +568,62 @@ func VoteOnProposal(ggp *gogopool.GoGoPool, proposalId uint64, support bool, opt
 
 // Estimate the gas of ExecuteProposal
 func EstimateExecuteProposalGas(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return EstimateExecuteProposalGasContext(gogopool.TransactOptsContext(opts), ggp, proposalId, opts)
+}
+func EstimateExecuteProposalGasContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDAONodeTrustedProposals.GetTransactionGasInfo(opts, "execute", big.NewInt(int64(proposalId)))
+	return gogoDAONodeTrustedProposals.GetTransactionGasInfoContext(ctx, opts, "execute", big.NewInt(int64(proposalId)))
+}
+
+// Add a ExecuteProposal call to a Safe batch
+func BatchExecuteProposal(ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, proposalId uint64) error {
+	return BatchExecuteProposalContext(context.Background(), ggp, batch, proposalId)
+}
+func BatchExecuteProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, batch *gogopool.SafeBatch, proposalId uint64) error {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return err
+	}
+	return batch.AddCall(gogoDAONodeTrustedProposals, "execute", big.NewInt(int64(proposalId)))
+}
+
+// Build an unsigned ExecuteProposal transaction for external signing
+func BuildExecuteProposalTransaction(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildExecuteProposalTransactionContext(gogopool.TransactOptsContext(opts), ggp, proposalId, opts)
+}
+func BuildExecuteProposalTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedProposals.BuildTransactionContext(ctx, opts, "execute", big.NewInt(int64(proposalId)))
+}
+
+// Simulate ExecuteProposal without sending it
+func SimulateExecuteProposal(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (*gogopool.SimulationResult, error) {
+	return SimulateExecuteProposalContext(gogopool.TransactOptsContext(opts), ggp, proposalId, opts)
+}
+func SimulateExecuteProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (*gogopool.SimulationResult, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoDAONodeTrustedProposals.SimulateContext(ctx, opts, "execute", big.NewInt(int64(proposalId)))
 }
 
 // Execute a submitted proposal
 func ExecuteProposal(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ggp)
+	return ExecuteProposalContext(gogopool.TransactOptsContext(opts), ggp, proposalId, opts)
+}
+func ExecuteProposalContext(ctx context.Context, ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDAONodeTrustedProposals, err := getGoGoDAONodeTrustedProposals(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDAONodeTrustedProposals.Transact(opts, "execute", big.NewInt(int64(proposalId)))
+	hash, err := gogoDAONodeTrustedProposals.TransactContext(ctx, opts, "execute", big.NewInt(int64(proposalId)))
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not execute trusted node DAO proposal %d: %w", proposalId, err)
 	}
@@ -303,8 +633,16 @@ func ExecuteProposal(ggp *gogopool.GoGoPool, proposalId uint64, opts *bind.Trans
 // Get contracts
 var gogoDAONodeTrustedProposalsLock sync.Mutex
 
-func getGoGoDAONodeTrustedProposals(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoDAONodeTrustedProposals(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoDAONodeTrustedProposalsLock.Lock()
 	defer gogoDAONodeTrustedProposalsLock.Unlock()
-	return ggp.GetContract("rocketDAONodeTrustedProposals")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAONodeTrustedProposals))
+}
+
+var gogoDAOProposalLock sync.Mutex
+
+func getGoGoDAOProposal(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+	gogoDAOProposalLock.Lock()
+	defer gogoDAOProposalLock.Unlock()
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProposal))
 }
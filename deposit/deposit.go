@@ -1,6 +1,7 @@
 package deposit
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -13,12 +14,15 @@ import (
 
 // Get the deposit pool balance
 func GetBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoDepositPool, err := getGoGoDepositPool(ggp)
+	return GetBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoDepositPool, err := getGoGoDepositPool(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	balance := new(*big.Int)
-	if err := gogoDepositPool.Call(opts, balance, "getBalance"); err != nil {
+	if err := gogoDepositPool.CallContext(ctx, opts, balance, "getBalance"); err != nil {
 		return nil, fmt.Errorf("Could not get deposit pool balance: %w", err)
 	}
 	return *balance, nil
@@ -26,12 +30,15 @@ func GetBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
 
 // Get the excess deposit pool balance
 func GetExcessBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoDepositPool, err := getGoGoDepositPool(ggp)
+	return GetExcessBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetExcessBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoDepositPool, err := getGoGoDepositPool(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	excessBalance := new(*big.Int)
-	if err := gogoDepositPool.Call(opts, excessBalance, "getExcessBalance"); err != nil {
+	if err := gogoDepositPool.CallContext(ctx, opts, excessBalance, "getExcessBalance"); err != nil {
 		return nil, fmt.Errorf("Could not get deposit pool excess balance: %w", err)
 	}
 	return *excessBalance, nil
@@ -39,20 +46,26 @@ func GetExcessBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, er
 
 // Estimate the gas of Deposit
 func EstimateDepositGas(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDepositPool, err := getGoGoDepositPool(ggp)
+	return EstimateDepositGasContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func EstimateDepositGasContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDepositPool, err := getGoGoDepositPool(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDepositPool.GetTransactionGasInfo(opts, "deposit")
+	return gogoDepositPool.GetTransactionGasInfoContext(ctx, opts, "deposit")
 }
 
 // Make a deposit
 func Deposit(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDepositPool, err := getGoGoDepositPool(ggp)
+	return DepositContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func DepositContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDepositPool, err := getGoGoDepositPool(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDepositPool.Transact(opts, "deposit")
+	hash, err := gogoDepositPool.TransactContext(ctx, opts, "deposit")
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not deposit: %w", err)
 	}
@@ -61,20 +74,26 @@ func Deposit(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, erro
 
 // Estimate the gas of AssignDeposits
 func EstimateAssignDepositsGas(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoDepositPool, err := getGoGoDepositPool(ggp)
+	return EstimateAssignDepositsGasContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func EstimateAssignDepositsGasContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoDepositPool, err := getGoGoDepositPool(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoDepositPool.GetTransactionGasInfo(opts, "assignDeposits")
+	return gogoDepositPool.GetTransactionGasInfoContext(ctx, opts, "assignDeposits")
 }
 
 // Assign deposits
 func AssignDeposits(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoDepositPool, err := getGoGoDepositPool(ggp)
+	return AssignDepositsContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func AssignDepositsContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoDepositPool, err := getGoGoDepositPool(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoDepositPool.Transact(opts, "assignDeposits")
+	hash, err := gogoDepositPool.TransactContext(ctx, opts, "assignDeposits")
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not assign deposits: %w", err)
 	}
@@ -84,8 +103,8 @@ func AssignDeposits(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Has
 // Get contracts
 var gogoDepositPoolLock sync.Mutex
 
-func getGoGoDepositPool(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoDepositPool(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoDepositPoolLock.Lock()
 	defer gogoDepositPoolLock.Unlock()
-	return ggp.GetContract("rocketDepositPool")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDepositPool))
 }
@@ -5,36 +5,57 @@ import (
 	"compress/zlib"
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
+	"strings"
+
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
 
 // Decode, decompress and parse a zlib-compressed, base64-encoded ABI
 func DecodeAbi(abiEncoded string) (*abi.ABI, error) {
 
+	// Decompress ABI
+	abiStr, err := DecompressAbi(abiEncoded)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse ABI
+	abiParsed, err := abi.JSON(strings.NewReader(abiStr))
+	if err != nil {
+		return nil, fmt.Errorf("Could not parse JSON: %w", err)
+	}
+
+	// Return
+	return &abiParsed, nil
+
+}
+
+// Decode and decompress a zlib-compressed, base64-encoded ABI into its JSON string
+func DecompressAbi(abiEncoded string) (string, error) {
+
 	// base64 decode
 	abiCompressed, err := base64.StdEncoding.DecodeString(abiEncoded)
 	if err != nil {
-		return nil, fmt.Errorf("Could not decode base64 data: %w", err)
+		return "", fmt.Errorf("Could not decode base64 data: %w", err)
 	}
 
 	// zlib decompress
 	byteReader := bytes.NewReader(abiCompressed)
 	zlibReader, err := zlib.NewReader(byteReader)
 	if err != nil {
-		return nil, fmt.Errorf("Could not decompress zlib data: %w", err)
+		return "", fmt.Errorf("Could not decompress zlib data: %w", err)
 	}
 	defer func() {
 		_ = zlibReader.Close()
 	}()
-
-	// Parse ABI
-	abiParsed, err := abi.JSON(zlibReader)
+	abiBytes, err := ioutil.ReadAll(zlibReader)
 	if err != nil {
-		return nil, fmt.Errorf("Could not parse JSON: %w", err)
+		return "", fmt.Errorf("Could not decompress zlib data: %w", err)
 	}
 
 	// Return
-	return &abiParsed, nil
+	return string(abiBytes), nil
 
 }
 
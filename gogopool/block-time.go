@@ -0,0 +1,226 @@
+package gogopool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// The maximum number of block timestamps cached by a block resolver
+const MaxCachedBlockTimestamps = 1024
+
+// Resolves wall-clock times to block numbers by binary searching block headers
+// Header timestamps are cached, and each search starts from the closest cached blocks around the time, so repeated lookups around the same times need few requests
+type BlockResolver struct {
+	Client     *client.EthClientProxy
+	timestamps []blockTimestamp
+	lock       sync.Mutex
+}
+
+// A cached block timestamp
+type blockTimestamp struct {
+	block uint64
+	time  uint64
+}
+
+// Create a new block resolver
+func NewBlockResolver(client *client.EthClientProxy) *BlockResolver {
+	return &BlockResolver{
+		Client:     client,
+		timestamps: []blockTimestamp{},
+	}
+}
+
+// Get the number of the latest block with a timestamp at or before a time
+func (r *BlockResolver) GetBlockAtTime(t time.Time) (uint64, error) {
+	return r.GetBlockAtTimeContext(context.Background(), t)
+}
+func (r *BlockResolver) GetBlockAtTimeContext(ctx context.Context, t time.Time) (uint64, error) {
+	target := uint64(0)
+	if t.Unix() > 0 {
+		target = uint64(t.Unix())
+	}
+
+	// Check the latest block
+	latestHeader, err := r.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Could not get latest block header: %w", err)
+	}
+	latestBlock := latestHeader.Number.Uint64()
+	r.setTimestamp(latestBlock, latestHeader.Time)
+	if latestHeader.Time <= target {
+		return latestBlock, nil
+	}
+
+	// Narrow the search to the closest cached blocks around the target time, checking the genesis block if none are before it
+	low, lowFound, high := r.getCachedBounds(target, latestBlock)
+	if !lowFound {
+		genesisTime, err := r.getTimestamp(ctx, 0)
+		if err != nil {
+			return 0, err
+		}
+		if genesisTime > target {
+			return 0, &BlockNotFoundError{Time: t}
+		}
+		low = 0
+	}
+
+	// Binary search for the last block at or before the target time
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		midTime, err := r.getTimestamp(ctx, mid)
+		if err != nil {
+			return 0, err
+		}
+		if midTime <= target {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return low, nil
+
+}
+
+// Get call options for the state as of a time
+// Returns a StateUnavailableError if the client has pruned the state at the block, e.g. if it is not an archive node
+func (r *BlockResolver) CallOptsAt(t time.Time) (*bind.CallOpts, error) {
+	return r.CallOptsAtContext(context.Background(), t)
+}
+func (r *BlockResolver) CallOptsAtContext(ctx context.Context, t time.Time) (*bind.CallOpts, error) {
+	blockNumber, err := r.GetBlockAtTimeContext(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: new(big.Int).SetUint64(blockNumber),
+		Context:     ctx,
+	}
+	if _, err := r.Client.BalanceAt(ctx, common.Address{}, opts.BlockNumber); err != nil {
+		if IsMissingStateError(err) {
+			return nil, &StateUnavailableError{Block: blockNumber, Err: err}
+		}
+		return nil, fmt.Errorf("Could not check state at block %d: %w", blockNumber, err)
+	}
+	return opts, nil
+}
+
+// Get the closest cached blocks at or before and after a time, bounded by the latest block
+// Returns the block before, whether one was found, and the block after
+func (r *BlockResolver) getCachedBounds(target uint64, latestBlock uint64) (uint64, bool, uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	high := latestBlock
+	index := sort.Search(len(r.timestamps), func(i int) bool { return r.timestamps[i].time > target })
+	if index < len(r.timestamps) && r.timestamps[index].block < high {
+		high = r.timestamps[index].block
+	}
+	if index == 0 || r.timestamps[index-1].block >= high {
+		return 0, false, high
+	}
+	return r.timestamps[index-1].block, true, high
+}
+
+// Get a block timestamp, from the cache if available
+func (r *BlockResolver) getTimestamp(ctx context.Context, blockNumber uint64) (uint64, error) {
+	r.lock.Lock()
+	index := r.findTimestamp(blockNumber)
+	cached := index < len(r.timestamps) && r.timestamps[index].block == blockNumber
+	var timestamp uint64
+	if cached {
+		timestamp = r.timestamps[index].time
+	}
+	r.lock.Unlock()
+	if cached {
+		return timestamp, nil
+	}
+	header, err := r.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return 0, fmt.Errorf("Could not get block %d header: %w", blockNumber, err)
+	}
+	r.setTimestamp(blockNumber, header.Time)
+	return header.Time, nil
+}
+
+// Cache a block timestamp
+// When the cache is full, every other timestamp is dropped, keeping the latest, so the cached blocks still span the chain
+func (r *BlockResolver) setTimestamp(blockNumber uint64, timestamp uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	index := r.findTimestamp(blockNumber)
+	if index < len(r.timestamps) && r.timestamps[index].block == blockNumber {
+		r.timestamps[index].time = timestamp
+		return
+	}
+	r.timestamps = append(r.timestamps, blockTimestamp{})
+	copy(r.timestamps[index+1:], r.timestamps[index:])
+	r.timestamps[index] = blockTimestamp{block: blockNumber, time: timestamp}
+	if len(r.timestamps) > MaxCachedBlockTimestamps {
+		kept := make([]blockTimestamp, 0, len(r.timestamps)/2+1)
+		for ti := (len(r.timestamps) - 1) % 2; ti < len(r.timestamps); ti += 2 {
+			kept = append(kept, r.timestamps[ti])
+		}
+		r.timestamps = kept
+	}
+}
+
+// Get the index of a block in the cached timestamps, or the index it would be inserted at
+// Must be called with the lock held
+func (r *BlockResolver) findTimestamp(blockNumber uint64) int {
+	return sort.Search(len(r.timestamps), func(i int) bool { return r.timestamps[i].block >= blockNumber })
+}
+
+// Get the block number of the latest block at or before a time
+func (ggp *GoGoPool) GetBlockAtTime(t time.Time) (uint64, error) {
+	return ggp.GetBlockAtTimeContext(context.Background(), t)
+}
+func (ggp *GoGoPool) GetBlockAtTimeContext(ctx context.Context, t time.Time) (uint64, error) {
+	return ggp.getBlockResolver().GetBlockAtTimeContext(ctx, t)
+}
+
+// Get call options for the state as of a time, to pass to any getter
+func (ggp *GoGoPool) CallOptsAt(t time.Time) (*bind.CallOpts, error) {
+	return ggp.CallOptsAtContext(context.Background(), t)
+}
+func (ggp *GoGoPool) CallOptsAtContext(ctx context.Context, t time.Time) (*bind.CallOpts, error) {
+	return ggp.getBlockResolver().CallOptsAtContext(ctx, t)
+}
+
+// Get the contract manager's block resolver, creating it if required
+func (ggp *GoGoPool) getBlockResolver() *BlockResolver {
+	ggp.blockResolverLock.Lock()
+	defer ggp.blockResolverLock.Unlock()
+	if ggp.blockResolver == nil {
+		ggp.blockResolver = NewBlockResolver(ggp.Client)
+	}
+	return ggp.blockResolver
+}
+
+// Get a copy of call options pinned to a block, using the latest block if opts has none
+// Use this to make a group of calls read consistent state
+func (ggp *GoGoPool) PinCallOpts(opts *bind.CallOpts) (*bind.CallOpts, error) {
+	return ggp.PinCallOptsContext(CallOptsContext(opts), opts)
+}
+func (ggp *GoGoPool) PinCallOptsContext(ctx context.Context, opts *bind.CallOpts) (*bind.CallOpts, error) {
+	pinnedOpts := &bind.CallOpts{}
+	if opts != nil {
+		*pinnedOpts = *opts
+	}
+	pinnedOpts.Context = ctx
+	if pinnedOpts.BlockNumber == nil {
+		blockNumber, err := ggp.Client.BlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get latest block number: %w", err)
+		}
+		pinnedOpts.BlockNumber = new(big.Int).SetUint64(blockNumber)
+	}
+	return pinnedOpts, nil
+}
@@ -0,0 +1,217 @@
+package gogopool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Cache invalidation settings
+const (
+	WatchedCacheTTL          = 86400 // 1 day
+	CacheWatcherPollInterval = 15 * time.Second
+)
+
+// Upgrade events which invalidate cached contract data, and whether they change the contract address
+var cacheInvalidationEvents = map[string]bool{
+	"ContractUpgraded": true,
+	"ContractAdded":    true,
+	"ABIUpgraded":      false,
+	"ABIAdded":         false,
+}
+
+// Start watching for contract upgrades, evicting the affected cache entries as they occur
+// The cache TTL is extended to WatchedCacheTTL while the watcher is keeping up, and falls back to CacheTTL otherwise
+// The watcher stops when ctx is done
+func (ggp *GoGoPool) WatchCache(ctx context.Context, pollInterval time.Duration) error {
+
+	// Initialize sync state
+	if err := ggp.SyncCacheContext(ctx); err != nil {
+		return err
+	}
+
+	// Poll for upgrades
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				atomic.StoreInt64(&ggp.cacheSyncTime, 0)
+				return
+			case <-ticker.C:
+				// Errors are tolerated here; the cache falls back to CacheTTL until a sync succeeds
+				_ = ggp.SyncCacheContext(ctx)
+			}
+		}
+	}()
+
+	// Return
+	return nil
+
+}
+
+// Evict cache entries for contracts upgraded since the last sync
+// The first sync resumes from the block persisted in the cache backend, or otherwise clears the cache and starts from the current block
+func (ggp *GoGoPool) SyncCache() error {
+	return ggp.SyncCacheContext(context.Background())
+}
+func (ggp *GoGoPool) SyncCacheContext(ctx context.Context) error {
+	ggp.cacheSyncLock.Lock()
+	defer ggp.cacheSyncLock.Unlock()
+
+	// Get latest block
+	latestBlock, err := ggp.Client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("Could not get latest block for cache sync: %w", err)
+	}
+
+	// Initialize sync state
+	if ggp.cacheSyncBlock == 0 {
+		if syncBlock, ok := ggp.getPersistedSyncBlock(); ok && syncBlock <= latestBlock {
+			ggp.cacheSyncBlock = syncBlock
+		}
+	}
+	if ggp.cacheSyncBlock == 0 {
+		ggp.ClearCache()
+		ggp.persistSyncBlock(latestBlock)
+		ggp.cacheSyncBlock = latestBlock
+		atomic.StoreInt64(&ggp.cacheSyncTime, time.Now().Unix())
+		return nil
+	}
+
+	// Check for new blocks
+	if latestBlock <= ggp.cacheSyncBlock {
+		atomic.StoreInt64(&ggp.cacheSyncTime, time.Now().Unix())
+		return nil
+	}
+	fromBlock := new(big.Int).SetUint64(ggp.cacheSyncBlock + 1)
+	toBlock := new(big.Int).SetUint64(latestBlock)
+
+	// Process upgrade logs, following upgrades of the upgrade contract itself within the block range
+	queried := map[common.Address]bool{}
+	for {
+		upgradeContract, err := ggp.GetContractContext(ctx, ggp.ContractName(ContractDAONodeTrustedUpgrade))
+		if err != nil {
+			return err
+		}
+		if queried[*upgradeContract.Address] {
+			break
+		}
+		queried[*upgradeContract.Address] = true
+
+		// Get upgrade logs
+		eventIds := []common.Hash{}
+		changesAddress := map[common.Hash]bool{}
+		for eventName, addressChanged := range cacheInvalidationEvents {
+			if event, ok := upgradeContract.ABI.Events[eventName]; ok {
+				eventIds = append(eventIds, event.ID)
+				changesAddress[event.ID] = addressChanged
+			}
+		}
+		if len(eventIds) == 0 {
+			break
+		}
+		logs, err := ggp.GetLogsContext(ctx, ethereum.FilterQuery{
+			Addresses: []common.Address{*upgradeContract.Address},
+			Topics:    [][]common.Hash{eventIds},
+			FromBlock: fromBlock,
+			ToBlock:   toBlock,
+		}, big.NewInt(DefaultLogIntervalSize))
+		if err != nil {
+			return fmt.Errorf("Could not get contract upgrade logs: %w", err)
+		}
+
+		// Evict affected entries
+		ggp.evictUpgradedContracts(logs, changesAddress)
+
+	}
+
+	// Update sync state
+	ggp.cacheSyncBlock = latestBlock
+	ggp.persistSyncBlock(latestBlock)
+	atomic.StoreInt64(&ggp.cacheSyncTime, time.Now().Unix())
+	return nil
+
+}
+
+// Clear all cached contract addresses, ABIs and contracts, including those in the cache backend
+func (ggp *GoGoPool) ClearCache() {
+	ggp.deletePersisted(func(key string) bool {
+		return true
+	})
+	ggp.addressesLock.Lock()
+	ggp.addresses = make(map[string]cachedAddress)
+	ggp.addressesLock.Unlock()
+	ggp.abisLock.Lock()
+	ggp.abis = make(map[string]cachedABI)
+	ggp.abisLock.Unlock()
+	ggp.contractsLock.Lock()
+	ggp.contracts = make(map[string]cachedContract)
+	ggp.contractsLock.Unlock()
+	ggp.clearReceiptDecoder()
+	ggp.clearUpgradeHistory()
+}
+
+// Get the current cache TTL in seconds
+func (ggp *GoGoPool) getCacheTTL() int64 {
+	syncTime := atomic.LoadInt64(&ggp.cacheSyncTime)
+	if syncTime > 0 && time.Now().Unix()-syncTime <= CacheTTL {
+		return WatchedCacheTTL
+	}
+	return CacheTTL
+}
+
+// Evict the cache entries of contracts named in upgrade logs
+func (ggp *GoGoPool) evictUpgradedContracts(logs []types.Log, changesAddress map[common.Hash]bool) {
+	if len(logs) > 0 {
+		ggp.clearReceiptDecoder()
+	}
+	for _, log := range logs {
+		if len(log.Topics) < 2 {
+			continue
+		}
+		nameHash := log.Topics[1]
+		addressChanged := changesAddress[log.Topics[0]]
+		ggp.deletePersisted(func(key string) bool {
+			if strings.HasPrefix(key, persistedAddressKey) {
+				return addressChanged && crypto.Keccak256Hash([]byte(strings.TrimPrefix(key, persistedAddressKey))) == nameHash
+			}
+			if strings.HasPrefix(key, persistedABIKey) {
+				return crypto.Keccak256Hash([]byte(strings.TrimPrefix(key, persistedABIKey))) == nameHash
+			}
+			return false
+		})
+		if addressChanged {
+			ggp.addressesLock.Lock()
+			for contractName := range ggp.addresses {
+				if crypto.Keccak256Hash([]byte(contractName)) == nameHash {
+					delete(ggp.addresses, contractName)
+				}
+			}
+			ggp.addressesLock.Unlock()
+		}
+		ggp.abisLock.Lock()
+		for contractName := range ggp.abis {
+			if crypto.Keccak256Hash([]byte(contractName)) == nameHash {
+				delete(ggp.abis, contractName)
+			}
+		}
+		ggp.abisLock.Unlock()
+		ggp.contractsLock.Lock()
+		for contractName := range ggp.contracts {
+			if crypto.Keccak256Hash([]byte(contractName)) == nameHash {
+				delete(ggp.contracts, contractName)
+			}
+		}
+		ggp.contractsLock.Unlock()
+	}
+}
@@ -0,0 +1,268 @@
+package gogopool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Cache backend key prefixes
+const (
+	persistedAddressKey   = "address/"
+	persistedABIKey       = "abi/"
+	persistedSyncBlockKey = "sync.block"
+)
+
+// A backend for cached contract addresses & ABIs
+// Keys are namespaced by chain ID and storage address, so a cache may be shared between deployments
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Set(key string, entry CacheEntry) error
+	Delete(key string) error
+	Keys() []string
+}
+
+// A cached value and the unix time it was loaded at
+type CacheEntry struct {
+	Value []byte `json:"value"`
+	Time  int64  `json:"time"`
+}
+
+// In-memory cache
+type MemoryCache struct {
+	entries map[string]CacheEntry
+	lock    sync.RWMutex
+}
+
+// Create a new in-memory cache
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]CacheEntry),
+	}
+}
+
+func (c *MemoryCache) Get(key string) (CacheEntry, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *MemoryCache) Set(key string, entry CacheEntry) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[key] = entry
+	return nil
+}
+
+func (c *MemoryCache) Delete(key string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, key)
+	return nil
+}
+
+func (c *MemoryCache) Keys() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	keys := make([]string, 0, len(c.entries))
+	for key := range c.entries {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// On-disk cache, stored as a single JSON file and written through on every change
+type DiskCache struct {
+	path    string
+	entries map[string]CacheEntry
+	lock    sync.RWMutex
+}
+
+// Create a new on-disk cache, loading existing entries from path if present
+func NewDiskCache(path string) (*DiskCache, error) {
+	cache := &DiskCache{
+		path:    path,
+		entries: make(map[string]CacheEntry),
+	}
+	bytes, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cache, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("Could not read cache file %s: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, &cache.entries); err != nil {
+		return nil, fmt.Errorf("Could not decode cache file %s: %w", path, err)
+	}
+	return cache, nil
+}
+
+func (c *DiskCache) Get(key string) (CacheEntry, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *DiskCache) Set(key string, entry CacheEntry) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[key] = entry
+	return c.save()
+}
+
+func (c *DiskCache) Delete(key string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		return nil
+	}
+	delete(c.entries, key)
+	return c.save()
+}
+
+func (c *DiskCache) Keys() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	keys := make([]string, 0, len(c.entries))
+	for key := range c.entries {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Write the cache file atomically; the lock must be held
+func (c *DiskCache) save() error {
+	bytes, err := json.Marshal(c.entries)
+	if err != nil {
+		return fmt.Errorf("Could not encode cache file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("Could not create cache directory: %w", err)
+	}
+	tmpPath := c.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, bytes, 0644); err != nil {
+		return fmt.Errorf("Could not write cache file %s: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, c.path); err != nil {
+		return fmt.Errorf("Could not write cache file %s: %w", c.path, err)
+	}
+	return nil
+}
+
+// Set a backend for cached contract addresses & ABIs
+// Entries older than ttl seconds are reloaded; a ttl of 0 keeps entries until they are evicted by SyncCache or ClearCache
+func (ggp *GoGoPool) SetCache(cache Cache, ttl int64) error {
+	return ggp.SetCacheContext(context.Background(), cache, ttl)
+}
+func (ggp *GoGoPool) SetCacheContext(ctx context.Context, cache Cache, ttl int64) error {
+
+	// Get chain ID
+	chainId, err := ggp.Client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("Could not get chain ID for cache: %w", err)
+	}
+
+	// Set cache
+	ggp.cacheLock.Lock()
+	defer ggp.cacheLock.Unlock()
+	ggp.cache = cache
+	ggp.cachePrefix = fmt.Sprintf("%s/%s/", chainId.String(), ggp.GoGoStorageContract.Address.Hex())
+	ggp.cacheTTL = ttl
+	return nil
+
+}
+
+// Get the cache backend and key prefix, or nil if none is set
+func (ggp *GoGoPool) getCache() (Cache, string, int64) {
+	ggp.cacheLock.RLock()
+	defer ggp.cacheLock.RUnlock()
+	return ggp.cache, ggp.cachePrefix, ggp.cacheTTL
+}
+
+// Get a value from the cache backend if present and not expired
+func (ggp *GoGoPool) getPersisted(key string) ([]byte, bool) {
+	cache, prefix, ttl := ggp.getCache()
+	if cache == nil {
+		return nil, false
+	}
+	entry, ok := cache.Get(prefix + key)
+	if !ok {
+		return nil, false
+	}
+	if ttl > 0 && time.Now().Unix()-entry.Time > ttl {
+		_ = cache.Delete(prefix + key)
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// Write a value to the cache backend
+// Write failures are ignored, as the value is only reloaded on the next lookup
+func (ggp *GoGoPool) persist(key string, value []byte) {
+	cache, prefix, _ := ggp.getCache()
+	if cache == nil {
+		return
+	}
+	_ = cache.Set(prefix+key, CacheEntry{
+		Value: value,
+		Time:  time.Now().Unix(),
+	})
+}
+
+// Delete the cache backend entries for which match returns true
+func (ggp *GoGoPool) deletePersisted(match func(key string) bool) {
+	cache, prefix, _ := ggp.getCache()
+	if cache == nil {
+		return
+	}
+	for _, key := range cache.Keys() {
+		if strings.HasPrefix(key, prefix) && match(strings.TrimPrefix(key, prefix)) {
+			_ = cache.Delete(key)
+		}
+	}
+}
+
+// Persisted address & ABI control
+func (ggp *GoGoPool) getPersistedAddress(contractName string) (common.Address, bool) {
+	value, ok := ggp.getPersisted(persistedAddressKey + contractName)
+	if !ok {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(value), true
+}
+func (ggp *GoGoPool) persistAddress(contractName string, address common.Address) {
+	ggp.persist(persistedAddressKey+contractName, address.Bytes())
+}
+func (ggp *GoGoPool) getPersistedABI(contractName string) (string, bool) {
+	value, ok := ggp.getPersisted(persistedABIKey + contractName)
+	return string(value), ok
+}
+func (ggp *GoGoPool) persistABI(contractName string, abiStr string) {
+	ggp.persist(persistedABIKey+contractName, []byte(abiStr))
+}
+
+// Persisted cache sync block control
+func (ggp *GoGoPool) getPersistedSyncBlock() (uint64, bool) {
+	cache, prefix, _ := ggp.getCache()
+	if cache == nil {
+		return 0, false
+	}
+	entry, ok := cache.Get(prefix + persistedSyncBlockKey)
+	if !ok {
+		return 0, false
+	}
+	return new(big.Int).SetBytes(entry.Value).Uint64(), true
+}
+func (ggp *GoGoPool) persistSyncBlock(blockNumber uint64) {
+	ggp.persist(persistedSyncBlockKey, new(big.Int).SetUint64(blockNumber).Bytes())
+}
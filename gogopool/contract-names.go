@@ -0,0 +1,112 @@
+package gogopool
+
+// A logical contract role, resolved to the name the contract is registered under in GoGoStorage
+type ContractRole string
+
+// A mapping of contract roles to GoGoStorage contract names
+type ContractNames map[ContractRole]string
+
+// Contract roles
+const (
+	ContractAuctionManager                  ContractRole = "AuctionManager"
+	ContractClaimNode                       ContractRole = "ClaimNode"
+	ContractClaimTrustedNode                ContractRole = "ClaimTrustedNode"
+	ContractDAONodeTrusted                  ContractRole = "DAONodeTrusted"
+	ContractDAONodeTrustedActions           ContractRole = "DAONodeTrustedActions"
+	ContractDAONodeTrustedProposals         ContractRole = "DAONodeTrustedProposals"
+	ContractDAONodeTrustedSettingsMembers   ContractRole = "DAONodeTrustedSettingsMembers"
+	ContractDAONodeTrustedSettingsMinipool  ContractRole = "DAONodeTrustedSettingsMinipool"
+	ContractDAONodeTrustedSettingsProposals ContractRole = "DAONodeTrustedSettingsProposals"
+	ContractDAONodeTrustedUpgrade           ContractRole = "DAONodeTrustedUpgrade"
+	ContractDAOProposal                     ContractRole = "DAOProposal"
+	ContractDAOProtocol                     ContractRole = "DAOProtocol"
+	ContractDAOProtocolSettingsAuction      ContractRole = "DAOProtocolSettingsAuction"
+	ContractDAOProtocolSettingsDeposit      ContractRole = "DAOProtocolSettingsDeposit"
+	ContractDAOProtocolSettingsInflation    ContractRole = "DAOProtocolSettingsInflation"
+	ContractDAOProtocolSettingsMinipool     ContractRole = "DAOProtocolSettingsMinipool"
+	ContractDAOProtocolSettingsNetwork      ContractRole = "DAOProtocolSettingsNetwork"
+	ContractDAOProtocolSettingsNode         ContractRole = "DAOProtocolSettingsNode"
+	ContractDAOProtocolSettingsRewards      ContractRole = "DAOProtocolSettingsRewards"
+	ContractDepositPool                     ContractRole = "DepositPool"
+	ContractMinipool                        ContractRole = "Minipool"
+	ContractMinipoolDelegate                ContractRole = "MinipoolDelegate"
+	ContractMinipoolManager                 ContractRole = "MinipoolManager"
+	ContractMinipoolQueue                   ContractRole = "MinipoolQueue"
+	ContractMinipoolStatus                  ContractRole = "MinipoolStatus"
+	ContractNetworkBalances                 ContractRole = "NetworkBalances"
+	ContractNetworkFees                     ContractRole = "NetworkFees"
+	ContractNetworkPrices                   ContractRole = "NetworkPrices"
+	ContractNodeDeposit                     ContractRole = "NodeDeposit"
+	ContractNodeManager                     ContractRole = "NodeManager"
+	ContractNodeStaking                     ContractRole = "NodeStaking"
+	ContractRewardsPool                     ContractRole = "RewardsPool"
+	ContractTokenGGP                        ContractRole = "TokenGGP"
+	ContractTokenGGPFixedSupply             ContractRole = "TokenGGPFixedSupply"
+	ContractTokenRETH                       ContractRole = "TokenRETH"
+)
+
+// The contract names used by the reference deployment
+var DefaultContractNames = ContractNames{
+	ContractAuctionManager:                  "rocketAuctionManager",
+	ContractClaimNode:                       "rocketClaimNode",
+	ContractClaimTrustedNode:                "rocketClaimTrustedNode",
+	ContractDAONodeTrusted:                  "rocketDAONodeTrusted",
+	ContractDAONodeTrustedActions:           "rocketDAONodeTrustedActions",
+	ContractDAONodeTrustedProposals:         "rocketDAONodeTrustedProposals",
+	ContractDAONodeTrustedSettingsMembers:   "rocketDAONodeTrustedSettingsMembers",
+	ContractDAONodeTrustedSettingsMinipool:  "rocketDAONodeTrustedSettingsMinipool",
+	ContractDAONodeTrustedSettingsProposals: "rocketDAONodeTrustedSettingsProposals",
+	ContractDAONodeTrustedUpgrade:           "rocketDAONodeTrustedUpgrade",
+	ContractDAOProposal:                     "rocketDAOProposal",
+	ContractDAOProtocol:                     "rocketDAOProtocol",
+	ContractDAOProtocolSettingsAuction:      "rocketDAOProtocolSettingsAuction",
+	ContractDAOProtocolSettingsDeposit:      "rocketDAOProtocolSettingsDeposit",
+	ContractDAOProtocolSettingsInflation:    "rocketDAOProtocolSettingsInflation",
+	ContractDAOProtocolSettingsMinipool:     "rocketDAOProtocolSettingsMinipool",
+	ContractDAOProtocolSettingsNetwork:      "rocketDAOProtocolSettingsNetwork",
+	ContractDAOProtocolSettingsNode:         "rocketDAOProtocolSettingsNode",
+	ContractDAOProtocolSettingsRewards:      "rocketDAOProtocolSettingsRewards",
+	ContractDepositPool:                     "rocketDepositPool",
+	ContractMinipool:                        "rocketMinipool",
+	ContractMinipoolDelegate:                "rocketMinipoolDelegate",
+	ContractMinipoolManager:                 "rocketMinipoolManager",
+	ContractMinipoolQueue:                   "rocketMinipoolQueue",
+	ContractMinipoolStatus:                  "rocketMinipoolStatus",
+	ContractNetworkBalances:                 "rocketNetworkBalances",
+	ContractNetworkFees:                     "rocketNetworkFees",
+	ContractNetworkPrices:                   "rocketNetworkPrices",
+	ContractNodeDeposit:                     "rocketNodeDeposit",
+	ContractNodeManager:                     "rocketNodeManager",
+	ContractNodeStaking:                     "rocketNodeStaking",
+	ContractRewardsPool:                     "rocketRewardsPool",
+	ContractTokenGGP:                        "rocketTokenGGP",
+	ContractTokenGGPFixedSupply:             "rocketTokenGGPFixedSupply",
+	ContractTokenRETH:                       "rocketTokenRETH",
+}
+
+// Merge contract name overrides onto the default names
+func newContractNames(overrides ...ContractNames) ContractNames {
+	names := make(ContractNames, len(DefaultContractNames))
+	for role, name := range DefaultContractNames {
+		names[role] = name
+	}
+	for _, override := range overrides {
+		for role, name := range override {
+			names[role] = name
+		}
+	}
+	return names
+}
+
+// Get the GoGoStorage name of the contract with a role
+func (ggp *GoGoPool) ContractName(role ContractRole) string {
+	if name, ok := ggp.contractNames[role]; ok {
+		return name
+	}
+	return string(role)
+}
+
+// Get the GoGoStorage names of all contract roles
+func (ggp *GoGoPool) GetContractNames() ContractNames {
+	return newContractNames(ggp.contractNames)
+}
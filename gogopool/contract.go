@@ -22,31 +22,75 @@ const (
 	MaxGasLimit                = 12000000
 )
 
+// The method name plain ETH transfers to a contract are observed with
+const TransferMethodName = "transfer"
+
 // Contract type wraps go-ethereum bound contract
 type Contract struct {
 	Contract *bind.BoundContract
 	Address  *common.Address
 	ABI      *abi.ABI
 	Client   *client.EthClientProxy
+	Name     string
 }
 
 // Response for gas limits from network and from user request
 type GasInfo struct {
-	EstGasLimit  uint64 `json:"estGasLimit"`
-	SafeGasLimit uint64 `json:"safeGasLimit"`
+	EstGasLimit  uint64               `json:"estGasLimit"`
+	SafeGasLimit uint64               `json:"safeGasLimit"`
+	Costs        map[FeeSpeed]GasCost `json:"costs,omitempty"`
+}
+
+// Get the context carried by call options, or the background context if none is set
+func CallOptsContext(opts *bind.CallOpts) context.Context {
+	if opts != nil && opts.Context != nil {
+		return opts.Context
+	}
+	return context.Background()
+}
+
+// Get the context carried by transaction options, or the background context if none is set
+func TransactOptsContext(opts *bind.TransactOpts) context.Context {
+	if opts != nil && opts.Context != nil {
+		return opts.Context
+	}
+	return context.Background()
 }
 
 // Call a contract method
 func (c *Contract) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return c.CallContext(CallOptsContext(opts), opts, result, method, params...)
+}
+func (c *Contract) CallContext(ctx context.Context, opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	if err := c.checkMethod(method); err != nil {
+		return err
+	}
+	if err := c.checkClient(method); err != nil {
+		return err
+	}
+	callOpts := bind.CallOpts{}
+	if opts != nil {
+		callOpts = *opts
+	}
+	ctx, done := c.Client.ObserveContractCall(ctx, client.CallKindCall, c.Name, method, callOpts.BlockNumber)
+	callOpts.Context = ctx
 	results := make([]interface{}, 1)
 	results[0] = result
-	return c.Contract.Call(opts, &results, method, params...)
+	err := c.Contract.Call(&callOpts, &results, method, params...)
+	done(err)
+	return err
 }
 
 // Get Gas Limit for transaction
 func (c *Contract) GetTransactionGasInfo(opts *bind.TransactOpts, method string, params ...interface{}) (GasInfo, error) {
+	return c.GetTransactionGasInfoContext(TransactOptsContext(opts), opts, method, params...)
+}
+func (c *Contract) GetTransactionGasInfoContext(ctx context.Context, opts *bind.TransactOpts, method string, params ...interface{}) (GasInfo, error) {
 
 	response := GasInfo{}
+	if err := c.checkMethod(method); err != nil {
+		return response, fmt.Errorf("Error getting transaction gas info: %w", err)
+	}
 
 	// Pack transaction Info
 	input, err := c.ABI.Pack(method, params...)
@@ -56,7 +100,7 @@ func (c *Contract) GetTransactionGasInfo(opts *bind.TransactOpts, method string,
 	fmt.Println(opts.From.String())
 	fmt.Println(c.Address.String())
 	// Estimate gas limit
-	estGasLimit, safeGasLimit, err := c.estimateGasLimit(opts, input)
+	estGasLimit, safeGasLimit, err := c.estimateGasLimit(ctx, opts, method, input)
 
 	if err != nil {
 		return response, fmt.Errorf("Error getting transaction gas info: could not estimate gas limit: %w", err)
@@ -64,11 +108,30 @@ func (c *Contract) GetTransactionGasInfo(opts *bind.TransactOpts, method string,
 	response.EstGasLimit = estGasLimit
 	response.SafeGasLimit = safeGasLimit
 
-	return response, err
+	// Get costs; these are best-effort, and left unset if fees can't be estimated unless the context is done
+	if err := NewFeeOracle(c.Client).AddGasCostsContext(ctx, &response); err != nil && ctx.Err() != nil {
+		return response, fmt.Errorf("Error getting transaction gas info: %w", ctx.Err())
+	}
+
+	return response, nil
 }
 
 // Transact on a contract method and wait for a receipt
 func (c *Contract) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (common.Hash, error) {
+	return c.TransactContext(TransactOptsContext(opts), opts, method, params...)
+}
+func (c *Contract) TransactContext(ctx context.Context, opts *bind.TransactOpts, method string, params ...interface{}) (hash common.Hash, err error) {
+	if err := c.checkMethod(method); err != nil {
+		return common.Hash{}, err
+	}
+	if err := c.checkClient(method); err != nil {
+		return common.Hash{}, err
+	}
+	ctx, done := c.Client.ObserveContractCall(ctx, client.CallKindTransact, c.Name, method, nil)
+	defer func() { done(err) }()
+
+	// Send the gas estimate, nonce lookup & transaction through the same endpoint
+	ctx = client.WithStickyEndpoint(ctx)
 
 	// Estimate gas limit
 	if opts.GasLimit == 0 {
@@ -76,7 +139,7 @@ func (c *Contract) Transact(opts *bind.TransactOpts, method string, params ...in
 		if err != nil {
 			return common.Hash{}, fmt.Errorf("Could not encode input data: %w", err)
 		}
-		_, safeGasLimit, err := c.estimateGasLimit(opts, input)
+		_, safeGasLimit, err := c.estimateGasLimit(ctx, opts, method, input)
 		if err != nil {
 			return common.Hash{}, err
 		}
@@ -84,9 +147,11 @@ func (c *Contract) Transact(opts *bind.TransactOpts, method string, params ...in
 	}
 
 	// Send transaction
-	tx, err := c.Contract.Transact(opts, method, params...)
+	txOpts := *opts
+	txOpts.Context = ctx
+	tx, err := c.Contract.Transact(&txOpts, method, params...)
 	if err != nil {
-		return common.Hash{}, err
+		return common.Hash{}, c.decodeRevertError(method, err)
 	}
 
 	return tx.Hash(), nil
@@ -95,26 +160,46 @@ func (c *Contract) Transact(opts *bind.TransactOpts, method string, params ...in
 
 // Get gas limit for a transfer call
 func (c *Contract) GetTransferGasInfo(opts *bind.TransactOpts) (GasInfo, error) {
+	return c.GetTransferGasInfoContext(TransactOptsContext(opts), opts)
+}
+func (c *Contract) GetTransferGasInfoContext(ctx context.Context, opts *bind.TransactOpts) (GasInfo, error) {
 
 	response := GasInfo{}
 
 	// Estimate gas limit
-	estGasLimit, safeGasLimit, err := c.estimateGasLimit(opts, []byte{})
+	estGasLimit, safeGasLimit, err := c.estimateGasLimit(ctx, opts, "", []byte{})
 	if err != nil {
 		return response, fmt.Errorf("Error getting transfer gas info: could not estimate gas limit: %w", err)
 	}
 	response.EstGasLimit = estGasLimit
 	response.SafeGasLimit = safeGasLimit
 
+	// Get costs; these are best-effort, and left unset if fees can't be estimated unless the context is done
+	if err := NewFeeOracle(c.Client).AddGasCostsContext(ctx, &response); err != nil && ctx.Err() != nil {
+		return response, fmt.Errorf("Error getting transfer gas info: %w", ctx.Err())
+	}
+
 	return response, nil
 }
 
 // Transfer ETH to a contract and wait for a receipt
+// Transfers are reported to the client's observer with the method name TransferMethodName
 func (c *Contract) Transfer(opts *bind.TransactOpts) (common.Hash, error) {
+	return c.TransferContext(TransactOptsContext(opts), opts)
+}
+func (c *Contract) TransferContext(ctx context.Context, opts *bind.TransactOpts) (hash common.Hash, err error) {
+	if err := c.checkClient(TransferMethodName); err != nil {
+		return common.Hash{}, err
+	}
+	ctx, done := c.Client.ObserveContractCall(ctx, client.CallKindTransact, c.Name, TransferMethodName, nil)
+	defer func() { done(err) }()
+
+	// Send the gas estimate, nonce lookup & transaction through the same endpoint
+	ctx = client.WithStickyEndpoint(ctx)
 
 	// Estimate gas limit
 	if opts.GasLimit == 0 {
-		_, safeGasLimit, err := c.estimateGasLimit(opts, []byte{})
+		_, safeGasLimit, err := c.estimateGasLimit(ctx, opts, "", []byte{})
 		if err != nil {
 			return common.Hash{}, err
 		}
@@ -122,7 +207,9 @@ func (c *Contract) Transfer(opts *bind.TransactOpts) (common.Hash, error) {
 	}
 
 	// Send transaction
-	tx, err := c.Contract.Transfer(opts)
+	txOpts := *opts
+	txOpts.Context = ctx
+	tx, err := c.Contract.Transfer(&txOpts)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -132,10 +219,13 @@ func (c *Contract) Transfer(opts *bind.TransactOpts) (common.Hash, error) {
 }
 
 // Estimate the expected and safe gas limits for a contract transaction
-func (c *Contract) estimateGasLimit(opts *bind.TransactOpts, input []byte) (uint64, uint64, error) {
+func (c *Contract) estimateGasLimit(ctx context.Context, opts *bind.TransactOpts, method string, input []byte) (uint64, uint64, error) {
+	if err := c.checkClient(method); err != nil {
+		return 0, 0, err
+	}
 
 	// Estimate gas limit
-	gasLimit, err := c.Client.EstimateGas(context.Background(), ethereum.CallMsg{
+	gasLimit, err := c.Client.EstimateGas(ctx, ethereum.CallMsg{
 		From:     opts.From,
 		To:       c.Address,
 		GasPrice: big.NewInt(0), // use 0 gwei for simulation
@@ -144,7 +234,7 @@ func (c *Contract) estimateGasLimit(opts *bind.TransactOpts, input []byte) (uint
 	})
 
 	if err != nil {
-		return 0, 0, fmt.Errorf("Could not estimate gas needed: %w", err)
+		return 0, 0, fmt.Errorf("Could not estimate gas needed: %w", c.decodeRevertError(method, err))
 	}
 
 	// Pad and return gas limit
@@ -159,25 +249,6 @@ func (c *Contract) estimateGasLimit(opts *bind.TransactOpts, input []byte) (uint
 
 }
 
-// Wait for a transaction to be mined and get a tx receipt
-func (c *Contract) getTransactionReceipt(tx *types.Transaction) (*types.Receipt, error) {
-
-	// Wait for transaction to be mined
-	txReceipt, err := bind.WaitMined(context.Background(), c.Client, tx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Check transaction status
-	if txReceipt.Status == 0 {
-		return txReceipt, errors.New("Transaction failed with status 0")
-	}
-
-	// Return
-	return txReceipt, nil
-
-}
-
 // Get contract events from a transaction
 // eventPrototype must be an event struct type
 // Returns a slice of untyped values; assert returned events to event struct type
@@ -192,7 +263,7 @@ func (c *Contract) GetTransactionEvents(txReceipt *types.Receipt, eventName stri
 	// Get ABI event
 	abiEvent, ok := c.ABI.Events[eventName]
 	if !ok {
-		return nil, fmt.Errorf("Event '%s' does not exist on contract", eventName)
+		return nil, &EventNotFoundError{Contract: c.Name, Event: eventName}
 	}
 
 	// Process transaction receipt logs
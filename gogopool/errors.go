@@ -0,0 +1,163 @@
+package gogopool
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// Error kinds, for use with errors.Is
+var (
+	ErrContractNotRegistered = errors.New("Contract is not registered")
+	ErrMethodNotFound        = errors.New("Method does not exist on contract")
+	ErrEventNotFound         = errors.New("Event does not exist on contract")
+	ErrReverted              = errors.New("Execution reverted")
+	ErrTransactionFailed     = errors.New("Transaction failed")
+	ErrBlockNotFound         = errors.New("Block not found")
+	ErrStateUnavailable      = errors.New("State is unavailable")
+	ErrChainMismatch         = errors.New("Chain ID does not match")
+	ErrNoClient              = errors.New("No client is available")
+	ErrNoClientsAvailable    = client.ErrNoClientsAvailable
+)
+
+// A contract which has no address or ABI registered in GoGoStorage or the manifest
+type ContractNotRegisteredError struct {
+	Contract string
+}
+
+func (e *ContractNotRegisteredError) Error() string {
+	return fmt.Sprintf("Contract %s is not registered", e.Contract)
+}
+
+func (e *ContractNotRegisteredError) Is(target error) bool {
+	return target == ErrContractNotRegistered
+}
+
+// A method which is missing from a contract ABI
+type MethodNotFoundError struct {
+	Contract string
+	Method   string
+}
+
+func (e *MethodNotFoundError) Error() string {
+	return fmt.Sprintf("Method '%s' does not exist on contract %s", e.Method, e.Contract)
+}
+
+func (e *MethodNotFoundError) Is(target error) bool {
+	return target == ErrMethodNotFound
+}
+
+// An event which is missing from a contract ABI
+type EventNotFoundError struct {
+	Contract string
+	Event    string
+}
+
+func (e *EventNotFoundError) Error() string {
+	return fmt.Sprintf("Event '%s' does not exist on contract %s", e.Event, e.Contract)
+}
+
+func (e *EventNotFoundError) Is(target error) bool {
+	return target == ErrEventNotFound
+}
+
+// A transaction which was mined with status 0
+type TransactionFailedError struct {
+	Hash common.Hash
+}
+
+func (e *TransactionFailedError) Error() string {
+	return fmt.Sprintf("Transaction %s failed with status 0", e.Hash.Hex())
+}
+
+func (e *TransactionFailedError) Is(target error) bool {
+	return target == ErrTransactionFailed
+}
+
+// A time before the first block
+type BlockNotFoundError struct {
+	Time time.Time
+}
+
+func (e *BlockNotFoundError) Error() string {
+	return fmt.Sprintf("No block exists at or before %s", e.Time.UTC().Format(time.RFC3339))
+}
+
+func (e *BlockNotFoundError) Is(target error) bool {
+	return target == ErrBlockNotFound
+}
+
+// A block whose state has been pruned by the client; reading it requires an archive node
+type StateUnavailableError struct {
+	Block uint64
+	Err   error
+}
+
+func (e *StateUnavailableError) Error() string {
+	return fmt.Sprintf("State at block %d is unavailable, an archive node is required: %s", e.Block, e.Err.Error())
+}
+
+func (e *StateUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+func (e *StateUnavailableError) Is(target error) bool {
+	return target == ErrStateUnavailable
+}
+
+// A manifest taken on a different chain than the client is connected to
+type ChainMismatchError struct {
+	Expected uint64
+	Actual   uint64
+}
+
+func (e *ChainMismatchError) Error() string {
+	return fmt.Sprintf("Manifest is for chain %d, but the client is connected to chain %d", e.Expected, e.Actual)
+}
+
+func (e *ChainMismatchError) Is(target error) bool {
+	return target == ErrChainMismatch
+}
+
+// A contract call which needs the network, made on an offline contract manager with no client
+type NoClientError struct {
+	Contract string
+	Method   string
+}
+
+func (e *NoClientError) Error() string {
+	return fmt.Sprintf("Could not call method '%s' on contract %s: no client is available", e.Method, e.Contract)
+}
+
+func (e *NoClientError) Is(target error) bool {
+	return target == ErrNoClient
+}
+
+// Check if an error was caused by the client missing historical state
+func IsMissingStateError(err error) bool {
+	return client.IsMissingStateError(err)
+}
+
+func (e *RevertError) Is(target error) bool {
+	return target == ErrReverted
+}
+
+// Check that the contract has a client to make network calls with
+func (c *Contract) checkClient(method string) error {
+	if c.Client == nil {
+		return &NoClientError{Contract: c.Name, Method: method}
+	}
+	return nil
+}
+
+// Check that a method exists on the contract ABI
+func (c *Contract) checkMethod(method string) error {
+	if _, ok := c.ABI.Methods[method]; !ok {
+		return &MethodNotFoundError{Contract: c.Name, Method: method}
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package gogopool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+	"github.com/multisig-labs/gogopool-go/utils/units"
+)
+
+// Fee oracle settings
+const (
+	FeeHistoryBlockCount = 20
+	AvalancheMinBaseFee  = 1000000000 // 1 nAVAX, since the Etna upgrade (ACP-125)
+)
+
+// Avalanche C-Chain IDs
+const (
+	AvalancheMainnetChainID = 43114
+	AvalancheFujiChainID    = 43113
+)
+
+// The minimum base fee enforced by each chain, by chain ID
+// Callers can override it with FeeOracle.MinBaseFee, e.g. if a chain's minimum changes
+var ChainMinBaseFees = map[uint64]*big.Int{
+	AvalancheMainnetChainID: big.NewInt(AvalancheMinBaseFee),
+	AvalancheFujiChainID:    big.NewInt(AvalancheMinBaseFee),
+}
+
+// Fee presets
+type FeeSpeed string
+
+const (
+	FeeSpeedSlow   FeeSpeed = "slow"
+	FeeSpeedNormal FeeSpeed = "normal"
+	FeeSpeedFast   FeeSpeed = "fast"
+)
+
+// The fee presets in order
+var FeeSpeeds = []FeeSpeed{FeeSpeedSlow, FeeSpeedNormal, FeeSpeedFast}
+
+// The priority fee reward percentile and base fee multiplier of each preset
+var feeSpeedSettings = map[FeeSpeed]struct {
+	percentile        float64
+	baseFeeMultiplier int64
+}{
+	FeeSpeedSlow:   {10, 1},
+	FeeSpeedNormal: {50, 2},
+	FeeSpeedFast:   {90, 3},
+}
+
+// Fees for a preset
+type FeePreset struct {
+	Speed     FeeSpeed `json:"speed"`
+	GasTipCap *big.Int `json:"gasTipCap"`
+	GasFeeCap *big.Int `json:"gasFeeCap"`
+}
+
+// Fee estimates for the next block
+type FeeEstimates struct {
+	BaseFee *big.Int               `json:"baseFee"`
+	Presets map[FeeSpeed]FeePreset `json:"presets"`
+}
+
+// The expected & maximum cost of a transaction at a preset, in wei and AVAX
+type GasCost struct {
+	GasTipCap       *big.Int `json:"gasTipCap"`
+	GasFeeCap       *big.Int `json:"gasFeeCap"`
+	ExpectedCost    *big.Int `json:"expectedCost"`
+	MaxCost         *big.Int `json:"maxCost"`
+	ExpectedCostEth float64  `json:"expectedCostEth"`
+	MaxCostEth      float64  `json:"maxCostEth"`
+}
+
+// Suggests EIP-1559 fees from the recent fee market
+// MinBaseFee is a floor for the estimated base fee which overrides the chain's; if nil, the floor in ChainMinBaseFees for the client's chain is used
+type FeeOracle struct {
+	Client     *client.EthClientProxy
+	BlockCount uint64
+	MinBaseFee *big.Int
+}
+
+// Create a new fee oracle, respecting the minimum base fee of the client's chain
+func NewFeeOracle(client *client.EthClientProxy) *FeeOracle {
+	return &FeeOracle{
+		Client:     client,
+		BlockCount: FeeHistoryBlockCount,
+	}
+}
+
+// Set the fees of a preset on transaction options
+func (p FeePreset) Apply(opts *bind.TransactOpts) {
+	opts.GasPrice = nil
+	opts.GasTipCap = new(big.Int).Set(p.GasTipCap)
+	opts.GasFeeCap = new(big.Int).Set(p.GasFeeCap)
+}
+
+// Get fee estimates for each preset
+// Priority fees are taken from eth_feeHistory reward percentiles, and are no lower than the node's suggestion for normal & fast presets
+func (o *FeeOracle) GetFees() (FeeEstimates, error) {
+	return o.GetFeesContext(context.Background())
+}
+func (o *FeeOracle) GetFeesContext(ctx context.Context) (FeeEstimates, error) {
+
+	// Get suggested priority fee
+	suggestedTip, err := o.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return FeeEstimates{}, fmt.Errorf("Could not get suggested priority fee: %w", err)
+	}
+
+	// Get fee history; nodes without eth_feeHistory fall back to the suggested priority fee & latest base fee
+	percentiles := make([]float64, len(FeeSpeeds))
+	for si, speed := range FeeSpeeds {
+		percentiles[si] = feeSpeedSettings[speed].percentile
+	}
+	var baseFee *big.Int
+	tips := make(map[FeeSpeed]*big.Int, len(FeeSpeeds))
+	if history, err := o.Client.FeeHistory(ctx, o.BlockCount, nil, percentiles); err == nil && len(history.BaseFee) > 0 {
+		baseFee = history.BaseFee[len(history.BaseFee)-1]
+		for si, speed := range FeeSpeeds {
+			tips[speed] = medianReward(history.Reward, si)
+		}
+	} else {
+		header, err := o.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return FeeEstimates{}, fmt.Errorf("Could not get latest block header: %w", err)
+		}
+		baseFee = header.BaseFee
+	}
+
+	// Respect the minimum base fee
+	minBaseFee, err := o.getMinBaseFee(ctx)
+	if err != nil {
+		return FeeEstimates{}, err
+	}
+	if baseFee == nil || (minBaseFee != nil && baseFee.Cmp(minBaseFee) < 0) {
+		baseFee = new(big.Int)
+		if minBaseFee != nil {
+			baseFee.Set(minBaseFee)
+		}
+	}
+
+	// Build presets
+	estimates := FeeEstimates{
+		BaseFee: baseFee,
+		Presets: make(map[FeeSpeed]FeePreset, len(FeeSpeeds)),
+	}
+	for _, speed := range FeeSpeeds {
+		tip := tips[speed]
+		if tip == nil || (speed != FeeSpeedSlow && tip.Cmp(suggestedTip) < 0) {
+			tip = new(big.Int).Set(suggestedTip)
+		}
+		feeCap := new(big.Int).Mul(baseFee, big.NewInt(feeSpeedSettings[speed].baseFeeMultiplier))
+		feeCap.Add(feeCap, tip)
+		estimates.Presets[speed] = FeePreset{
+			Speed:     speed,
+			GasTipCap: tip,
+			GasFeeCap: feeCap,
+		}
+	}
+	return estimates, nil
+
+}
+
+// Get the minimum base fee set on the oracle, or enforced by the client's chain
+func (o *FeeOracle) getMinBaseFee(ctx context.Context) (*big.Int, error) {
+	if o.MinBaseFee != nil {
+		return o.MinBaseFee, nil
+	}
+	chainId, err := o.Client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get chain ID: %w", err)
+	}
+	if !chainId.IsUint64() {
+		return nil, nil
+	}
+	return ChainMinBaseFees[chainId.Uint64()], nil
+}
+
+// Get the expected & maximum cost of a transaction at each preset
+func (e FeeEstimates) GetGasCosts(estGasLimit uint64, safeGasLimit uint64) map[FeeSpeed]GasCost {
+	costs := make(map[FeeSpeed]GasCost, len(e.Presets))
+	for speed, preset := range e.Presets {
+
+		// Expected fee is the base fee plus the tip, up to the fee cap
+		expectedFee := new(big.Int).Add(e.BaseFee, preset.GasTipCap)
+		if expectedFee.Cmp(preset.GasFeeCap) > 0 {
+			expectedFee.Set(preset.GasFeeCap)
+		}
+		expectedCost := new(big.Int).Mul(expectedFee, new(big.Int).SetUint64(estGasLimit))
+		maxCost := new(big.Int).Mul(preset.GasFeeCap, new(big.Int).SetUint64(safeGasLimit))
+
+		costs[speed] = GasCost{
+			GasTipCap:       preset.GasTipCap,
+			GasFeeCap:       preset.GasFeeCap,
+			ExpectedCost:    expectedCost,
+			MaxCost:         maxCost,
+			ExpectedCostEth: units.WeiToEth(expectedCost),
+			MaxCostEth:      units.WeiToEth(maxCost),
+		}
+
+	}
+	return costs
+}
+
+// Add the expected & maximum cost at each preset to gas info
+func (o *FeeOracle) AddGasCosts(gasInfo *GasInfo) error {
+	return o.AddGasCostsContext(context.Background(), gasInfo)
+}
+func (o *FeeOracle) AddGasCostsContext(ctx context.Context, gasInfo *GasInfo) error {
+	estimates, err := o.GetFeesContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Could not get fee estimates: %w", err)
+	}
+	gasInfo.Costs = estimates.GetGasCosts(gasInfo.EstGasLimit, gasInfo.SafeGasLimit)
+	return nil
+}
+
+// Get the fees set on transaction options, using the normal preset for any that aren't set
+func (o *FeeOracle) GetTransactionFees(opts *bind.TransactOpts) (*big.Int, *big.Int, error) {
+	return o.GetTransactionFeesContext(TransactOptsContext(opts), opts)
+}
+func (o *FeeOracle) GetTransactionFeesContext(ctx context.Context, opts *bind.TransactOpts) (*big.Int, *big.Int, error) {
+	gasTipCap := opts.GasTipCap
+	gasFeeCap := opts.GasFeeCap
+	if gasTipCap != nil && gasFeeCap != nil {
+		return gasTipCap, gasFeeCap, nil
+	}
+	fees, err := o.GetFeesContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if gasTipCap == nil {
+		gasTipCap = fees.Presets[FeeSpeedNormal].GasTipCap
+	}
+	if gasFeeCap == nil {
+		gasFeeCap = fees.Presets[FeeSpeedNormal].GasFeeCap
+	}
+	if gasFeeCap.Cmp(gasTipCap) < 0 {
+		gasFeeCap = gasTipCap
+	}
+	return gasTipCap, gasFeeCap, nil
+}
+
+// Get the median reward at a percentile index across fee history blocks
+func medianReward(rewards [][]*big.Int, index int) *big.Int {
+	values := []*big.Int{}
+	for _, blockRewards := range rewards {
+		if index < len(blockRewards) && blockRewards[index] != nil {
+			values = append(values, blockRewards[index])
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Cmp(values[j]) < 0 })
+	return new(big.Int).Set(values[len(values)/2])
+}
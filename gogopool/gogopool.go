@@ -1,6 +1,7 @@
 package gogopool
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
@@ -17,7 +18,7 @@ import (
 )
 
 // Cache settings
-const CacheTTL = 300 // 5 minutes
+const CacheTTL = 300 // 5 minutes, or the fallback TTL while watching for upgrades
 
 // Cached data types
 type cachedAddress struct {
@@ -44,10 +45,28 @@ type GoGoPool struct {
 	addressesLock       sync.RWMutex
 	abisLock            sync.RWMutex
 	contractsLock       sync.RWMutex
+	multicall           *Contract
+	multicallLock       sync.RWMutex
+	cacheSyncBlock      uint64
+	cacheSyncTime       int64
+	cacheSyncLock       sync.Mutex
+	cache               Cache
+	cachePrefix         string
+	cacheTTL            int64
+	cacheLock           sync.RWMutex
+	manifest            *manifestRegistry
+	contractNames       ContractNames
+	blockResolver       *BlockResolver
+	blockResolverLock   sync.Mutex
+	receiptDecoder      *cachedReceiptDecoder
+	receiptDecoderLock  sync.Mutex
+	upgradeHistory      *upgradeHistory
+	upgradeHistoryLock  sync.Mutex
 }
 
 // Create new contract manager
-func NewGoGoPool(client *client.EthClientProxy, gogoStorageAddress common.Address) (*GoGoPool, error) {
+// Contract names default to DefaultContractNames, with any roles in contractNames overridden
+func NewGoGoPool(client *client.EthClientProxy, gogoStorageAddress common.Address, contractNames ...ContractNames) (*GoGoPool, error) {
 
 	// Initialize GoGoStorage contract
 	gogoStorage, err := contracts.NewGoGoStorage(gogoStorageAddress, client)
@@ -65,6 +84,7 @@ func NewGoGoPool(client *client.EthClientProxy, gogoStorageAddress common.Addres
 		Address:  &gogoStorageAddress,
 		ABI:      &rsAbi,
 		Client:   client,
+		Name:     "GoGoStorage",
 	}
 
 	// Create and return
@@ -75,39 +95,64 @@ func NewGoGoPool(client *client.EthClientProxy, gogoStorageAddress common.Addres
 		addresses:           make(map[string]cachedAddress),
 		abis:                make(map[string]cachedABI),
 		contracts:           make(map[string]cachedContract),
+		contractNames:       newContractNames(contractNames...),
 	}, nil
 
 }
 
 // Load GoGo Pool contract addresses
 func (ggp *GoGoPool) GetAddress(contractName string) (*common.Address, error) {
+	return ggp.GetAddressContext(context.Background(), contractName)
+}
+func (ggp *GoGoPool) GetAddressContext(ctx context.Context, contractName string) (*common.Address, error) {
+
+	// Resolve from manifest if loaded
+	if ggp.manifest != nil {
+		return ggp.manifest.getAddress(contractName)
+	}
 
 	// Check for cached address
 	if cached, ok := ggp.getCachedAddress(contractName); ok {
-		if time.Now().Unix()-cached.time <= CacheTTL {
+		if time.Now().Unix()-cached.time <= ggp.getCacheTTL() {
 			return cached.address, nil
 		} else {
 			ggp.deleteCachedAddress(contractName)
 		}
 	}
 
+	// Check for persisted address
+	if address, ok := ggp.getPersistedAddress(contractName); ok {
+		ggp.setCachedAddress(contractName, cachedAddress{
+			address: &address,
+			time:    time.Now().Unix(),
+		})
+		return &address, nil
+	}
+
 	// Get address
-	address, err := ggp.GoGoStorage.GetAddress(nil, crypto.Keccak256Hash([]byte("contract.address"), []byte(contractName)))
+	address, err := ggp.GoGoStorage.GetAddress(&bind.CallOpts{Context: ctx}, crypto.Keccak256Hash([]byte("contract.address"), []byte(contractName)))
 	if err != nil {
 		return nil, fmt.Errorf("Could not load contract %s address: %w", contractName, err)
 	}
+	if address == (common.Address{}) {
+		return nil, fmt.Errorf("Could not load contract %s address: %w", contractName, &ContractNotRegisteredError{Contract: contractName})
+	}
 
 	// Cache address
 	ggp.setCachedAddress(contractName, cachedAddress{
 		address: &address,
 		time:    time.Now().Unix(),
 	})
+	ggp.persistAddress(contractName, address)
 
 	// Return
 	return &address, nil
 
 }
 func (ggp *GoGoPool) GetAddresses(contractNames ...string) ([]*common.Address, error) {
+	return ggp.GetAddressesContext(context.Background(), contractNames...)
+}
+func (ggp *GoGoPool) GetAddressesContext(ctx context.Context, contractNames ...string) ([]*common.Address, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -117,7 +162,7 @@ func (ggp *GoGoPool) GetAddresses(contractNames ...string) ([]*common.Address, e
 	for ci, contractName := range contractNames {
 		ci, contractName := ci, contractName
 		wg.Go(func() error {
-			address, err := ggp.GetAddress(contractName)
+			address, err := ggp.GetAddressContext(ctx, contractName)
 			if err == nil {
 				addresses[ci] = address
 			}
@@ -137,27 +182,52 @@ func (ggp *GoGoPool) GetAddresses(contractNames ...string) ([]*common.Address, e
 
 // Load GoGo Pool contract ABIs
 func (ggp *GoGoPool) GetABI(contractName string) (*abi.ABI, error) {
+	return ggp.GetABIContext(context.Background(), contractName)
+}
+func (ggp *GoGoPool) GetABIContext(ctx context.Context, contractName string) (*abi.ABI, error) {
+
+	// Resolve from manifest if loaded
+	if ggp.manifest != nil {
+		return ggp.manifest.getABI(contractName)
+	}
 
 	// Check for cached ABI
 	if cached, ok := ggp.getCachedABI(contractName); ok {
-		if time.Now().Unix()-cached.time <= CacheTTL {
+		if time.Now().Unix()-cached.time <= ggp.getCacheTTL() {
 			return cached.abi, nil
 		} else {
 			ggp.deleteCachedABI(contractName)
 		}
 	}
 
-	// Get ABI
-	abiEncoded, err := ggp.GoGoStorage.GetString(nil, crypto.Keccak256Hash([]byte("contract.abi"), []byte(contractName)))
-	if err != nil {
-		return nil, fmt.Errorf("Could not load contract %s ABI: %w", contractName, err)
+	// Check for persisted ABI
+	abiStr, ok := ggp.getPersistedABI(contractName)
+	if !ok {
+
+		// Get ABI
+		abiEncoded, err := ggp.GoGoStorage.GetString(&bind.CallOpts{Context: ctx}, crypto.Keccak256Hash([]byte("contract.abi"), []byte(contractName)))
+		if err != nil {
+			return nil, fmt.Errorf("Could not load contract %s ABI: %w", contractName, err)
+		}
+		if abiEncoded == "" {
+			return nil, fmt.Errorf("Could not load contract %s ABI: %w", contractName, &ContractNotRegisteredError{Contract: contractName})
+		}
+
+		// Decompress ABI
+		abiStr, err = DecompressAbi(abiEncoded)
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode contract %s ABI: %w", contractName, err)
+		}
+		ggp.persistABI(contractName, abiStr)
+
 	}
 
-	// Decode ABI
-	abi, err := DecodeAbi(abiEncoded)
+	// Parse ABI
+	abiParsed, err := abi.JSON(strings.NewReader(abiStr))
 	if err != nil {
 		return nil, fmt.Errorf("Could not decode contract %s ABI: %w", contractName, err)
 	}
+	abi := &abiParsed
 
 	// Cache ABI
 	ggp.setCachedABI(contractName, cachedABI{
@@ -170,6 +240,9 @@ func (ggp *GoGoPool) GetABI(contractName string) (*abi.ABI, error) {
 
 }
 func (ggp *GoGoPool) GetABIs(contractNames ...string) ([]*abi.ABI, error) {
+	return ggp.GetABIsContext(context.Background(), contractNames...)
+}
+func (ggp *GoGoPool) GetABIsContext(ctx context.Context, contractNames ...string) ([]*abi.ABI, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -179,7 +252,7 @@ func (ggp *GoGoPool) GetABIs(contractNames ...string) ([]*abi.ABI, error) {
 	for ci, contractName := range contractNames {
 		ci, contractName := ci, contractName
 		wg.Go(func() error {
-			abi, err := ggp.GetABI(contractName)
+			abi, err := ggp.GetABIContext(ctx, contractName)
 			if err == nil {
 				abis[ci] = abi
 			}
@@ -199,10 +272,13 @@ func (ggp *GoGoPool) GetABIs(contractNames ...string) ([]*abi.ABI, error) {
 
 // Load GoGo Pool contracts
 func (ggp *GoGoPool) GetContract(contractName string) (*Contract, error) {
+	return ggp.GetContractContext(context.Background(), contractName)
+}
+func (ggp *GoGoPool) GetContractContext(ctx context.Context, contractName string) (*Contract, error) {
 
 	// Check for cached contract
 	if cached, ok := ggp.getCachedContract(contractName); ok {
-		if time.Now().Unix()-cached.time <= CacheTTL {
+		if time.Now().Unix()-cached.time <= ggp.getCacheTTL() {
 			return cached.contract, nil
 		} else {
 			ggp.deleteCachedContract(contractName)
@@ -217,12 +293,12 @@ func (ggp *GoGoPool) GetContract(contractName string) (*Contract, error) {
 	// Load data
 	wg.Go(func() error {
 		var err error
-		address, err = ggp.GetAddress(contractName)
+		address, err = ggp.GetAddressContext(ctx, contractName)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		abi, err = ggp.GetABI(contractName)
+		abi, err = ggp.GetABIContext(ctx, contractName)
 		return err
 	})
 
@@ -237,6 +313,7 @@ func (ggp *GoGoPool) GetContract(contractName string) (*Contract, error) {
 		Address:  address,
 		ABI:      abi,
 		Client:   ggp.Client,
+		Name:     contractName,
 	}
 
 	// Cache contract
@@ -250,6 +327,9 @@ func (ggp *GoGoPool) GetContract(contractName string) (*Contract, error) {
 
 }
 func (ggp *GoGoPool) GetContracts(contractNames ...string) ([]*Contract, error) {
+	return ggp.GetContractsContext(context.Background(), contractNames...)
+}
+func (ggp *GoGoPool) GetContractsContext(ctx context.Context, contractNames ...string) ([]*Contract, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -259,7 +339,7 @@ func (ggp *GoGoPool) GetContracts(contractNames ...string) ([]*Contract, error)
 	for ci, contractName := range contractNames {
 		ci, contractName := ci, contractName
 		wg.Go(func() error {
-			contract, err := ggp.GetContract(contractName)
+			contract, err := ggp.GetContractContext(ctx, contractName)
 			if err == nil {
 				contracts[ci] = contract
 			}
@@ -279,9 +359,12 @@ func (ggp *GoGoPool) GetContracts(contractNames ...string) ([]*Contract, error)
 
 // Create a GoGo Pool contract instance
 func (ggp *GoGoPool) MakeContract(contractName string, address common.Address) (*Contract, error) {
+	return ggp.MakeContractContext(context.Background(), contractName, address)
+}
+func (ggp *GoGoPool) MakeContractContext(ctx context.Context, contractName string, address common.Address) (*Contract, error) {
 
 	// Load ABI
-	abi, err := ggp.GetABI(contractName)
+	abi, err := ggp.GetABIContext(ctx, contractName)
 	if err != nil {
 		return nil, err
 	}
@@ -292,6 +375,7 @@ func (ggp *GoGoPool) MakeContract(contractName string, address common.Address) (
 		Address:  &address,
 		ABI:      abi,
 		Client:   ggp.Client,
+		Name:     contractName,
 	}, nil
 
 }
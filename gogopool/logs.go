@@ -0,0 +1,73 @@
+package gogopool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Log query settings
+const DefaultLogIntervalSize = 1000 // The number of blocks per log query when scanning the protocol's history
+
+// Get the logs matching a query, breaking its block range into intervals of intervalSize blocks
+// FromBlock defaults to the GoGo Pool deployment block, and ToBlock to the latest block; the range is queried in a single call if intervalSize is nil
+func (ggp *GoGoPool) GetLogs(query ethereum.FilterQuery, intervalSize *big.Int) ([]types.Log, error) {
+	return ggp.GetLogsContext(context.Background(), query, intervalSize)
+}
+func (ggp *GoGoPool) GetLogsContext(ctx context.Context, query ethereum.FilterQuery, intervalSize *big.Int) ([]types.Log, error) {
+
+	// Query a single block directly
+	if query.BlockHash != nil {
+		return ggp.Client.FilterLogs(ctx, query)
+	}
+
+	// Get the block that GoGo Pool was deployed on as the lower bound if one wasn't specified
+	if query.FromBlock == nil {
+		deployBlock, err := ggp.GoGoStorage.GetUint(&bind.CallOpts{Context: ctx}, crypto.Keccak256Hash([]byte("deploy.block")))
+		if err != nil {
+			return nil, fmt.Errorf("Could not get deployment block: %w", err)
+		}
+		query.FromBlock = deployBlock
+	}
+
+	// Handle unlimited intervals with a single call
+	if intervalSize == nil || intervalSize.Sign() <= 0 {
+		return ggp.Client.FilterLogs(ctx, query)
+	}
+
+	// Get the latest block
+	toBlock := query.ToBlock
+	if toBlock == nil {
+		latestBlock, err := ggp.Client.BlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get latest block: %w", err)
+		}
+		toBlock = new(big.Int).SetUint64(latestBlock)
+	}
+
+	// Get the logs in each interval, clamping on the last block
+	logs := []types.Log{}
+	for start := new(big.Int).Set(query.FromBlock); start.Cmp(toBlock) <= 0; {
+		end := new(big.Int).Add(start, intervalSize)
+		end.Sub(end, big.NewInt(1))
+		if end.Cmp(toBlock) > 0 {
+			end.Set(toBlock)
+		}
+		intervalQuery := query
+		intervalQuery.FromBlock = start
+		intervalQuery.ToBlock = end
+		intervalLogs, err := ggp.Client.FilterLogs(ctx, intervalQuery)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, intervalLogs...)
+		start = new(big.Int).Add(end, big.NewInt(1))
+	}
+	return logs, nil
+
+}
@@ -0,0 +1,199 @@
+package gogopool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// A snapshot of a GoGo Pool deployment's contract addresses & ABIs
+type Manifest struct {
+	StorageAddress common.Address              `json:"storageAddress"`
+	ChainID        uint64                      `json:"chainId,omitempty"`
+	Block          uint64                      `json:"block,omitempty"`
+	Contracts      map[string]ManifestContract `json:"contracts"`
+}
+
+// A contract in a deployment manifest
+type ManifestContract struct {
+	Address common.Address  `json:"address"`
+	ABI     json.RawMessage `json:"abi"`
+}
+
+// Parsed manifest contract data
+type manifestRegistry struct {
+	addresses map[string]common.Address
+	abis      map[string]*abi.ABI
+}
+
+// Load a deployment manifest from a JSON file
+func LoadManifest(path string) (*Manifest, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read manifest file %s: %w", path, err)
+	}
+	manifest := new(Manifest)
+	if err := json.Unmarshal(bytes, manifest); err != nil {
+		return nil, fmt.Errorf("Could not decode manifest file %s: %w", path, err)
+	}
+	return manifest, nil
+}
+
+// Write a deployment manifest to a JSON file
+func (m *Manifest) Save(path string) error {
+	bytes, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Could not encode manifest: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("Could not write manifest file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Create new contract manager which resolves contracts from a deployment manifest instead of GoGoStorage
+// The client may be nil on offline machines, in which case contracts can only be used to encode & decode data
+// and calls which need the network return ErrNoClient
+// If the manifest has a chain ID and a client is given, the client must be connected to the same chain
+func NewGoGoPoolFromManifest(client *client.EthClientProxy, manifest *Manifest, contractNames ...ContractNames) (*GoGoPool, error) {
+	return NewGoGoPoolFromManifestContext(context.Background(), client, manifest, contractNames...)
+}
+func NewGoGoPoolFromManifestContext(ctx context.Context, client *client.EthClientProxy, manifest *Manifest, contractNames ...ContractNames) (*GoGoPool, error) {
+
+	// Check the chain ID
+	if client != nil && manifest.ChainID != 0 {
+		chainId, err := client.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get chain ID to check manifest: %w", err)
+		}
+		if !chainId.IsUint64() || chainId.Uint64() != manifest.ChainID {
+			return nil, &ChainMismatchError{Expected: manifest.ChainID, Actual: chainId.Uint64()}
+		}
+	}
+
+	// Parse manifest contracts
+	registry := &manifestRegistry{
+		addresses: make(map[string]common.Address, len(manifest.Contracts)),
+		abis:      make(map[string]*abi.ABI, len(manifest.Contracts)),
+	}
+	for contractName, contract := range manifest.Contracts {
+		contractAbi, err := abi.JSON(strings.NewReader(string(contract.ABI)))
+		if err != nil {
+			return nil, fmt.Errorf("Could not parse manifest contract %s ABI: %w", contractName, err)
+		}
+		registry.addresses[contractName] = contract.Address
+		registry.abis[contractName] = &contractAbi
+	}
+
+	// Create contract manager
+	ggp, err := NewGoGoPool(client, manifest.StorageAddress, contractNames...)
+	if err != nil {
+		return nil, err
+	}
+	ggp.manifest = registry
+	return ggp, nil
+
+}
+
+// Export a deployment manifest of the given contracts from GoGoStorage
+// The manifest is taken at opts.BlockNumber if set, otherwise at the latest block
+// Contracts without a registered ABI are rejected; contracts with an ABI but no address (e.g. minipools) are exported with a zero address
+func (ggp *GoGoPool) ExportManifest(contractNames []string, opts *bind.CallOpts) (*Manifest, error) {
+	return ggp.ExportManifestContext(CallOptsContext(opts), contractNames, opts)
+}
+func (ggp *GoGoPool) ExportManifestContext(ctx context.Context, contractNames []string, opts *bind.CallOpts) (*Manifest, error) {
+
+	// Pin the block so every contract is read from the same state
+	var callOpts bind.CallOpts
+	if opts != nil {
+		callOpts = *opts
+	}
+	callOpts.Context = ctx
+	if callOpts.BlockNumber == nil {
+		blockNumber, err := ggp.Client.BlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get latest block for manifest: %w", err)
+		}
+		callOpts.BlockNumber = new(big.Int).SetUint64(blockNumber)
+	}
+
+	// Get chain ID
+	chainId, err := ggp.Client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get chain ID for manifest: %w", err)
+	}
+
+	// Load contracts
+	var wg errgroup.Group
+	manifestContracts := make([]ManifestContract, len(contractNames))
+	for ci, contractName := range contractNames {
+		ci, contractName := ci, contractName
+		wg.Go(func() error {
+			address, err := ggp.GoGoStorage.GetAddress(&callOpts, crypto.Keccak256Hash([]byte("contract.address"), []byte(contractName)))
+			if err != nil {
+				return fmt.Errorf("Could not load contract %s address: %w", contractName, err)
+			}
+			abiEncoded, err := ggp.GoGoStorage.GetString(&callOpts, crypto.Keccak256Hash([]byte("contract.abi"), []byte(contractName)))
+			if err != nil {
+				return fmt.Errorf("Could not load contract %s ABI: %w", contractName, err)
+			}
+			if abiEncoded == "" {
+				return fmt.Errorf("Could not load contract %s ABI: %w", contractName, &ContractNotRegisteredError{Contract: contractName})
+			}
+			abiStr, err := DecompressAbi(abiEncoded)
+			if err != nil {
+				return fmt.Errorf("Could not decode contract %s ABI: %w", contractName, err)
+			}
+			manifestContracts[ci] = ManifestContract{
+				Address: address,
+				ABI:     json.RawMessage(abiStr),
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Return
+	manifest := &Manifest{
+		StorageAddress: *ggp.GoGoStorageContract.Address,
+		ChainID:        chainId.Uint64(),
+		Block:          callOpts.BlockNumber.Uint64(),
+		Contracts:      make(map[string]ManifestContract, len(contractNames)),
+	}
+	for ci, contractName := range contractNames {
+		manifest.Contracts[contractName] = manifestContracts[ci]
+	}
+	return manifest, nil
+
+}
+
+// Get a contract address from the manifest
+func (r *manifestRegistry) getAddress(contractName string) (*common.Address, error) {
+	address, ok := r.addresses[contractName]
+	if !ok {
+		return nil, fmt.Errorf("Could not load contract %s address from the manifest: %w", contractName, &ContractNotRegisteredError{Contract: contractName})
+	}
+	return &address, nil
+}
+
+// Get a contract ABI from the manifest
+func (r *manifestRegistry) getABI(contractName string) (*abi.ABI, error) {
+	contractAbi, ok := r.abis[contractName]
+	if !ok {
+		return nil, fmt.Errorf("Could not load contract %s ABI from the manifest: %w", contractName, &ContractNotRegisteredError{Contract: contractName})
+	}
+	return contractAbi, nil
+}
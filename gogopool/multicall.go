@@ -0,0 +1,236 @@
+package gogopool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// Multicall settings
+const (
+	MulticallBatchSize         = 500
+	MulticallFallbackBatchSize = 20
+)
+
+// The canonical Multicall3 deployment address, shared by Avalanche C-Chain and most EVM networks
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// Multicall3 aggregate3 ABI
+const Multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+// Multicall3 aggregate3 input and output types
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// A queued contract call
+type multicallCall struct {
+	contract *Contract
+	method   string
+	params   []interface{}
+	input    []byte
+	result   interface{}
+}
+
+// Queues contract calls and executes them with as few eth_calls as possible
+type MultiCaller struct {
+	ggp   *GoGoPool
+	calls []multicallCall
+}
+
+// Set the Multicall3 contract used to batch bulk reads
+func (ggp *GoGoPool) SetMulticallAddress(address common.Address) error {
+	multicallAbi, err := abi.JSON(strings.NewReader(Multicall3ABI))
+	if err != nil {
+		return fmt.Errorf("Could not parse Multicall3 ABI: %w", err)
+	}
+	ggp.multicallLock.Lock()
+	defer ggp.multicallLock.Unlock()
+	ggp.multicall = &Contract{
+		Contract: bind.NewBoundContract(address, multicallAbi, ggp.Client, ggp.Client, ggp.Client),
+		Address:  &address,
+		ABI:      &multicallAbi,
+		Client:   ggp.Client,
+		Name:     "Multicall3",
+	}
+	return nil
+}
+
+// Get the Multicall3 contract, or nil if none is configured
+func (ggp *GoGoPool) GetMulticallContract() *Contract {
+	ggp.multicallLock.RLock()
+	defer ggp.multicallLock.RUnlock()
+	return ggp.multicall
+}
+
+// Create a new multicaller
+func (ggp *GoGoPool) NewMultiCaller() *MultiCaller {
+	return &MultiCaller{
+		ggp:   ggp,
+		calls: []multicallCall{},
+	}
+}
+
+// Queue a contract call; result is populated when the multicaller is flushed
+func (mc *MultiCaller) AddCall(contract *Contract, result interface{}, method string, params ...interface{}) error {
+	if err := contract.checkMethod(method); err != nil {
+		return err
+	}
+	input, err := contract.ABI.Pack(method, params...)
+	if err != nil {
+		return fmt.Errorf("Could not encode %s call: %w", method, err)
+	}
+	mc.calls = append(mc.calls, multicallCall{
+		contract: contract,
+		method:   method,
+		params:   params,
+		input:    input,
+		result:   result,
+	})
+	return nil
+}
+
+// Get the number of queued calls
+func (mc *MultiCaller) Len() int {
+	return len(mc.calls)
+}
+
+// Execute all queued calls and decode their results
+// Each queued call is reported to the client's observer as a contract call, along with the aggregate3 calls batching them
+func (mc *MultiCaller) Flush(opts *bind.CallOpts) error {
+	return mc.FlushContext(CallOptsContext(opts), opts)
+}
+func (mc *MultiCaller) FlushContext(ctx context.Context, opts *bind.CallOpts) error {
+
+	// Take the queued calls
+	calls := mc.calls
+	mc.calls = []multicallCall{}
+
+	// Fall back to individual calls if no multicall contract is configured
+	multicall := mc.ggp.GetMulticallContract()
+	if multicall == nil {
+		return flushIndividually(ctx, calls, opts)
+	}
+
+	// Execute calls in batches
+	var blockNumber *big.Int
+	if opts != nil {
+		blockNumber = opts.BlockNumber
+	}
+	for bsi := 0; bsi < len(calls); bsi += MulticallBatchSize {
+
+		// Get batch start & end index
+		csi := bsi
+		cei := bsi + MulticallBatchSize
+		if cei > len(calls) {
+			cei = len(calls)
+		}
+		batch := calls[csi:cei]
+
+		// Build aggregate3 input
+		aggregateCalls := make([]multicall3Call, len(batch))
+		for ci, call := range batch {
+			aggregateCalls[ci] = multicall3Call{
+				Target:       *call.contract.Address,
+				AllowFailure: true,
+				CallData:     call.input,
+			}
+		}
+
+		// Report each call in the batch to the observer
+		dones := make([]func(error), len(batch))
+		for ci, call := range batch {
+			_, dones[ci] = call.contract.Client.ObserveContractCall(ctx, client.CallKindCall, call.contract.Name, call.method, blockNumber)
+		}
+
+		// Run aggregate3 & decode results
+		errs := runAggregate(ctx, multicall, opts, batch, aggregateCalls)
+		for ci, err := range errs {
+			dones[ci](err)
+		}
+		for _, err := range errs {
+			if err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// Return
+	return nil
+
+}
+
+// Run an aggregate3 call for a batch of queued calls, and decode each call's result
+// Returns the error of each call, which is the aggregate call's error if it failed
+func runAggregate(ctx context.Context, multicall *Contract, opts *bind.CallOpts, batch []multicallCall, aggregateCalls []multicall3Call) []error {
+	errs := make([]error, len(batch))
+
+	// Run aggregate3
+	results := new([]multicall3Result)
+	err := multicall.CallContext(ctx, opts, results, "aggregate3", aggregateCalls)
+	if err != nil {
+		err = fmt.Errorf("Could not run multicall: %w", err)
+	} else if len(*results) != len(batch) {
+		err = fmt.Errorf("Multicall returned %d results for %d calls", len(*results), len(batch))
+	}
+	if err != nil {
+		for ci := range errs {
+			errs[ci] = err
+		}
+		return errs
+	}
+
+	// Decode results
+	for ci, call := range batch {
+		result := (*results)[ci]
+		if !result.Success {
+			errs[ci] = fmt.Errorf("Multicall to %s on %s failed", call.method, call.contract.Address.Hex())
+		} else if err := call.contract.ABI.UnpackIntoInterface(call.result, call.method, result.ReturnData); err != nil {
+			errs[ci] = fmt.Errorf("Could not decode multicall %s result: %w", call.method, err)
+		}
+	}
+	return errs
+
+}
+
+// Execute queued calls one eth_call at a time, in concurrent batches
+func flushIndividually(ctx context.Context, calls []multicallCall, opts *bind.CallOpts) error {
+	for bsi := 0; bsi < len(calls); bsi += MulticallFallbackBatchSize {
+
+		// Get batch start & end index
+		csi := bsi
+		cei := bsi + MulticallFallbackBatchSize
+		if cei > len(calls) {
+			cei = len(calls)
+		}
+
+		// Run calls
+		var wg errgroup.Group
+		for ci := csi; ci < cei; ci++ {
+			call := calls[ci]
+			wg.Go(func() error {
+				return call.contract.CallContext(ctx, opts, call.result, call.method, call.params...)
+			})
+		}
+		if err := wg.Wait(); err != nil {
+			return err
+		}
+
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package gogopool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// Nonce manager settings
+const (
+	NonceSyncInterval = 30 * time.Second
+	NonceRetryLimit   = 3
+)
+
+// Hands out nonces for a single sending account so that transactions can be sent concurrently
+type NonceManager struct {
+	Client       *client.EthClientProxy
+	Account      common.Address
+	SyncInterval time.Duration
+	next         uint64
+	released     []uint64
+	reserved     map[uint64]time.Time
+	sent         map[uint64]time.Time
+	syncTime     time.Time
+	synced       bool
+	lock         sync.Mutex
+}
+
+// Create a new nonce manager for an account
+func NewNonceManager(client *client.EthClientProxy, account common.Address) *NonceManager {
+	return &NonceManager{
+		Client:       client,
+		Account:      account,
+		SyncInterval: NonceSyncInterval,
+		reserved:     make(map[uint64]time.Time),
+		sent:         make(map[uint64]time.Time),
+	}
+}
+
+// Reserve the next nonce for the account
+// The nonce must be released with ReleaseNonce if the transaction using it is not sent
+func (nm *NonceManager) NextNonce() (uint64, error) {
+	return nm.NextNonceContext(context.Background())
+}
+func (nm *NonceManager) NextNonceContext(ctx context.Context) (uint64, error) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+
+	// Resync if the nonce state is stale
+	if !nm.synced || time.Since(nm.syncTime) > nm.SyncInterval {
+		if err := nm.sync(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	// Reuse the lowest released nonce, or take a new one
+	var nonce uint64
+	if len(nm.released) > 0 {
+		nonce = nm.released[0]
+		nm.released = nm.released[1:]
+	} else {
+		nonce = nm.next
+		nm.next++
+	}
+	nm.reserved[nonce] = time.Now()
+	return nonce, nil
+}
+
+// Return a reserved nonce which was not used by a sent transaction
+func (nm *NonceManager) ReleaseNonce(nonce uint64) {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	delete(nm.reserved, nonce)
+	if nonce+1 == nm.next {
+		nm.next--
+		return
+	}
+	if nonce < nm.next {
+		nm.addReleased(nonce)
+	}
+}
+
+// Resync the nonce state with the network
+// Nonces which were reserved or sent more than SyncInterval ago but are missing from the pending pool are released for reuse
+func (nm *NonceManager) Sync() error {
+	return nm.SyncContext(context.Background())
+}
+func (nm *NonceManager) SyncContext(ctx context.Context) error {
+	nm.lock.Lock()
+	defer nm.lock.Unlock()
+	return nm.sync(ctx)
+}
+
+// Set the next nonce on transaction options
+// Returns a copy of the options; the nonce must be released with ReleaseNonce if the transaction is not sent
+func (nm *NonceManager) TransactOpts(opts *bind.TransactOpts) (*bind.TransactOpts, error) {
+	nonce, err := nm.NextNonceContext(TransactOptsContext(opts))
+	if err != nil {
+		return nil, err
+	}
+	txOpts := *opts
+	txOpts.Nonce = new(big.Int).SetUint64(nonce)
+	return &txOpts, nil
+}
+
+// Send a transaction with the next nonce, recovering from nonce errors
+// send is called with a copy of opts carrying the nonce, e.g. a contract method wrapper
+// The nonce lookup & send go through the same endpoint, so a lagging endpoint can't hand out a stale nonce
+func (nm *NonceManager) Send(opts *bind.TransactOpts, send func(*bind.TransactOpts) (common.Hash, error)) (common.Hash, error) {
+	return nm.SendContext(TransactOptsContext(opts), opts, send)
+}
+func (nm *NonceManager) SendContext(ctx context.Context, opts *bind.TransactOpts, send func(*bind.TransactOpts) (common.Hash, error)) (common.Hash, error) {
+	ctx = client.WithStickyEndpoint(ctx)
+	for attempt := 0; ; attempt++ {
+
+		// Get nonce
+		nonce, err := nm.NextNonceContext(ctx)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		txOpts := *opts
+		txOpts.Context = ctx
+		txOpts.Nonce = new(big.Int).SetUint64(nonce)
+
+		// Send transaction
+		hash, err := send(&txOpts)
+		if err == nil {
+			nm.lock.Lock()
+			delete(nm.reserved, nonce)
+			nm.sent[nonce] = time.Now()
+			nm.lock.Unlock()
+			return hash, nil
+		}
+
+		// Release the nonce, and resync & retry on nonce errors
+		if !isNonceError(err) || attempt >= NonceRetryLimit {
+			nm.ReleaseNonce(nonce)
+			return common.Hash{}, err
+		}
+		if err := nm.SyncContext(ctx); err != nil {
+			return common.Hash{}, err
+		}
+
+	}
+}
+
+// Resync the nonce state with the network
+func (nm *NonceManager) sync(ctx context.Context) error {
+
+	// Get network nonces
+	pendingNonce, err := nm.Client.PendingNonceAt(ctx, nm.Account)
+	if err != nil {
+		return fmt.Errorf("Could not get account %s pending nonce: %w", nm.Account.Hex(), err)
+	}
+	minedNonce, err := nm.Client.NonceAt(ctx, nm.Account, nil)
+	if err != nil {
+		return fmt.Errorf("Could not get account %s nonce: %w", nm.Account.Hex(), err)
+	}
+	if minedNonce > pendingNonce {
+		pendingNonce = minedNonce
+	}
+
+	// Drop released & reserved nonces which have since been used, and sent nonces which have been mined
+	for nonce := range nm.reserved {
+		if nonce < pendingNonce {
+			delete(nm.reserved, nonce)
+		}
+	}
+	for nonce := range nm.sent {
+		if nonce < minedNonce {
+			delete(nm.sent, nonce)
+		}
+	}
+	released := nm.released[:0]
+	for _, nonce := range nm.released {
+		if nonce >= pendingNonce {
+			released = append(released, nonce)
+		}
+	}
+	nm.released = released
+
+	// Skip nonces used outside the manager, or release reserved & sent nonces missing from the pending pool
+	// Recent nonces are kept, as the pending pool may not include them yet (e.g. on another endpoint)
+	if !nm.synced || pendingNonce > nm.next {
+		nm.next = pendingNonce
+	} else {
+		for nonce := pendingNonce; nonce < nm.next; nonce++ {
+			if reservedTime, ok := nm.reserved[nonce]; ok && time.Since(reservedTime) <= nm.SyncInterval {
+				continue
+			}
+			if sentTime, ok := nm.sent[nonce]; ok && time.Since(sentTime) <= nm.SyncInterval {
+				continue
+			}
+			delete(nm.reserved, nonce)
+			delete(nm.sent, nonce)
+			nm.addReleased(nonce)
+		}
+	}
+
+	// Update sync state
+	nm.synced = true
+	nm.syncTime = time.Now()
+	return nil
+
+}
+
+// Add a nonce to the sorted released nonce set
+func (nm *NonceManager) addReleased(nonce uint64) {
+	i := sort.Search(len(nm.released), func(i int) bool { return nm.released[i] >= nonce })
+	if i < len(nm.released) && nm.released[i] == nonce {
+		return
+	}
+	nm.released = append(nm.released, 0)
+	copy(nm.released[i+1:], nm.released[i:])
+	nm.released[i] = nonce
+}
+
+// Check whether an error was caused by a nonce which is already in use
+func isNonceError(err error) bool {
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "nonce too low") || strings.Contains(message, "replacement transaction underpriced")
+}
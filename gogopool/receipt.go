@@ -0,0 +1,395 @@
+package gogopool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/sync/errgroup"
+)
+
+// A transaction log decoded against the GoGo Pool contract ABIs
+// Contract is empty if the log address is unknown and no ABI has a matching event; Event is empty if the log could not be decoded
+type DecodedEvent struct {
+	LogIndex uint                   `json:"logIndex"`
+	Address  common.Address         `json:"address"`
+	Contract string                 `json:"contract"`
+	Event    string                 `json:"event"`
+	Args     map[string]interface{} `json:"args"`
+	Log      types.Log              `json:"-"`
+}
+
+// Decodes transaction receipts against every registered contract ABI, including past contract versions
+// Logs from unknown addresses are only matched against contracts without a fixed address (e.g. minipools)
+type ReceiptDecoder struct {
+	contracts map[common.Address]decoderContract
+	abis      []decoderContract
+}
+
+// A cached receipt decoder
+type cachedReceiptDecoder struct {
+	decoder *ReceiptDecoder
+	time    int64
+}
+
+// The contract upgrade logs scanned so far, kept across receipt decoders and extended from the last scanned block
+type upgradeHistory struct {
+	upgradeAddresses []common.Address
+	logs             []types.Log
+	scannedBlock     uint64
+}
+
+// A contract version known to the receipt decoder
+type decoderContract struct {
+	name string
+	abi  *abi.ABI
+}
+
+// Decode the logs in a transaction receipt
+// The receipt decoder is cached along with contract addresses & ABIs
+func (ggp *GoGoPool) DecodeReceipt(receipt *types.Receipt) ([]DecodedEvent, error) {
+	return ggp.DecodeReceiptContext(context.Background(), receipt)
+}
+func (ggp *GoGoPool) DecodeReceiptContext(ctx context.Context, receipt *types.Receipt) ([]DecodedEvent, error) {
+	decoder, err := ggp.getReceiptDecoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return decoder.DecodeReceipt(receipt), nil
+}
+
+// Create a receipt decoder which can be reused across receipts
+// Past contract versions are loaded from the upgrade history, with their ABIs as of the block before each upgrade
+func (ggp *GoGoPool) NewReceiptDecoder() (*ReceiptDecoder, error) {
+	return ggp.NewReceiptDecoderContext(context.Background())
+}
+func (ggp *GoGoPool) NewReceiptDecoderContext(ctx context.Context) (*ReceiptDecoder, error) {
+
+	// Get unique contract names
+	nameSet := map[string]bool{}
+	for _, contractName := range ggp.GetContractNames() {
+		nameSet[contractName] = true
+	}
+	contractNames := make([]string, 0, len(nameSet))
+	for contractName := range nameSet {
+		contractNames = append(contractNames, contractName)
+	}
+	sort.Strings(contractNames)
+
+	// Load current contracts; unregistered contracts are skipped, and contracts without an address (e.g. minipools) are matched by event only
+	decoder := &ReceiptDecoder{
+		contracts: make(map[common.Address]decoderContract),
+		abis:      []decoderContract{},
+	}
+	var wg errgroup.Group
+	var lock sync.Mutex
+	for _, contractName := range contractNames {
+		contractName := contractName
+		wg.Go(func() error {
+			contractAbi, err := ggp.GetABIContext(ctx, contractName)
+			if errors.Is(err, ErrContractNotRegistered) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			address, err := ggp.GetAddressContext(ctx, contractName)
+			if err != nil && !errors.Is(err, ErrContractNotRegistered) {
+				return err
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			contract := decoderContract{name: contractName, abi: contractAbi}
+			if address != nil && *address != (common.Address{}) {
+				decoder.contracts[*address] = contract
+			} else {
+				decoder.abis = append(decoder.abis, contract)
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	sort.Slice(decoder.abis, func(i, j int) bool { return decoder.abis[i].name < decoder.abis[j].name })
+
+	// Load past contract versions
+	if ggp.manifest == nil {
+		if err := ggp.loadUpgradeHistory(ctx, decoder, contractNames); err != nil {
+			return nil, err
+		}
+	}
+
+	// Return
+	return decoder, nil
+
+}
+
+// Decode the logs in a transaction receipt
+func (d *ReceiptDecoder) DecodeReceipt(receipt *types.Receipt) []DecodedEvent {
+	events := make([]DecodedEvent, len(receipt.Logs))
+	for li, log := range receipt.Logs {
+		events[li] = d.DecodeLog(*log)
+	}
+	return events
+}
+
+// Decode a single log
+func (d *ReceiptDecoder) DecodeLog(log types.Log) DecodedEvent {
+	event := DecodedEvent{
+		LogIndex: log.Index,
+		Address:  log.Address,
+		Log:      log,
+	}
+
+	// Decode against the contract at the log address
+	if contract, ok := d.contracts[log.Address]; ok {
+		event.Contract = contract.name
+		if name, args, ok := decodeLog(contract.abi, log.Topics, log.Data); ok {
+			event.Event = name
+			event.Args = args
+		}
+		return event
+	}
+
+	// Decode against any contract without a fixed address with a matching event
+	for _, contract := range d.abis {
+		if name, args, ok := decodeLog(contract.abi, log.Topics, log.Data); ok {
+			event.Contract = contract.name
+			event.Event = name
+			event.Args = args
+			return event
+		}
+	}
+	return event
+
+}
+
+// Get the cached receipt decoder, or create one if it is missing or expired
+func (ggp *GoGoPool) getReceiptDecoder(ctx context.Context) (*ReceiptDecoder, error) {
+	ggp.receiptDecoderLock.Lock()
+	defer ggp.receiptDecoderLock.Unlock()
+	if ggp.receiptDecoder != nil && time.Now().Unix()-ggp.receiptDecoder.time <= ggp.getCacheTTL() {
+		return ggp.receiptDecoder.decoder, nil
+	}
+	decoder, err := ggp.NewReceiptDecoderContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ggp.receiptDecoder = &cachedReceiptDecoder{
+		decoder: decoder,
+		time:    time.Now().Unix(),
+	}
+	return decoder, nil
+}
+
+// Clear the cached receipt decoder
+func (ggp *GoGoPool) clearReceiptDecoder() {
+	ggp.receiptDecoderLock.Lock()
+	defer ggp.receiptDecoderLock.Unlock()
+	ggp.receiptDecoder = nil
+}
+
+// Load the past addresses & ABIs of contracts from the upgrade history
+func (ggp *GoGoPool) loadUpgradeHistory(ctx context.Context, decoder *ReceiptDecoder, contractNames []string) error {
+
+	// Get upgrade contract
+	upgradeContractName := ggp.ContractName(ContractDAONodeTrustedUpgrade)
+	upgradeContract, err := ggp.GetContractContext(ctx, upgradeContractName)
+	if errors.Is(err, ErrContractNotRegistered) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	upgradeEvent, ok := upgradeContract.ABI.Events["ContractUpgraded"]
+	if !ok {
+		return nil
+	}
+
+	// Get contract name hashes
+	nameHashes := make(map[common.Hash]string, len(contractNames))
+	for _, contractName := range contractNames {
+		nameHashes[crypto.Keccak256Hash([]byte(contractName))] = contractName
+	}
+
+	// Get the upgrade history up to the latest block
+	history, err := ggp.getUpgradeHistory(ctx, *upgradeContract.Address, upgradeEvent.ID, crypto.Keccak256Hash([]byte(upgradeContractName)))
+	if err != nil {
+		return err
+	}
+
+	// Load past contract versions
+	var wg errgroup.Group
+	var lock sync.Mutex
+	for _, log := range history.logs {
+		log := log
+		if len(log.Topics) < 3 {
+			continue
+		}
+		contractName, ok := nameHashes[log.Topics[1]]
+		if !ok {
+			continue
+		}
+		oldAddress := common.BytesToAddress(log.Topics[2].Bytes())
+		wg.Go(func() error {
+			contractAbi, err := ggp.getHistoricalABI(ctx, contractName, previousBlock(new(big.Int).SetUint64(log.BlockNumber)))
+			if err != nil {
+				return err
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			if _, ok := decoder.contracts[oldAddress]; !ok {
+				decoder.contracts[oldAddress] = decoderContract{name: contractName, abi: contractAbi}
+			}
+			return nil
+		})
+	}
+	return wg.Wait()
+
+}
+
+// Get the upgrade history, scanning it from the deployment block on first use and extending it to the latest block after
+// Upgrades of the upgrade contract are logged by its old instance, so past instances are found by the address they were replaced with, and later instances are followed as they are logged
+func (ggp *GoGoPool) getUpgradeHistory(ctx context.Context, upgradeAddress common.Address, upgradeEventId common.Hash, upgradeNameHash common.Hash) (*upgradeHistory, error) {
+	ggp.upgradeHistoryLock.Lock()
+	defer ggp.upgradeHistoryLock.Unlock()
+
+	// Get latest block
+	latestBlock, err := ggp.Client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get latest block for upgrade history: %w", err)
+	}
+	toBlock := new(big.Int).SetUint64(latestBlock)
+
+	// Extend the cached history, or find past upgrade contracts if there is none or it doesn't include the current one
+	cached := ggp.upgradeHistory
+	var fromBlock *big.Int
+	history := &upgradeHistory{scannedBlock: latestBlock}
+	if cached != nil && containsAddress(cached.upgradeAddresses, upgradeAddress) {
+		if latestBlock <= cached.scannedBlock {
+			return cached, nil
+		}
+		fromBlock = new(big.Int).SetUint64(cached.scannedBlock + 1)
+		history.upgradeAddresses = append(history.upgradeAddresses, cached.upgradeAddresses...)
+		history.logs = append(history.logs, cached.logs...)
+	} else {
+		history.upgradeAddresses = []common.Address{upgradeAddress}
+		replacedBy := []common.Hash{common.BytesToHash(upgradeAddress.Bytes())}
+		for len(replacedBy) > 0 {
+			logs, err := ggp.GetLogsContext(ctx, ethereum.FilterQuery{
+				Topics:  [][]common.Hash{{upgradeEventId}, {upgradeNameHash}, nil, replacedBy},
+				ToBlock: toBlock,
+			}, big.NewInt(DefaultLogIntervalSize))
+			if err != nil {
+				return nil, fmt.Errorf("Could not get upgrade contract upgrade logs: %w", err)
+			}
+			replacedBy = nil
+			for _, log := range logs {
+				if len(log.Topics) < 4 {
+					continue
+				}
+				oldAddress := common.BytesToAddress(log.Topics[2].Bytes())
+				if log.Address == oldAddress && !containsAddress(history.upgradeAddresses, oldAddress) {
+					history.upgradeAddresses = append(history.upgradeAddresses, oldAddress)
+					replacedBy = append(replacedBy, log.Topics[2])
+				}
+			}
+		}
+	}
+
+	// Get upgrade logs from the known upgrade contracts, following upgrades of the upgrade contract in the block range
+	queryAddresses := history.upgradeAddresses
+	for len(queryAddresses) > 0 {
+		logs, err := ggp.GetLogsContext(ctx, ethereum.FilterQuery{
+			Addresses: queryAddresses,
+			Topics:    [][]common.Hash{{upgradeEventId}},
+			FromBlock: fromBlock,
+			ToBlock:   toBlock,
+		}, big.NewInt(DefaultLogIntervalSize))
+		if err != nil {
+			return nil, fmt.Errorf("Could not get contract upgrade logs: %w", err)
+		}
+		queryAddresses = nil
+		for _, log := range logs {
+			history.logs = append(history.logs, log)
+			if len(log.Topics) < 4 || log.Topics[1] != upgradeNameHash || log.Address != common.BytesToAddress(log.Topics[2].Bytes()) {
+				continue
+			}
+			newAddress := common.BytesToAddress(log.Topics[3].Bytes())
+			if !containsAddress(history.upgradeAddresses, newAddress) {
+				history.upgradeAddresses = append(history.upgradeAddresses, newAddress)
+				queryAddresses = append(queryAddresses, newAddress)
+			}
+		}
+	}
+
+	// Cache and return
+	ggp.upgradeHistory = history
+	return history, nil
+
+}
+
+// Clear the cached upgrade history
+func (ggp *GoGoPool) clearUpgradeHistory() {
+	ggp.upgradeHistoryLock.Lock()
+	defer ggp.upgradeHistoryLock.Unlock()
+	ggp.upgradeHistory = nil
+}
+
+// Get a contract ABI as of a past block, falling back to the current ABI if the state is unavailable
+func (ggp *GoGoPool) getHistoricalABI(ctx context.Context, contractName string, blockNumber *big.Int) (*abi.ABI, error) {
+	abiEncoded, err := ggp.GoGoStorage.GetString(&bind.CallOpts{Context: ctx, BlockNumber: blockNumber}, crypto.Keccak256Hash([]byte("contract.abi"), []byte(contractName)))
+	if err == nil && abiEncoded != "" {
+		if abiStr, err := DecompressAbi(abiEncoded); err == nil {
+			if contractAbi, err := abi.JSON(strings.NewReader(abiStr)); err == nil {
+				return &contractAbi, nil
+			}
+		}
+	}
+	return ggp.GetABIContext(ctx, contractName)
+}
+
+// Check whether an address is in a list
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode a log's event name & arguments against an ABI
+func decodeLog(contractAbi *abi.ABI, topics []common.Hash, data []byte) (string, map[string]interface{}, bool) {
+	if len(topics) == 0 {
+		return "", nil, false
+	}
+	abiEvent, err := contractAbi.EventByID(topics[0])
+	if err != nil {
+		return "", nil, false
+	}
+	args := make(map[string]interface{})
+	if err := abiEvent.Inputs.UnpackIntoMap(args, data); err != nil {
+		return "", nil, false
+	}
+	indexed := abi.Arguments{}
+	for _, input := range abiEvent.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, topics[1:]); err != nil {
+		return "", nil, false
+	}
+	return abiEvent.Name, args, true
+}
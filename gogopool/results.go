@@ -0,0 +1,72 @@
+package gogopool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// The result of a mined transaction
+type TransactionResult struct {
+	TxHash      common.Hash    `json:"txHash"`
+	BlockNumber uint64         `json:"blockNumber"`
+	GasUsed     uint64         `json:"gasUsed"`
+	Receipt     *types.Receipt `json:"-"`
+}
+
+// Wait for a transaction to be mined and get its result
+// Returns the result along with an error if the transaction failed
+func WaitForResult(client *client.EthClientProxy, hash common.Hash) (TransactionResult, error) {
+	return WaitForResultContext(context.Background(), client, hash)
+}
+func WaitForResultContext(ctx context.Context, client *client.EthClientProxy, hash common.Hash) (TransactionResult, error) {
+	receipt, err := NewTransactionManager(client, DefaultConfirmations).WaitForReceiptContext(ctx, hash)
+	if receipt == nil {
+		return TransactionResult{TxHash: hash}, err
+	}
+	result := TransactionResult{
+		TxHash:  hash,
+		GasUsed: receipt.GasUsed,
+		Receipt: receipt,
+	}
+	if receipt.BlockNumber != nil {
+		result.BlockNumber = receipt.BlockNumber.Uint64()
+	}
+	return result, err
+}
+
+// Get the named arguments of contract events from a transaction
+// Returns an error if the transaction has no matching events
+func (c *Contract) GetTransactionEventArgs(txReceipt *types.Receipt, eventName string) ([]map[string]interface{}, error) {
+
+	// Get ABI event
+	abiEvent, ok := c.ABI.Events[eventName]
+	if !ok {
+		return nil, &EventNotFoundError{Contract: c.Name, Event: eventName}
+	}
+
+	// Decode matching logs
+	events := []map[string]interface{}{}
+	for _, log := range txReceipt.Logs {
+		if !bytes.Equal(log.Address.Bytes(), c.Address.Bytes()) || len(log.Topics) == 0 || log.Topics[0] != abiEvent.ID {
+			continue
+		}
+		_, args, ok := decodeLog(c.ABI, log.Topics, log.Data)
+		if !ok {
+			return nil, fmt.Errorf("Could not unpack %s event data", eventName)
+		}
+		events = append(events, args)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("Could not find %s event in transaction %s: %w", eventName, txReceipt.TxHash.Hex(), &EventNotFoundError{Contract: c.Name, Event: eventName})
+	}
+
+	// Return
+	return events, nil
+
+}
@@ -0,0 +1,312 @@
+package gogopool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// Built-in revert error selectors
+var (
+	revertErrorSelector = []byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	revertPanicSelector = []byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+)
+
+// Built-in revert error names
+const (
+	RevertErrorName = "Error"
+	RevertPanicName = "Panic"
+)
+
+// Descriptions of Solidity panic codes
+var panicCodeDescriptions = map[uint64]string{
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// A decoded contract revert
+type RevertError struct {
+	Contract  string        `json:"contract"`
+	Method    string        `json:"method"`
+	Name      string        `json:"name"`
+	Args      []interface{} `json:"args"`
+	Reason    string        `json:"reason"`
+	PanicCode *big.Int      `json:"panicCode"`
+	Data      []byte        `json:"data"`
+	err       error
+}
+
+func (e *RevertError) Error() string {
+
+	// Get reverting call
+	call := "execution"
+	if e.Contract != "" && e.Method != "" {
+		call = fmt.Sprintf("%s.%s", e.Contract, e.Method)
+	} else if e.Method != "" {
+		call = e.Method
+	}
+
+	// Get revert details
+	switch {
+	case e.Name == RevertErrorName:
+		return fmt.Sprintf("%s reverted: %s", call, e.Reason)
+	case e.Name == RevertPanicName:
+		return fmt.Sprintf("%s panicked: %s", call, e.Reason)
+	case e.Name != "":
+		args := make([]string, len(e.Args))
+		for ai, arg := range e.Args {
+			args[ai] = fmt.Sprintf("%v", arg)
+		}
+		return fmt.Sprintf("%s reverted: %s(%s)", call, e.Name, strings.Join(args, ", "))
+	case e.Reason != "":
+		return fmt.Sprintf("%s reverted: %s", call, e.Reason)
+	case len(e.Data) > 0:
+		return fmt.Sprintf("%s reverted with unknown error %s", call, hexutil.Encode(e.Data))
+	default:
+		return fmt.Sprintf("%s reverted", call)
+	}
+
+}
+
+func (e *RevertError) Unwrap() error {
+	return e.err
+}
+
+// Decode revert data against built-in errors and the custom errors in a set of ABIs
+func DecodeRevertData(data []byte, abis ...*abi.ABI) *RevertError {
+	revertErr := &RevertError{Data: data}
+	if len(data) < 4 {
+		return revertErr
+	}
+
+	// Error(string)
+	if bytes.Equal(data[:4], revertErrorSelector) {
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			revertErr.Name = RevertErrorName
+			revertErr.Reason = reason
+			revertErr.Args = []interface{}{reason}
+		}
+		return revertErr
+	}
+
+	// Panic(uint256)
+	if bytes.Equal(data[:4], revertPanicSelector) {
+		if len(data) == 36 {
+			code := new(big.Int).SetBytes(data[4:])
+			revertErr.Name = RevertPanicName
+			revertErr.PanicCode = code
+			revertErr.Args = []interface{}{code}
+			if description, ok := panicCodeDescriptions[code.Uint64()]; ok && code.IsUint64() {
+				revertErr.Reason = fmt.Sprintf("%s (0x%x)", description, code)
+			} else {
+				revertErr.Reason = fmt.Sprintf("panic code 0x%x", code)
+			}
+		}
+		return revertErr
+	}
+
+	// Custom errors
+	for _, contractAbi := range abis {
+		if contractAbi == nil {
+			continue
+		}
+		for _, abiError := range contractAbi.Errors {
+			if !bytes.Equal(data[:4], abiError.ID[:4]) {
+				continue
+			}
+			args, err := abiError.Inputs.Unpack(data[4:])
+			if err != nil {
+				continue
+			}
+			revertErr.Name = abiError.Name
+			revertErr.Args = args
+			return revertErr
+		}
+	}
+
+	// Return
+	return revertErr
+
+}
+
+// Decode the revert in an RPC error, or return the error unchanged if it isn't a revert
+func (c *Contract) decodeRevertError(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	data, reason, ok := getRevertData(err)
+	if !ok {
+		return err
+	}
+	revertErr := DecodeRevertData(data, c.ABI)
+	if revertErr.Name == "" && reason != "" {
+		revertErr.Reason = reason
+	}
+	revertErr.Contract = c.Name
+	revertErr.Method = method
+	revertErr.err = err
+	return revertErr
+}
+
+// Get the revert error for a failed transaction receipt by replaying it against the contract
+// Returns nil if the transaction succeeded
+func (c *Contract) GetReceiptRevertError(receipt *types.Receipt) error {
+	return c.GetReceiptRevertErrorContext(context.Background(), receipt)
+}
+func (c *Contract) GetReceiptRevertErrorContext(ctx context.Context, receipt *types.Receipt) error {
+	if receipt.Status != types.ReceiptStatusFailed {
+		return nil
+	}
+
+	// Replay the transaction
+	tx, msg, err := getReceiptCall(ctx, c.Client, receipt)
+	if err != nil {
+		return err
+	}
+	_, callErr := c.Client.CallContract(ctx, msg, previousBlock(receipt.BlockNumber))
+
+	// Get the reverted method
+	method := ""
+	if len(tx.Data()) >= 4 {
+		if abiMethod, err := c.ABI.MethodById(tx.Data()[:4]); err == nil {
+			method = abiMethod.Name
+		}
+	}
+
+	// Decode the revert
+	failedErr := &TransactionFailedError{Hash: receipt.TxHash}
+	if callErr == nil {
+		return failedErr
+	}
+	data, reason, ok := getRevertData(callErr)
+	if !ok {
+		return failedErr
+	}
+	revertErr := DecodeRevertData(data, c.ABI)
+	if revertErr.Name == "" && reason != "" {
+		revertErr.Reason = reason
+	}
+	revertErr.Contract = c.Name
+	revertErr.Method = method
+	revertErr.err = failedErr
+	return revertErr
+
+}
+
+// Get the revert error for a failed transaction receipt, decoding built-in errors only
+func getReceiptRevertError(ctx context.Context, ec *client.EthClientProxy, receipt *types.Receipt) error {
+	failedErr := &TransactionFailedError{Hash: receipt.TxHash}
+	_, msg, err := getReceiptCall(ctx, ec, receipt)
+	if err != nil {
+		return failedErr
+	}
+	_, callErr := ec.CallContract(ctx, msg, previousBlock(receipt.BlockNumber))
+	if callErr == nil {
+		return failedErr
+	}
+	data, reason, ok := getRevertData(callErr)
+	if !ok {
+		return failedErr
+	}
+	revertErr := DecodeRevertData(data)
+	if revertErr.Name == "" && reason != "" {
+		revertErr.Reason = reason
+	}
+	revertErr.err = failedErr
+	return revertErr
+}
+
+// Get the transaction for a receipt and the call which replays it
+func getReceiptCall(ctx context.Context, ec *client.EthClientProxy, receipt *types.Receipt) (*types.Transaction, ethereum.CallMsg, error) {
+	tx, _, err := ec.TransactionByHash(ctx, receipt.TxHash)
+	if err != nil {
+		return nil, ethereum.CallMsg{}, fmt.Errorf("Could not get transaction %s: %w", receipt.TxHash.Hex(), err)
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, ethereum.CallMsg{}, fmt.Errorf("Could not get transaction %s sender: %w", receipt.TxHash.Hex(), err)
+	}
+	return tx, ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}, nil
+}
+
+// Get the block before a block number, or nil for the latest block
+func previousBlock(blockNumber *big.Int) *big.Int {
+	if blockNumber == nil || blockNumber.Sign() == 0 {
+		return nil
+	}
+	return new(big.Int).Sub(blockNumber, big.NewInt(1))
+}
+
+// Get the revert data and reason message from an RPC error
+func getRevertData(err error) ([]byte, string, bool) {
+
+	// Get reason from the error message
+	reason := ""
+	message := err.Error()
+	if i := strings.LastIndex(message, "execution reverted"); i >= 0 {
+		reason = strings.TrimPrefix(strings.TrimPrefix(message[i:], "execution reverted"), ": ")
+	} else if i := strings.LastIndex(message, "VM Exception while processing transaction: revert"); i >= 0 {
+		reason = strings.TrimSpace(strings.TrimPrefix(message[i:], "VM Exception while processing transaction: revert"))
+	}
+
+	// Get data from the RPC error
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := decodeRevertErrorData(dataErr.ErrorData()); ok {
+			return data, reason, true
+		}
+	}
+
+	// Return
+	if reason != "" || strings.Contains(message, "revert") {
+		return nil, reason, true
+	}
+	return nil, "", false
+
+}
+
+// Decode the data field of an RPC error
+func decodeRevertErrorData(errorData interface{}) ([]byte, bool) {
+	switch value := errorData.(type) {
+	case string:
+		data, err := hexutil.Decode(value)
+		return data, err == nil
+	case map[string]interface{}:
+		// Ganache nests the return data by transaction hash
+		for _, nested := range value {
+			if nestedMap, ok := nested.(map[string]interface{}); ok {
+				if returnData, ok := nestedMap["return"]; ok {
+					return decodeRevertErrorData(returnData)
+				}
+			}
+		}
+		if data, ok := value["data"]; ok {
+			return decodeRevertErrorData(data)
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,244 @@
+package gogopool
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Safe operations
+const (
+	SafeOperationCall         uint8 = 0
+	SafeOperationDelegateCall uint8 = 1
+)
+
+// Safe settings
+const (
+	SafeTxBuilderVersion            = "1.13.3"
+	DefaultMultiSendCallOnlyAddress = "0x40A2aCCbd92BCA938b02010E17A5b8929b49130D" // Safe v1.3.0 MultiSendCallOnly
+	safeMultiSendAbi                = `[{"inputs":[{"internalType":"bytes","name":"transactions","type":"bytes"}],"name":"multiSend","outputs":[],"stateMutability":"payable","type":"function"}]`
+	safeDomainTypeString            = "EIP712Domain(uint256 chainId,address verifyingContract)"
+	safeTxTypeString                = "SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"
+)
+
+// A batch of calls to be executed by a Safe multisig
+type SafeBatch struct {
+	ChainID          *big.Int
+	SafeAddress      common.Address
+	MultiSendAddress common.Address
+	Name             string
+	Description      string
+	Transactions     []SafeBatchTransaction
+}
+
+// A call in a Safe batch; a nil Value is treated as zero
+type SafeBatchTransaction struct {
+	To          common.Address
+	Value       *big.Int
+	Data        []byte
+	Description string
+}
+
+// A Safe transaction for signers to approve
+type SafeTransaction struct {
+	ChainID        *hexutil.Big   `json:"chainId"`
+	Safe           common.Address `json:"safe"`
+	To             common.Address `json:"to"`
+	Value          *hexutil.Big   `json:"value"`
+	Data           hexutil.Bytes  `json:"data"`
+	Operation      uint8          `json:"operation"`
+	SafeTxGas      *hexutil.Big   `json:"safeTxGas"`
+	BaseGas        *hexutil.Big   `json:"baseGas"`
+	GasPrice       *hexutil.Big   `json:"gasPrice"`
+	GasToken       common.Address `json:"gasToken"`
+	RefundReceiver common.Address `json:"refundReceiver"`
+	Nonce          uint64         `json:"nonce"`
+}
+
+// Safe Transaction Builder batch file format
+type safeBuilderBatch struct {
+	Version      string                   `json:"version"`
+	ChainID      string                   `json:"chainId"`
+	CreatedAt    int64                    `json:"createdAt"`
+	Meta         safeBuilderMeta          `json:"meta"`
+	Transactions []safeBuilderTransaction `json:"transactions"`
+}
+type safeBuilderMeta struct {
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	TxBuilderVersion       string `json:"txBuilderVersion"`
+	CreatedFromSafeAddress string `json:"createdFromSafeAddress"`
+}
+type safeBuilderTransaction struct {
+	To                   string      `json:"to"`
+	Value                string      `json:"value"`
+	Data                 string      `json:"data"`
+	ContractMethod       interface{} `json:"contractMethod"`
+	ContractInputsValues interface{} `json:"contractInputsValues"`
+}
+
+// Create a new Safe batch
+func NewSafeBatch(chainId *big.Int, safeAddress common.Address) *SafeBatch {
+	return &SafeBatch{
+		ChainID:          chainId,
+		SafeAddress:      safeAddress,
+		MultiSendAddress: common.HexToAddress(DefaultMultiSendCallOnlyAddress),
+		Transactions:     []SafeBatchTransaction{},
+	}
+}
+
+// Add a contract method call to the batch
+func (b *SafeBatch) AddCall(contract *Contract, method string, params ...interface{}) error {
+	if err := contract.checkMethod(method); err != nil {
+		return err
+	}
+	data, err := contract.ABI.Pack(method, params...)
+	if err != nil {
+		return fmt.Errorf("Could not encode %s call data: %w", method, err)
+	}
+	b.Transactions = append(b.Transactions, SafeBatchTransaction{
+		To:          *contract.Address,
+		Value:       big.NewInt(0),
+		Data:        data,
+		Description: fmt.Sprintf("%s.%s", contract.Name, method),
+	})
+	return nil
+}
+
+// Get the batch in the Safe Transaction Builder JSON format
+func (b *SafeBatch) BuilderJSON() ([]byte, error) {
+	batch := safeBuilderBatch{
+		Version:   "1.0",
+		ChainID:   b.ChainID.String(),
+		CreatedAt: time.Now().UnixNano() / int64(time.Millisecond),
+		Meta: safeBuilderMeta{
+			Name:                   b.Name,
+			Description:            b.Description,
+			TxBuilderVersion:       SafeTxBuilderVersion,
+			CreatedFromSafeAddress: b.SafeAddress.Hex(),
+		},
+		Transactions: make([]safeBuilderTransaction, len(b.Transactions)),
+	}
+	for ti, tx := range b.Transactions {
+		batch.Transactions[ti] = safeBuilderTransaction{
+			To:    tx.To.Hex(),
+			Value: tx.value().String(),
+			Data:  hexutil.Encode(tx.Data),
+		}
+	}
+	return json.MarshalIndent(batch, "", "  ")
+}
+
+// Write the batch to a Safe Transaction Builder JSON file
+func (b *SafeBatch) Save(path string) error {
+	bytes, err := b.BuilderJSON()
+	if err != nil {
+		return fmt.Errorf("Could not encode Safe batch: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("Could not write Safe batch file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Get the Safe transaction which executes the batch at the given Safe nonce
+// A single call is executed directly; multiple calls are executed through MultiSend
+func (b *SafeBatch) SafeTransaction(nonce uint64) (*SafeTransaction, error) {
+	safeTx := &SafeTransaction{
+		ChainID:   (*hexutil.Big)(b.ChainID),
+		Safe:      b.SafeAddress,
+		SafeTxGas: (*hexutil.Big)(big.NewInt(0)),
+		BaseGas:   (*hexutil.Big)(big.NewInt(0)),
+		GasPrice:  (*hexutil.Big)(big.NewInt(0)),
+		Nonce:     nonce,
+	}
+	switch len(b.Transactions) {
+	case 0:
+		return nil, fmt.Errorf("Safe batch has no transactions")
+	case 1:
+		tx := b.Transactions[0]
+		safeTx.To = tx.To
+		safeTx.Value = (*hexutil.Big)(tx.value())
+		safeTx.Data = tx.Data
+		safeTx.Operation = SafeOperationCall
+	default:
+		data, err := b.multiSendData()
+		if err != nil {
+			return nil, err
+		}
+		safeTx.To = b.MultiSendAddress
+		safeTx.Value = (*hexutil.Big)(big.NewInt(0))
+		safeTx.Data = data
+		safeTx.Operation = SafeOperationDelegateCall
+	}
+	return safeTx, nil
+}
+
+// Get the EIP-712 Safe transaction hash which signers approve
+// Nil amounts are treated as zero
+func (t *SafeTransaction) Hash() common.Hash {
+	domainSeparator := crypto.Keccak256Hash(
+		crypto.Keccak256([]byte(safeDomainTypeString)),
+		encodeSafeUint(t.ChainID),
+		common.LeftPadBytes(t.Safe.Bytes(), 32),
+	)
+	safeTxHash := crypto.Keccak256Hash(
+		crypto.Keccak256([]byte(safeTxTypeString)),
+		common.LeftPadBytes(t.To.Bytes(), 32),
+		encodeSafeUint(t.Value),
+		crypto.Keccak256(t.Data),
+		common.LeftPadBytes([]byte{t.Operation}, 32),
+		encodeSafeUint(t.SafeTxGas),
+		encodeSafeUint(t.BaseGas),
+		encodeSafeUint(t.GasPrice),
+		common.LeftPadBytes(t.GasToken.Bytes(), 32),
+		common.LeftPadBytes(t.RefundReceiver.Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(t.Nonce).Bytes(), 32),
+	)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), safeTxHash.Bytes())
+}
+
+// Encode the batch as MultiSend call data
+func (b *SafeBatch) multiSendData() ([]byte, error) {
+	packed := []byte{}
+	for _, tx := range b.Transactions {
+		packed = append(packed, SafeOperationCall)
+		packed = append(packed, tx.To.Bytes()...)
+		packed = append(packed, common.LeftPadBytes(tx.value().Bytes(), 32)...)
+		packed = append(packed, common.LeftPadBytes(big.NewInt(int64(len(tx.Data))).Bytes(), 32)...)
+		packed = append(packed, tx.Data...)
+	}
+	multiSendAbi, err := abi.JSON(strings.NewReader(safeMultiSendAbi))
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode MultiSend ABI: %w", err)
+	}
+	data, err := multiSendAbi.Pack("multiSend", packed)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode MultiSend call data: %w", err)
+	}
+	return data, nil
+}
+
+// Get the value of a batch call
+func (tx SafeBatchTransaction) value() *big.Int {
+	if tx.Value == nil {
+		return big.NewInt(0)
+	}
+	return tx.Value
+}
+
+// Encode an amount as an EIP-712 uint256, treating nil as zero
+func encodeSafeUint(value *hexutil.Big) []byte {
+	if value == nil {
+		return make([]byte, 32)
+	}
+	return common.LeftPadBytes(value.ToInt().Bytes(), 32)
+}
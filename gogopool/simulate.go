@@ -0,0 +1,167 @@
+package gogopool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// The result of simulating a contract transaction
+type SimulationResult struct {
+	Outputs      []interface{}    `json:"outputs"`
+	ReturnData   []byte           `json:"returnData"`
+	Events       []SimulatedEvent `json:"events"`
+	EventsTraced bool             `json:"eventsTraced"`
+	Block        uint64           `json:"block"`
+}
+
+// An event emitted during a simulated transaction
+// Name and Values are only set for events on the simulated contract's ABI
+type SimulatedEvent struct {
+	Address common.Address         `json:"address"`
+	Topics  []common.Hash          `json:"topics"`
+	Data    []byte                 `json:"data"`
+	Name    string                 `json:"name,omitempty"`
+	Values  map[string]interface{} `json:"values,omitempty"`
+}
+
+// A call frame from the callTracer
+type callFrame struct {
+	Error string         `json:"error"`
+	Calls []callFrame    `json:"calls"`
+	Logs  []callFrameLog `json:"logs"`
+}
+type callFrameLog struct {
+	Address  common.Address  `json:"address"`
+	Topics   []common.Hash   `json:"topics"`
+	Data     hexutil.Bytes   `json:"data"`
+	Position *hexutil.Uint64 `json:"position"`
+}
+
+// Simulate a contract transaction against the latest state without sending it
+// The call and its trace are run at the same block, which is returned with the result
+// Reverts are returned as *RevertError; events are only listed if the node supports debug_traceCall
+func (c *Contract) Simulate(opts *bind.TransactOpts, method string, params ...interface{}) (*SimulationResult, error) {
+	return c.SimulateContext(TransactOptsContext(opts), opts, method, params...)
+}
+func (c *Contract) SimulateContext(ctx context.Context, opts *bind.TransactOpts, method string, params ...interface{}) (*SimulationResult, error) {
+
+	// Pack transaction info
+	if err := c.checkMethod(method); err != nil {
+		return nil, err
+	}
+	if err := c.checkClient(method); err != nil {
+		return nil, err
+	}
+	input, err := c.ABI.Pack(method, params...)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode input data: %w", err)
+	}
+	msg := ethereum.CallMsg{
+		From:  opts.From,
+		To:    c.Address,
+		Gas:   opts.GasLimit,
+		Value: opts.Value,
+		Data:  input,
+	}
+
+	// Pin the block so the call and its trace see the same state
+	latestBlock, err := c.Client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get latest block for simulation: %w", err)
+	}
+	blockNumber := new(big.Int).SetUint64(latestBlock)
+
+	// Run call
+	returnData, err := c.Client.CallContract(ctx, msg, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("Could not simulate %s: %w", method, c.decodeRevertError(method, err))
+	}
+	outputs, err := c.ABI.Unpack(method, returnData)
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode %s outputs: %w", method, err)
+	}
+	result := &SimulationResult{
+		Outputs:    outputs,
+		ReturnData: returnData,
+		Events:     []SimulatedEvent{},
+		Block:      latestBlock,
+	}
+
+	// Trace call for events; nodes without the debug API are tolerated
+	var trace callFrame
+	if err := c.Client.CallContext(ctx, &trace, "debug_traceCall", toCallArg(msg), hexutil.EncodeBig(blockNumber), map[string]interface{}{
+		"tracer":       "callTracer",
+		"tracerConfig": map[string]interface{}{"withLog": true},
+	}); err == nil {
+		result.EventsTraced = true
+		for _, log := range trace.getLogs() {
+			result.Events = append(result.Events, c.decodeSimulatedEvent(log))
+		}
+	}
+
+	// Return
+	return result, nil
+
+}
+
+// Get the logs emitted by a call frame and its successful subcalls, in execution order
+func (f *callFrame) getLogs() []callFrameLog {
+	if f.Error != "" {
+		return nil
+	}
+	logs := []callFrameLog{}
+	nextCall := 0
+	for _, log := range f.Logs {
+		if log.Position != nil {
+			for ; nextCall < len(f.Calls) && uint64(nextCall) < uint64(*log.Position); nextCall++ {
+				logs = append(logs, f.Calls[nextCall].getLogs()...)
+			}
+		}
+		logs = append(logs, log)
+	}
+	for ; nextCall < len(f.Calls); nextCall++ {
+		logs = append(logs, f.Calls[nextCall].getLogs()...)
+	}
+	return logs
+}
+
+// Decode a traced log against the contract ABI
+func (c *Contract) decodeSimulatedEvent(log callFrameLog) SimulatedEvent {
+	event := SimulatedEvent{
+		Address: log.Address,
+		Topics:  log.Topics,
+		Data:    log.Data,
+	}
+	if name, values, ok := decodeLog(c.ABI, log.Topics, log.Data); ok {
+		event.Name = name
+		event.Values = values
+	}
+	return event
+}
+
+// Encode a call message as JSON-RPC call arguments
+func toCallArg(msg ethereum.CallMsg) map[string]interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	return arg
+}
@@ -0,0 +1,244 @@
+package gogopool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// Transaction manager settings
+const (
+	DefaultConfirmations      = 1
+	TransactionPollInterval   = 2 * time.Second
+	ReplacementFeeBumpPercent = 10
+	CancelTransactionGasLimit = 21000
+)
+
+// Tracks transactions until they are confirmed, and replaces stuck transactions at the same nonce
+// The lookups & sends for each operation go through the same endpoint
+type TransactionManager struct {
+	Client        *client.EthClientProxy
+	Confirmations uint64
+	PollInterval  time.Duration
+	replacements  map[common.Hash][]common.Hash
+	lock          sync.RWMutex
+}
+
+// Create a new transaction manager
+// Receipts are returned once they have the given number of confirmations, including the block they were mined in
+func NewTransactionManager(client *client.EthClientProxy, confirmations uint64) *TransactionManager {
+	if confirmations == 0 {
+		confirmations = DefaultConfirmations
+	}
+	return &TransactionManager{
+		Client:        client,
+		Confirmations: confirmations,
+		PollInterval:  TransactionPollInterval,
+		replacements:  make(map[common.Hash][]common.Hash),
+	}
+}
+
+// Wait for a transaction, or any of its replacements, to be mined with enough confirmations
+// Returns the receipt along with an error if the mined transaction failed with status 0
+func (tm *TransactionManager) WaitForReceipt(hash common.Hash) (*types.Receipt, error) {
+	return tm.WaitForReceiptContext(context.Background(), hash)
+}
+func (tm *TransactionManager) WaitForReceiptContext(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	ctx = client.WithStickyEndpoint(ctx)
+	for {
+
+		// Check each transaction at the nonce for a receipt
+		for _, txHash := range tm.getTransactionFamily(hash) {
+			receipt, err := tm.Client.TransactionReceipt(ctx, txHash)
+			if err != nil {
+				if errors.Is(err, ethereum.NotFound) {
+					continue
+				}
+				return nil, fmt.Errorf("Could not get transaction %s receipt: %w", txHash.Hex(), err)
+			}
+
+			// Check confirmations
+			latestBlock, err := tm.Client.BlockNumber(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("Could not get latest block: %w", err)
+			}
+			if receipt.BlockNumber != nil && latestBlock+1 < receipt.BlockNumber.Uint64()+tm.Confirmations {
+				break
+			}
+
+			// Check status
+			if receipt.Status == types.ReceiptStatusFailed {
+				return receipt, getReceiptRevertError(ctx, tm.Client, receipt)
+			}
+			return receipt, nil
+
+		}
+
+		// Wait for next poll
+		select {
+		case <-time.After(tm.PollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+	}
+}
+
+// Re-broadcast a pending transaction at the same nonce with higher fees
+// The replacement is based on the highest-fee pending transaction among the transaction and its tracked replacements
+// Fees are raised by at least ReplacementFeeBumpPercent, or to the fees set in opts if they are higher
+func (tm *TransactionManager) SpeedUp(hash common.Hash, opts *bind.TransactOpts) (common.Hash, error) {
+	return tm.SpeedUpContext(TransactOptsContext(opts), hash, opts)
+}
+func (tm *TransactionManager) SpeedUpContext(ctx context.Context, hash common.Hash, opts *bind.TransactOpts) (common.Hash, error) {
+	ctx = client.WithStickyEndpoint(ctx)
+	tx, err := tm.getPendingTransaction(ctx, hash, opts.From)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return tm.replaceTransaction(ctx, hash, tx, tx.To(), tx.Value(), tx.Data(), tx.Gas(), opts)
+}
+
+// Cancel a pending transaction by replacing it with a zero-value send to the sender at the same nonce
+// opts.From must be the transaction sender
+func (tm *TransactionManager) Cancel(hash common.Hash, opts *bind.TransactOpts) (common.Hash, error) {
+	return tm.CancelContext(TransactOptsContext(opts), hash, opts)
+}
+func (tm *TransactionManager) CancelContext(ctx context.Context, hash common.Hash, opts *bind.TransactOpts) (common.Hash, error) {
+	ctx = client.WithStickyEndpoint(ctx)
+	tx, err := tm.getPendingTransaction(ctx, hash, opts.From)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	to := opts.From
+	return tm.replaceTransaction(ctx, hash, tx, &to, big.NewInt(0), nil, CancelTransactionGasLimit, opts)
+}
+
+// Get the pending transaction with the highest fees among a transaction and its tracked replacements, checking that it was sent by the given account
+// Transactions which were dropped by the network (e.g. after being replaced) are skipped
+func (tm *TransactionManager) getPendingTransaction(ctx context.Context, hash common.Hash, from common.Address) (*types.Transaction, error) {
+
+	// Get the highest-fee pending transaction
+	var pendingTx *types.Transaction
+	for _, txHash := range tm.getTransactionFamily(hash) {
+		tx, isPending, err := tm.Client.TransactionByHash(ctx, txHash)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("Could not get transaction %s: %w", txHash.Hex(), err)
+		}
+		if !isPending {
+			return nil, fmt.Errorf("Transaction %s has already been mined", txHash.Hex())
+		}
+		if pendingTx == nil || tx.GasFeeCapCmp(pendingTx) > 0 || (tx.GasFeeCapCmp(pendingTx) == 0 && tx.GasTipCapCmp(pendingTx) > 0) {
+			pendingTx = tx
+		}
+	}
+	if pendingTx == nil {
+		return nil, fmt.Errorf("Could not get transaction %s: %w", hash.Hex(), ethereum.NotFound)
+	}
+
+	// Check the sender
+	sender, err := types.Sender(types.LatestSignerForChainID(pendingTx.ChainId()), pendingTx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get transaction %s sender: %w", pendingTx.Hash().Hex(), err)
+	}
+	if sender != from {
+		return nil, fmt.Errorf("Transaction %s was sent by %s, not %s", hash.Hex(), sender.Hex(), from.Hex())
+	}
+	return pendingTx, nil
+
+}
+
+// Sign & send a replacement for a transaction at the same nonce
+func (tm *TransactionManager) replaceTransaction(ctx context.Context, hash common.Hash, tx *types.Transaction, to *common.Address, value *big.Int, data []byte, gasLimit uint64, opts *bind.TransactOpts) (common.Hash, error) {
+
+	// Build replacement transaction
+	var replacement types.TxData
+	if tx.Type() == types.DynamicFeeTxType {
+		gasTipCap := bumpFee(tx.GasTipCap(), opts.GasTipCap)
+		gasFeeCap := bumpFee(tx.GasFeeCap(), opts.GasFeeCap)
+		if gasFeeCap.Cmp(gasTipCap) < 0 {
+			gasFeeCap = gasTipCap
+		}
+		replacement = &types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       gasLimit,
+			To:        to,
+			Value:     value,
+			Data:      data,
+		}
+	} else {
+		replacement = &types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: bumpFee(tx.GasPrice(), opts.GasPrice),
+			Gas:      gasLimit,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		}
+	}
+
+	// Sign & send replacement transaction
+	signedTx, err := opts.Signer(opts.From, types.NewTx(replacement))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not sign replacement transaction: %w", err)
+	}
+	if err := tm.Client.SendTransaction(ctx, signedTx); err != nil {
+		return common.Hash{}, fmt.Errorf("Could not send replacement transaction: %w", err)
+	}
+
+	// Track replacement & return
+	tm.addReplacement(hash, signedTx.Hash())
+	return signedTx.Hash(), nil
+
+}
+
+// Get a transaction and all of its tracked replacements
+func (tm *TransactionManager) getTransactionFamily(hash common.Hash) []common.Hash {
+	tm.lock.RLock()
+	defer tm.lock.RUnlock()
+	family := []common.Hash{hash}
+	return append(family, tm.replacements[hash]...)
+}
+
+// Record a replacement transaction against the original transaction and every other replacement
+func (tm *TransactionManager) addReplacement(hash common.Hash, replacementHash common.Hash) {
+	tm.lock.Lock()
+	defer tm.lock.Unlock()
+	family := append([]common.Hash{hash}, tm.replacements[hash]...)
+	family = append(family, replacementHash)
+	for _, member := range family {
+		others := []common.Hash{}
+		for _, other := range family {
+			if other != member {
+				others = append(others, other)
+			}
+		}
+		tm.replacements[member] = others
+	}
+}
+
+// Raise a fee by ReplacementFeeBumpPercent, rounding up, or to the minimum fee if it is higher
+func bumpFee(fee *big.Int, minFee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+ReplacementFeeBumpPercent))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if minFee != nil && minFee.Cmp(bumped) > 0 {
+		return new(big.Int).Set(minFee)
+	}
+	return bumped
+}
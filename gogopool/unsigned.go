@@ -0,0 +1,200 @@
+package gogopool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// A fully populated transaction awaiting an external signature
+type UnsignedTransaction struct {
+	From      common.Address  `json:"from"`
+	ChainID   *hexutil.Big    `json:"chainId"`
+	Nonce     hexutil.Uint64  `json:"nonce"`
+	To        *common.Address `json:"to"`
+	Value     *hexutil.Big    `json:"value"`
+	Data      hexutil.Bytes   `json:"data"`
+	Gas       hexutil.Uint64  `json:"gas"`
+	GasTipCap *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	GasFeeCap *hexutil.Big    `json:"maxFeePerGas"`
+	Contract  string          `json:"contract,omitempty"`
+	Method    string          `json:"method,omitempty"`
+}
+
+// Build an unsigned transaction for a contract method
+// Nonce, gas limit and fees are taken from opts if set, otherwise from the network
+func (c *Contract) BuildTransaction(opts *bind.TransactOpts, method string, params ...interface{}) (*UnsignedTransaction, error) {
+	return c.BuildTransactionContext(TransactOptsContext(opts), opts, method, params...)
+}
+func (c *Contract) BuildTransactionContext(ctx context.Context, opts *bind.TransactOpts, method string, params ...interface{}) (*UnsignedTransaction, error) {
+
+	// Pack transaction info
+	if err := c.checkMethod(method); err != nil {
+		return nil, err
+	}
+	if err := c.checkClient(method); err != nil {
+		return nil, err
+	}
+	input, err := c.ABI.Pack(method, params...)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode input data: %w", err)
+	}
+
+	// Estimate gas limit
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		_, gasLimit, err = c.estimateGasLimit(ctx, opts, method, input)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Build transaction
+	tx, err := NewUnsignedTransactionContext(ctx, c.Client, opts, c.Address, input, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+	tx.Contract = c.Name
+	tx.Method = method
+	return tx, nil
+
+}
+
+// Build an unsigned transaction with the given gas limit
+// Nonce and fees are taken from opts if set, otherwise from the network
+func NewUnsignedTransaction(client *client.EthClientProxy, opts *bind.TransactOpts, to *common.Address, data []byte, gasLimit uint64) (*UnsignedTransaction, error) {
+	return NewUnsignedTransactionContext(TransactOptsContext(opts), client, opts, to, data, gasLimit)
+}
+func NewUnsignedTransactionContext(ctx context.Context, ec *client.EthClientProxy, opts *bind.TransactOpts, to *common.Address, data []byte, gasLimit uint64) (*UnsignedTransaction, error) {
+
+	// Get the chain ID, nonce & fees from the same endpoint
+	ctx = client.WithStickyEndpoint(ctx)
+
+	// Get chain ID
+	chainId, err := ec.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get chain ID: %w", err)
+	}
+
+	// Get nonce
+	var nonce uint64
+	if opts.Nonce != nil {
+		nonce = opts.Nonce.Uint64()
+	} else if nonce, err = ec.PendingNonceAt(ctx, opts.From); err != nil {
+		return nil, fmt.Errorf("Could not get account %s nonce: %w", opts.From.Hex(), err)
+	}
+
+	// Get fees
+	gasTipCap, gasFeeCap, err := NewFeeOracle(ec).GetTransactionFeesContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default value
+	value := opts.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	// Return
+	return &UnsignedTransaction{
+		From:      opts.From,
+		ChainID:   (*hexutil.Big)(chainId),
+		Nonce:     hexutil.Uint64(nonce),
+		To:        to,
+		Value:     (*hexutil.Big)(value),
+		Data:      data,
+		Gas:       hexutil.Uint64(gasLimit),
+		GasTipCap: (*hexutil.Big)(gasTipCap),
+		GasFeeCap: (*hexutil.Big)(gasFeeCap),
+	}, nil
+
+}
+
+// Get the unsigned transaction as a go-ethereum transaction, e.g. to pass to a signer
+func (u *UnsignedTransaction) Transaction() *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    u.ChainID.ToInt(),
+		Nonce:      uint64(u.Nonce),
+		GasTipCap:  u.GasTipCap.ToInt(),
+		GasFeeCap:  u.GasFeeCap.ToInt(),
+		Gas:        uint64(u.Gas),
+		To:         u.To,
+		Value:      u.Value.ToInt(),
+		Data:       u.Data,
+		AccessList: types.AccessList{},
+	})
+}
+
+// Get the hash the signer must sign
+func (u *UnsignedTransaction) SigningHash() common.Hash {
+	return types.LatestSignerForChainID(u.ChainID.ToInt()).Hash(u.Transaction())
+}
+
+// Get the unsigned EIP-1559 transaction encoding: 0x02 || rlp([chainId, nonce, maxPriorityFeePerGas, maxFeePerGas, gas, to, value, data, accessList])
+func (u *UnsignedTransaction) RLP() ([]byte, error) {
+	encoded, err := rlp.EncodeToBytes([]interface{}{
+		u.ChainID.ToInt(),
+		uint64(u.Nonce),
+		u.GasTipCap.ToInt(),
+		u.GasFeeCap.ToInt(),
+		uint64(u.Gas),
+		u.To,
+		u.Value.ToInt(),
+		[]byte(u.Data),
+		types.AccessList{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode unsigned transaction: %w", err)
+	}
+	return append([]byte{types.DynamicFeeTxType}, encoded...), nil
+}
+
+// Get the unsigned transaction encoding as a hex string
+func (u *UnsignedTransaction) RLPHex() (string, error) {
+	encoded, err := u.RLP()
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(encoded), nil
+}
+
+// Load an unsigned transaction from JSON
+func ParseUnsignedTransaction(data []byte) (*UnsignedTransaction, error) {
+	tx := new(UnsignedTransaction)
+	if err := json.Unmarshal(data, tx); err != nil {
+		return nil, fmt.Errorf("Could not decode unsigned transaction: %w", err)
+	}
+	if tx.ChainID == nil || tx.Value == nil || tx.GasTipCap == nil || tx.GasFeeCap == nil {
+		return nil, fmt.Errorf("Could not decode unsigned transaction: missing chain ID, value or fees")
+	}
+	return tx, nil
+}
+
+// Broadcast a signed transaction given as a hex-encoded binary blob
+func Broadcast(client *client.EthClientProxy, signedTxHex string) (common.Hash, error) {
+	return BroadcastContext(context.Background(), client, signedTxHex)
+}
+func BroadcastContext(ctx context.Context, client *client.EthClientProxy, signedTxHex string) (common.Hash, error) {
+	encoded, err := hexutil.Decode(signedTxHex)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("Could not decode signed transaction: %w", err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(encoded); err != nil {
+		return common.Hash{}, fmt.Errorf("Could not decode signed transaction: %w", err)
+	}
+	if err := client.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, fmt.Errorf("Could not broadcast transaction %s: %w", tx.Hash().Hex(), err)
+	}
+	return tx.Hash(), nil
+}
@@ -2,6 +2,7 @@ package minipool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -21,6 +22,9 @@ import (
 // The number of blocks to look for events in at once when scanning
 const EventScanInterval = 10000
 
+// Returned when a minipool has no MinipoolPrestaked event
+var ErrPrestakeEventNotFound = errors.New("Minipool prestake event not found")
+
 // Minipool detail types
 type StatusDetails struct {
 	Status      ggptypes.MinipoolStatus `json:"status"`
@@ -69,9 +73,12 @@ type Minipool struct {
 
 // Create new minipool contract
 func NewMinipool(ggp *gogopool.GoGoPool, address common.Address) (*Minipool, error) {
+	return NewMinipoolContext(context.Background(), ggp, address)
+}
+func NewMinipoolContext(ctx context.Context, ggp *gogopool.GoGoPool, address common.Address) (*Minipool, error) {
 
 	// Get contract
-	contract, err := getMinipoolContract(ggp, address)
+	contract, err := getMinipoolContract(ctx, ggp, address)
 	if err != nil {
 		return nil, err
 	}
@@ -347,6 +354,11 @@ func (mp *Minipool) EstimateDistributeBalanceAndFinaliseGas(opts *bind.TransactO
 	return mp.Contract.GetTransactionGasInfo(opts, "distributeBalanceAndFinalise")
 }
 
+// Simulate DistributeBalanceAndFinalise without sending it
+func (mp *Minipool) SimulateDistributeBalanceAndFinalise(opts *bind.TransactOpts) (*gogopool.SimulationResult, error) {
+	return mp.Contract.Simulate(opts, "distributeBalanceAndFinalise")
+}
+
 // Distribute the minipool's ETH balance to the node operator and rETH staking pool,
 // then finalises the minipool
 // !!! WARNING !!!
@@ -529,18 +541,19 @@ func (mp *Minipool) VoteScrub(opts *bind.TransactOpts) (common.Hash, error) {
 // Get the data from this minipool's MinipoolPrestaked event
 func (mp *Minipool) GetPrestakeEvent(intervalSize *big.Int, opts *bind.CallOpts) (PrestakeData, error) {
 
+	ctx := gogopool.CallOptsContext(opts)
 	addressFilter := []common.Address{mp.Address}
 	topicFilter := [][]common.Hash{{mp.Contract.ABI.Events["MinipoolPrestaked"].ID}}
 
 	// Grab the latest block number
-	currentBlock, err := mp.GoGoPool.Client.BlockNumber(context.Background())
+	currentBlock, err := mp.GoGoPool.Client.BlockNumber(ctx)
 	if err != nil {
 		return PrestakeData{}, fmt.Errorf("Error getting current block %s: %w", mp.Address.Hex(), err)
 	}
 
 	// Grab the lowest block number worth querying from (should never have to go back this far in practice)
 	deployBlockHash := crypto.Keccak256Hash([]byte("deploy.block"))
-	fromBlockBig, err := mp.GoGoPool.GoGoStorage.GetUint(nil, deployBlockHash)
+	fromBlockBig, err := mp.GoGoPool.GoGoStorage.GetUint(&bind.CallOpts{Context: ctx}, deployBlockHash)
 	if err != nil {
 		return PrestakeData{}, fmt.Errorf("Error getting deploy block %s: %w", mp.Address.Hex(), err)
 	}
@@ -559,7 +572,7 @@ func (mp *Minipool) GetPrestakeEvent(intervalSize *big.Int, opts *bind.CallOpts)
 		fromBig := big.NewInt(0).SetUint64(from)
 		toBig := big.NewInt(0).SetUint64(i)
 
-		logs, err := avax.GetLogs(mp.GoGoPool, addressFilter, topicFilter, intervalSize, fromBig, toBig, nil)
+		logs, err := avax.GetLogsContext(ctx, mp.GoGoPool, addressFilter, topicFilter, intervalSize, fromBig, toBig, nil)
 		if err != nil {
 			return PrestakeData{}, fmt.Errorf("Error getting prestake logs for minipool %s: %w", mp.Address.Hex(), err)
 		}
@@ -573,7 +586,7 @@ func (mp *Minipool) GetPrestakeEvent(intervalSize *big.Int, opts *bind.CallOpts)
 
 	if !found {
 		// This should never happen
-		return PrestakeData{}, fmt.Errorf("Error finding prestake log for minipool %s", mp.Address.Hex())
+		return PrestakeData{}, fmt.Errorf("Error finding prestake log for minipool %s: %w", mp.Address.Hex(), ErrPrestakeEventNotFound)
 	}
 
 	// Decode the event
@@ -598,8 +611,8 @@ func (mp *Minipool) GetPrestakeEvent(intervalSize *big.Int, opts *bind.CallOpts)
 // Get a minipool contract
 var gogoMinipoolLock sync.Mutex
 
-func getMinipoolContract(ggp *gogopool.GoGoPool, minipoolAddress common.Address) (*gogopool.Contract, error) {
+func getMinipoolContract(ctx context.Context, ggp *gogopool.GoGoPool, minipoolAddress common.Address) (*gogopool.Contract, error) {
 	gogoMinipoolLock.Lock()
 	defer gogoMinipoolLock.Unlock()
-	return ggp.MakeContract("rocketMinipool", minipoolAddress)
+	return ggp.MakeContractContext(ctx, ggp.ContractName(gogopool.ContractMinipool), minipoolAddress)
 }
@@ -1,6 +1,7 @@
 package minipool
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -38,53 +39,49 @@ type MinipoolCountsPerStatus struct {
 
 // Get all minipool details
 func GetMinipools(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]MinipoolDetails, error) {
-	minipoolAddresses, err := GetMinipoolAddresses(ggp, opts)
+	return GetMinipoolsContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolsContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]MinipoolDetails, error) {
+	minipoolAddresses, err := GetMinipoolAddressesContext(ctx, ggp, opts)
 	if err != nil {
 		return []MinipoolDetails{}, err
 	}
-	return loadMinipoolDetails(ggp, minipoolAddresses, opts)
+	return loadMinipoolDetails(ctx, ggp, minipoolAddresses, opts)
 }
 
 // Get a node's minipool details
 func GetNodeMinipools(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) ([]MinipoolDetails, error) {
-	minipoolAddresses, err := GetNodeMinipoolAddresses(ggp, nodeAddress, opts)
+	return GetNodeMinipoolsContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeMinipoolsContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) ([]MinipoolDetails, error) {
+	minipoolAddresses, err := GetNodeMinipoolAddressesContext(ctx, ggp, nodeAddress, opts)
 	if err != nil {
 		return []MinipoolDetails{}, err
 	}
-	return loadMinipoolDetails(ggp, minipoolAddresses, opts)
+	return loadMinipoolDetails(ctx, ggp, minipoolAddresses, opts)
 }
 
 // Load minipool details
-func loadMinipoolDetails(ggp *gogopool.GoGoPool, minipoolAddresses []common.Address, opts *bind.CallOpts) ([]MinipoolDetails, error) {
+func loadMinipoolDetails(ctx context.Context, ggp *gogopool.GoGoPool, minipoolAddresses []common.Address, opts *bind.CallOpts) ([]MinipoolDetails, error) {
 
-	// Load minipool details in batches
+	// Load minipool details
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
+	if err != nil {
+		return []MinipoolDetails{}, err
+	}
 	details := make([]MinipoolDetails, len(minipoolAddresses))
-	for bsi := 0; bsi < len(minipoolAddresses); bsi += MinipoolDetailsBatchSize {
-
-		// Get batch start & end index
-		msi := bsi
-		mei := bsi + MinipoolDetailsBatchSize
-		if mei > len(minipoolAddresses) {
-			mei = len(minipoolAddresses)
-		}
-
-		// Load details
-		var wg errgroup.Group
-		for mi := msi; mi < mei; mi++ {
-			mi := mi
-			wg.Go(func() error {
-				minipoolAddress := minipoolAddresses[mi]
-				minipoolDetails, err := GetMinipoolDetails(ggp, minipoolAddress, opts)
-				if err == nil {
-					details[mi] = minipoolDetails
-				}
-				return err
-			})
+	mc := ggp.NewMultiCaller()
+	for mi, minipoolAddress := range minipoolAddresses {
+		details[mi].Address = minipoolAddress
+		if err := mc.AddCall(gogoMinipoolManager, &details[mi].Exists, "getMinipoolExists", minipoolAddress); err != nil {
+			return []MinipoolDetails{}, err
 		}
-		if err := wg.Wait(); err != nil {
+		if err := mc.AddCall(gogoMinipoolManager, &details[mi].Pubkey, "getMinipoolPubkey", minipoolAddress); err != nil {
 			return []MinipoolDetails{}, err
 		}
-
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []MinipoolDetails{}, fmt.Errorf("Could not get minipool details: %w", err)
 	}
 
 	// Return
@@ -94,40 +91,30 @@ func loadMinipoolDetails(ggp *gogopool.GoGoPool, minipoolAddresses []common.Addr
 
 // Get all minipool addresses
 func GetMinipoolAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Address, error) {
+	return GetMinipoolAddressesContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolAddressesContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Address, error) {
 
 	// Get minipool count
-	minipoolCount, err := GetMinipoolCount(ggp, opts)
+	minipoolCount, err := GetMinipoolCountContext(ctx, ggp, opts)
 	if err != nil {
 		return []common.Address{}, err
 	}
 
-	// Load minipool addresses in batches
+	// Load minipool addresses
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
+	if err != nil {
+		return []common.Address{}, err
+	}
 	addresses := make([]common.Address, minipoolCount)
-	for bsi := uint64(0); bsi < minipoolCount; bsi += MinipoolAddressBatchSize {
-
-		// Get batch start & end index
-		msi := bsi
-		mei := bsi + MinipoolAddressBatchSize
-		if mei > minipoolCount {
-			mei = minipoolCount
-		}
-
-		// Load addresses
-		var wg errgroup.Group
-		for mi := msi; mi < mei; mi++ {
-			mi := mi
-			wg.Go(func() error {
-				address, err := GetMinipoolAt(ggp, mi, opts)
-				if err == nil {
-					addresses[mi] = address
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
+	mc := ggp.NewMultiCaller()
+	for mi := uint64(0); mi < minipoolCount; mi++ {
+		if err := mc.AddCall(gogoMinipoolManager, &addresses[mi], "getMinipoolAt", big.NewInt(int64(mi))); err != nil {
 			return []common.Address{}, err
 		}
-
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []common.Address{}, fmt.Errorf("Could not get minipool addresses: %w", err)
 	}
 
 	// Return
@@ -137,14 +124,17 @@ func GetMinipoolAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common
 
 // Get the addresses of all minipools in prelaunch status
 func GetPrelaunchMinipoolAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Address, error) {
+	return GetPrelaunchMinipoolAddressesContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetPrelaunchMinipoolAddressesContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Address, error) {
 
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return []common.Address{}, err
 	}
 
 	// Get the total number of minipools
-	totalMinipoolsUint, err := GetMinipoolCount(ggp, nil)
+	totalMinipoolsUint, err := GetMinipoolCountContext(ctx, ggp, nil)
 	if err != nil {
 		return []common.Address{}, err
 	}
@@ -156,7 +146,7 @@ func GetPrelaunchMinipoolAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts)
 		// Get a batch of addresses
 		offset := big.NewInt(i)
 		newAddresses := new([]common.Address)
-		if err := gogoMinipoolManager.Call(opts, newAddresses, "getPrelaunchMinipools", offset, limit); err != nil {
+		if err := gogoMinipoolManager.CallContext(ctx, opts, newAddresses, "getPrelaunchMinipools", offset, limit); err != nil {
 			return []common.Address{}, fmt.Errorf("Could not get prelaunch minipool addresses: %w", err)
 		}
 		addresses = append(addresses, *newAddresses...)
@@ -167,40 +157,30 @@ func GetPrelaunchMinipoolAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts)
 
 // Get a node's minipool addresses
 func GetNodeMinipoolAddresses(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) ([]common.Address, error) {
+	return GetNodeMinipoolAddressesContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeMinipoolAddressesContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) ([]common.Address, error) {
 
 	// Get minipool count
-	minipoolCount, err := GetNodeMinipoolCount(ggp, nodeAddress, opts)
+	minipoolCount, err := GetNodeMinipoolCountContext(ctx, ggp, nodeAddress, opts)
 	if err != nil {
 		return []common.Address{}, err
 	}
 
-	// Load minipool addresses in batches
+	// Load minipool addresses
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
+	if err != nil {
+		return []common.Address{}, err
+	}
 	addresses := make([]common.Address, minipoolCount)
-	for bsi := uint64(0); bsi < minipoolCount; bsi += MinipoolAddressBatchSize {
-
-		// Get batch start & end index
-		msi := bsi
-		mei := bsi + MinipoolAddressBatchSize
-		if mei > minipoolCount {
-			mei = minipoolCount
-		}
-
-		// Load addresses
-		var wg errgroup.Group
-		for mi := msi; mi < mei; mi++ {
-			mi := mi
-			wg.Go(func() error {
-				address, err := GetNodeMinipoolAt(ggp, nodeAddress, mi, opts)
-				if err == nil {
-					addresses[mi] = address
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
+	mc := ggp.NewMultiCaller()
+	for mi := uint64(0); mi < minipoolCount; mi++ {
+		if err := mc.AddCall(gogoMinipoolManager, &addresses[mi], "getNodeMinipoolAt", nodeAddress, big.NewInt(int64(mi))); err != nil {
 			return []common.Address{}, err
 		}
-
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []common.Address{}, fmt.Errorf("Could not get node %s minipool addresses: %w", nodeAddress.Hex(), err)
 	}
 
 	// Return
@@ -210,9 +190,12 @@ func GetNodeMinipoolAddresses(ggp *gogopool.GoGoPool, nodeAddress common.Address
 
 // Get a node's validating minipool pubkeys
 func GetNodeValidatingMinipoolPubkeys(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) ([]ggptypes.ValidatorPubkey, error) {
+	return GetNodeValidatingMinipoolPubkeysContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeValidatingMinipoolPubkeysContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) ([]ggptypes.ValidatorPubkey, error) {
 
 	// Get minipool count
-	minipoolCount, err := GetNodeValidatingMinipoolCount(ggp, nodeAddress, opts)
+	minipoolCount, err := GetNodeValidatingMinipoolCountContext(ctx, ggp, nodeAddress, opts)
 	if err != nil {
 		return []ggptypes.ValidatorPubkey{}, err
 	}
@@ -234,11 +217,11 @@ func GetNodeValidatingMinipoolPubkeys(ggp *gogopool.GoGoPool, nodeAddress common
 		for mi := msi; mi < mei; mi++ {
 			mi := mi
 			wg.Go(func() error {
-				minipoolAddress, err := GetNodeValidatingMinipoolAt(ggp, nodeAddress, mi, opts)
+				minipoolAddress, err := GetNodeValidatingMinipoolAtContext(ctx, ggp, nodeAddress, mi, opts)
 				if err != nil {
 					return err
 				}
-				pubkey, err := GetMinipoolPubkey(ggp, minipoolAddress, opts)
+				pubkey, err := GetMinipoolPubkeyContext(ctx, ggp, minipoolAddress, opts)
 				if err != nil {
 					return err
 				}
@@ -261,6 +244,9 @@ func GetNodeValidatingMinipoolPubkeys(ggp *gogopool.GoGoPool, nodeAddress common
 
 // Get a minipool's details
 func GetMinipoolDetails(ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.CallOpts) (MinipoolDetails, error) {
+	return GetMinipoolDetailsContext(gogopool.CallOptsContext(opts), ggp, minipoolAddress, opts)
+}
+func GetMinipoolDetailsContext(ctx context.Context, ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.CallOpts) (MinipoolDetails, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -270,12 +256,12 @@ func GetMinipoolDetails(ggp *gogopool.GoGoPool, minipoolAddress common.Address,
 	// Load data
 	wg.Go(func() error {
 		var err error
-		exists, err = GetMinipoolExists(ggp, minipoolAddress, opts)
+		exists, err = GetMinipoolExistsContext(ctx, ggp, minipoolAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		pubkey, err = GetMinipoolPubkey(ggp, minipoolAddress, opts)
+		pubkey, err = GetMinipoolPubkeyContext(ctx, ggp, minipoolAddress, opts)
 		return err
 	})
 
@@ -295,12 +281,15 @@ func GetMinipoolDetails(ggp *gogopool.GoGoPool, minipoolAddress common.Address,
 
 // Get the minipool count
 func GetMinipoolCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetMinipoolCountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolCountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minipoolCount := new(*big.Int)
-	if err := gogoMinipoolManager.Call(opts, minipoolCount, "getMinipoolCount"); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolCount, "getMinipoolCount"); err != nil {
 		return 0, fmt.Errorf("Could not get minipool count: %w", err)
 	}
 	return (*minipoolCount).Uint64(), nil
@@ -308,12 +297,15 @@ func GetMinipoolCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, erro
 
 // Get the number of finalised minipools in the network
 func GetFinalisedMinipoolCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetFinalisedMinipoolCountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetFinalisedMinipoolCountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minipoolCount := new(*big.Int)
-	if err := gogoMinipoolManager.Call(opts, minipoolCount, "getFinalisedMinipoolCount"); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolCount, "getFinalisedMinipoolCount"); err != nil {
 		return 0, fmt.Errorf("Could not get finalised minipool count: %w", err)
 	}
 	return (*minipoolCount).Uint64(), nil
@@ -321,12 +313,15 @@ func GetFinalisedMinipoolCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uin
 
 // Get the number of active minipools in the network
 func GetActiveMinipoolCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetActiveMinipoolCountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetActiveMinipoolCountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minipoolCount := new(*big.Int)
-	if err := gogoMinipoolManager.Call(opts, minipoolCount, "getActiveMinipoolCount"); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolCount, "getActiveMinipoolCount"); err != nil {
 		return 0, fmt.Errorf("Could not get finalised minipool count: %w", err)
 	}
 	return (*minipoolCount).Uint64(), nil
@@ -334,13 +329,16 @@ func GetActiveMinipoolCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64
 
 // Get the minipool count by status
 func GetMinipoolCountPerStatus(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (MinipoolCountsPerStatus, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetMinipoolCountPerStatusContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolCountPerStatusContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (MinipoolCountsPerStatus, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return MinipoolCountsPerStatus{}, err
 	}
 
 	// Get the total number of minipools
-	totalMinipoolsUint, err := GetMinipoolCount(ggp, nil)
+	totalMinipoolsUint, err := GetMinipoolCountContext(ctx, ggp, nil)
 	if err != nil {
 		return MinipoolCountsPerStatus{}, err
 	}
@@ -358,7 +356,7 @@ func GetMinipoolCountPerStatus(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (Min
 		// Get a batch of counts
 		offset := big.NewInt(i)
 		newMinipoolCounts := new(MinipoolCountsPerStatus)
-		if err := gogoMinipoolManager.Call(opts, newMinipoolCounts, "getMinipoolCountPerStatus", offset, limit); err != nil {
+		if err := gogoMinipoolManager.CallContext(ctx, opts, newMinipoolCounts, "getMinipoolCountPerStatus", offset, limit); err != nil {
 			return MinipoolCountsPerStatus{}, fmt.Errorf("Could not get minipool counts: %w", err)
 		}
 		if newMinipoolCounts != nil {
@@ -384,12 +382,15 @@ func GetMinipoolCountPerStatus(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (Min
 
 // Get a minipool address by index
 func GetMinipoolAt(ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (common.Address, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetMinipoolAtContext(gogopool.CallOptsContext(opts), ggp, index, opts)
+}
+func GetMinipoolAtContext(ctx context.Context, ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (common.Address, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
 	minipoolAddress := new(common.Address)
-	if err := gogoMinipoolManager.Call(opts, minipoolAddress, "getMinipoolAt", big.NewInt(int64(index))); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolAddress, "getMinipoolAt", big.NewInt(int64(index))); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get minipool %d address: %w", index, err)
 	}
 	return *minipoolAddress, nil
@@ -397,12 +398,15 @@ func GetMinipoolAt(ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (c
 
 // Get a node's minipool count
 func GetNodeMinipoolCount(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetNodeMinipoolCountContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeMinipoolCountContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minipoolCount := new(*big.Int)
-	if err := gogoMinipoolManager.Call(opts, minipoolCount, "getNodeMinipoolCount", nodeAddress); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolCount, "getNodeMinipoolCount", nodeAddress); err != nil {
 		return 0, fmt.Errorf("Could not get node %s minipool count: %w", nodeAddress.Hex(), err)
 	}
 	return (*minipoolCount).Uint64(), nil
@@ -410,12 +414,15 @@ func GetNodeMinipoolCount(ggp *gogopool.GoGoPool, nodeAddress common.Address, op
 
 // Get the number of minipools owned by a node that are not finalised
 func GetNodeActiveMinipoolCount(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetNodeActiveMinipoolCountContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeActiveMinipoolCountContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minipoolCount := new(*big.Int)
-	if err := gogoMinipoolManager.Call(opts, minipoolCount, "getNodeActiveMinipoolCount", nodeAddress); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolCount, "getNodeActiveMinipoolCount", nodeAddress); err != nil {
 		return 0, fmt.Errorf("Could not get node %s minipool count: %w", nodeAddress.Hex(), err)
 	}
 	return (*minipoolCount).Uint64(), nil
@@ -423,12 +430,15 @@ func GetNodeActiveMinipoolCount(ggp *gogopool.GoGoPool, nodeAddress common.Addre
 
 // Get the number of minipools owned by a node that are finalised
 func GetNodeFinalisedMinipoolCount(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetNodeFinalisedMinipoolCountContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeFinalisedMinipoolCountContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minipoolCount := new(*big.Int)
-	if err := gogoMinipoolManager.Call(opts, minipoolCount, "getNodeFinalisedMinipoolCount", nodeAddress); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolCount, "getNodeFinalisedMinipoolCount", nodeAddress); err != nil {
 		return 0, fmt.Errorf("Could not get node %s minipool count: %w", nodeAddress.Hex(), err)
 	}
 	return (*minipoolCount).Uint64(), nil
@@ -436,12 +446,15 @@ func GetNodeFinalisedMinipoolCount(ggp *gogopool.GoGoPool, nodeAddress common.Ad
 
 // Get a node's minipool address by index
 func GetNodeMinipoolAt(ggp *gogopool.GoGoPool, nodeAddress common.Address, index uint64, opts *bind.CallOpts) (common.Address, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetNodeMinipoolAtContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, index, opts)
+}
+func GetNodeMinipoolAtContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, index uint64, opts *bind.CallOpts) (common.Address, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
 	minipoolAddress := new(common.Address)
-	if err := gogoMinipoolManager.Call(opts, minipoolAddress, "getNodeMinipoolAt", nodeAddress, big.NewInt(int64(index))); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolAddress, "getNodeMinipoolAt", nodeAddress, big.NewInt(int64(index))); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get node %s minipool %d address: %w", nodeAddress.Hex(), index, err)
 	}
 	return *minipoolAddress, nil
@@ -449,12 +462,15 @@ func GetNodeMinipoolAt(ggp *gogopool.GoGoPool, nodeAddress common.Address, index
 
 // Get a node's validating minipool count
 func GetNodeValidatingMinipoolCount(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetNodeValidatingMinipoolCountContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeValidatingMinipoolCountContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minipoolCount := new(*big.Int)
-	if err := gogoMinipoolManager.Call(opts, minipoolCount, "getNodeValidatingMinipoolCount", nodeAddress); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolCount, "getNodeValidatingMinipoolCount", nodeAddress); err != nil {
 		return 0, fmt.Errorf("Could not get node %s validating minipool count: %w", nodeAddress.Hex(), err)
 	}
 	return (*minipoolCount).Uint64(), nil
@@ -462,12 +478,15 @@ func GetNodeValidatingMinipoolCount(ggp *gogopool.GoGoPool, nodeAddress common.A
 
 // Get a node's validating minipool address by index
 func GetNodeValidatingMinipoolAt(ggp *gogopool.GoGoPool, nodeAddress common.Address, index uint64, opts *bind.CallOpts) (common.Address, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetNodeValidatingMinipoolAtContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, index, opts)
+}
+func GetNodeValidatingMinipoolAtContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, index uint64, opts *bind.CallOpts) (common.Address, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
 	minipoolAddress := new(common.Address)
-	if err := gogoMinipoolManager.Call(opts, minipoolAddress, "getNodeValidatingMinipoolAt", nodeAddress, big.NewInt(int64(index))); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolAddress, "getNodeValidatingMinipoolAt", nodeAddress, big.NewInt(int64(index))); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get node %s validating minipool %d address: %w", nodeAddress.Hex(), index, err)
 	}
 	return *minipoolAddress, nil
@@ -475,12 +494,15 @@ func GetNodeValidatingMinipoolAt(ggp *gogopool.GoGoPool, nodeAddress common.Addr
 
 // Get a minipool address by validator pubkey
 func GetMinipoolByPubkey(ggp *gogopool.GoGoPool, pubkey ggptypes.ValidatorPubkey, opts *bind.CallOpts) (common.Address, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetMinipoolByPubkeyContext(gogopool.CallOptsContext(opts), ggp, pubkey, opts)
+}
+func GetMinipoolByPubkeyContext(ctx context.Context, ggp *gogopool.GoGoPool, pubkey ggptypes.ValidatorPubkey, opts *bind.CallOpts) (common.Address, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
 	minipoolAddress := new(common.Address)
-	if err := gogoMinipoolManager.Call(opts, minipoolAddress, "getMinipoolByPubkey", pubkey[:]); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, minipoolAddress, "getMinipoolByPubkey", pubkey[:]); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get validator %s minipool address: %w", pubkey.Hex(), err)
 	}
 	return *minipoolAddress, nil
@@ -488,12 +510,15 @@ func GetMinipoolByPubkey(ggp *gogopool.GoGoPool, pubkey ggptypes.ValidatorPubkey
 
 // Check whether a minipool exists
 func GetMinipoolExists(ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.CallOpts) (bool, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetMinipoolExistsContext(gogopool.CallOptsContext(opts), ggp, minipoolAddress, opts)
+}
+func GetMinipoolExistsContext(ctx context.Context, ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.CallOpts) (bool, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	exists := new(bool)
-	if err := gogoMinipoolManager.Call(opts, exists, "getMinipoolExists", minipoolAddress); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, exists, "getMinipoolExists", minipoolAddress); err != nil {
 		return false, fmt.Errorf("Could not get minipool %s exists status: %w", minipoolAddress.Hex(), err)
 	}
 	return *exists, nil
@@ -501,12 +526,15 @@ func GetMinipoolExists(ggp *gogopool.GoGoPool, minipoolAddress common.Address, o
 
 // Get a minipool's validator pubkey
 func GetMinipoolPubkey(ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.CallOpts) (ggptypes.ValidatorPubkey, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetMinipoolPubkeyContext(gogopool.CallOptsContext(opts), ggp, minipoolAddress, opts)
+}
+func GetMinipoolPubkeyContext(ctx context.Context, ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.CallOpts) (ggptypes.ValidatorPubkey, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return ggptypes.ValidatorPubkey{}, err
 	}
 	pubkey := new(ggptypes.ValidatorPubkey)
-	if err := gogoMinipoolManager.Call(opts, pubkey, "getMinipoolPubkey", minipoolAddress); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, pubkey, "getMinipoolPubkey", minipoolAddress); err != nil {
 		return ggptypes.ValidatorPubkey{}, fmt.Errorf("Could not get minipool %s pubkey: %w", minipoolAddress.Hex(), err)
 	}
 	return *pubkey, nil
@@ -514,12 +542,15 @@ func GetMinipoolPubkey(ggp *gogopool.GoGoPool, minipoolAddress common.Address, o
 
 // Get the CreationCode binary for the GoGoMinipool contract that will be created by node deposits
 func GetMinipoolBytecode(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]byte, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetMinipoolBytecodeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolBytecodeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]byte, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return []byte{}, err
 	}
 	bytecode := new([]byte)
-	if err := gogoMinipoolManager.Call(opts, bytecode, "getMinipoolBytecode"); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, bytecode, "getMinipoolBytecode"); err != nil {
 		return []byte{}, fmt.Errorf("Could not get minipool contract bytecode: %w", err)
 	}
 	return *bytecode, nil
@@ -527,12 +558,15 @@ func GetMinipoolBytecode(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]byte, e
 
 // Get the 0x01-based Beacon Chain withdrawal credentials for a given minipool
 func GetMinipoolWithdrawalCredentials(ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.CallOpts) (common.Hash, error) {
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	return GetMinipoolWithdrawalCredentialsContext(gogopool.CallOptsContext(opts), ggp, minipoolAddress, opts)
+}
+func GetMinipoolWithdrawalCredentialsContext(ctx context.Context, ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.CallOpts) (common.Hash, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
 	withdrawalCredentials := new(common.Hash)
-	if err := gogoMinipoolManager.Call(opts, withdrawalCredentials, "getMinipoolWithdrawalCredentials", minipoolAddress); err != nil {
+	if err := gogoMinipoolManager.CallContext(ctx, opts, withdrawalCredentials, "getMinipoolWithdrawalCredentials", minipoolAddress); err != nil {
 		return common.Hash{}, fmt.Errorf("Could not get minipool withdrawal credentials: %w", err)
 	}
 	return *withdrawalCredentials, nil
@@ -541,8 +575,8 @@ func GetMinipoolWithdrawalCredentials(ggp *gogopool.GoGoPool, minipoolAddress co
 // Get contracts
 var gogoMinipoolManagerLock sync.Mutex
 
-func getGoGoMinipoolManager(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoMinipoolManager(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoMinipoolManagerLock.Lock()
 	defer gogoMinipoolManagerLock.Unlock()
-	return ggp.GetContract("rocketMinipoolManager")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractMinipoolManager))
 }
@@ -1,6 +1,7 @@
 package minipool
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -29,6 +30,9 @@ type QueueCapacity struct {
 
 // Get minipool queue lengths
 func GetQueueLengths(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (QueueLengths, error) {
+	return GetQueueLengthsContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetQueueLengthsContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (QueueLengths, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -40,22 +44,22 @@ func GetQueueLengths(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (QueueLengths,
 	// Load data
 	wg.Go(func() error {
 		var err error
-		total, err = GetQueueTotalLength(ggp, opts)
+		total, err = GetQueueTotalLengthContext(ctx, ggp, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		fullDeposit, err = GetQueueLength(ggp, ggptypes.Full, opts)
+		fullDeposit, err = GetQueueLengthContext(ctx, ggp, ggptypes.Full, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		halfDeposit, err = GetQueueLength(ggp, ggptypes.Half, opts)
+		halfDeposit, err = GetQueueLengthContext(ctx, ggp, ggptypes.Half, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		emptyDeposit, err = GetQueueLength(ggp, ggptypes.Empty, opts)
+		emptyDeposit, err = GetQueueLengthContext(ctx, ggp, ggptypes.Empty, opts)
 		return err
 	})
 
@@ -76,6 +80,9 @@ func GetQueueLengths(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (QueueLengths,
 
 // Get minipool queue capacity
 func GetQueueCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (QueueCapacity, error) {
+	return GetQueueCapacityContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetQueueCapacityContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (QueueCapacity, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -86,17 +93,17 @@ func GetQueueCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (QueueCapacit
 	// Load data
 	wg.Go(func() error {
 		var err error
-		total, err = GetQueueTotalCapacity(ggp, opts)
+		total, err = GetQueueTotalCapacityContext(ctx, ggp, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		effective, err = GetQueueEffectiveCapacity(ggp, opts)
+		effective, err = GetQueueEffectiveCapacityContext(ctx, ggp, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		nextMinipool, err = GetQueueNextCapacity(ggp, opts)
+		nextMinipool, err = GetQueueNextCapacityContext(ctx, ggp, opts)
 		return err
 	})
 
@@ -116,12 +123,15 @@ func GetQueueCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (QueueCapacit
 
 // Get the total length of the minipool queue
 func GetQueueTotalLength(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ggp)
+	return GetQueueTotalLengthContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetQueueTotalLengthContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	length := new(*big.Int)
-	if err := gogoMinipoolQueue.Call(opts, length, "getTotalLength"); err != nil {
+	if err := gogoMinipoolQueue.CallContext(ctx, opts, length, "getTotalLength"); err != nil {
 		return 0, fmt.Errorf("Could not get minipool queue total length: %w", err)
 	}
 	return (*length).Uint64(), nil
@@ -129,12 +139,15 @@ func GetQueueTotalLength(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, e
 
 // Get the length of a single minipool queue
 func GetQueueLength(ggp *gogopool.GoGoPool, depositType ggptypes.MinipoolDeposit, opts *bind.CallOpts) (uint64, error) {
-	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ggp)
+	return GetQueueLengthContext(gogopool.CallOptsContext(opts), ggp, depositType, opts)
+}
+func GetQueueLengthContext(ctx context.Context, ggp *gogopool.GoGoPool, depositType ggptypes.MinipoolDeposit, opts *bind.CallOpts) (uint64, error) {
+	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	length := new(*big.Int)
-	if err := gogoMinipoolQueue.Call(opts, length, "getLength", depositType); err != nil {
+	if err := gogoMinipoolQueue.CallContext(ctx, opts, length, "getLength", depositType); err != nil {
 		return 0, fmt.Errorf("Could not get minipool queue length for deposit type %d: %w", depositType, err)
 	}
 	return (*length).Uint64(), nil
@@ -142,12 +155,15 @@ func GetQueueLength(ggp *gogopool.GoGoPool, depositType ggptypes.MinipoolDeposit
 
 // Get the total capacity of the minipool queue
 func GetQueueTotalCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ggp)
+	return GetQueueTotalCapacityContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetQueueTotalCapacityContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	capacity := new(*big.Int)
-	if err := gogoMinipoolQueue.Call(opts, capacity, "getTotalCapacity"); err != nil {
+	if err := gogoMinipoolQueue.CallContext(ctx, opts, capacity, "getTotalCapacity"); err != nil {
 		return nil, fmt.Errorf("Could not get minipool queue total capacity: %w", err)
 	}
 	return *capacity, nil
@@ -155,12 +171,15 @@ func GetQueueTotalCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.In
 
 // Get the total effective capacity of the minipool queue (used in node demand calculation)
 func GetQueueEffectiveCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ggp)
+	return GetQueueEffectiveCapacityContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetQueueEffectiveCapacityContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	capacity := new(*big.Int)
-	if err := gogoMinipoolQueue.Call(opts, capacity, "getEffectiveCapacity"); err != nil {
+	if err := gogoMinipoolQueue.CallContext(ctx, opts, capacity, "getEffectiveCapacity"); err != nil {
 		return nil, fmt.Errorf("Could not get minipool queue effective capacity: %w", err)
 	}
 	return *capacity, nil
@@ -168,12 +187,15 @@ func GetQueueEffectiveCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*bi
 
 // Get the capacity of the next minipool in the queue
 func GetQueueNextCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ggp)
+	return GetQueueNextCapacityContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetQueueNextCapacityContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoMinipoolQueue, err := getGoGoMinipoolQueue(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	capacity := new(*big.Int)
-	if err := gogoMinipoolQueue.Call(opts, capacity, "getNextCapacity"); err != nil {
+	if err := gogoMinipoolQueue.CallContext(ctx, opts, capacity, "getNextCapacity"); err != nil {
 		return nil, fmt.Errorf("Could not get minipool queue next item capacity: %w", err)
 	}
 	return *capacity, nil
@@ -182,8 +204,8 @@ func GetQueueNextCapacity(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int
 // Get contracts
 var gogoMinipoolQueueLock sync.Mutex
 
-func getGoGoMinipoolQueue(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoMinipoolQueue(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoMinipoolQueueLock.Lock()
 	defer gogoMinipoolQueueLock.Unlock()
-	return ggp.GetContract("rocketMinipoolQueue")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractMinipoolQueue))
 }
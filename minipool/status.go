@@ -1,6 +1,7 @@
 package minipool
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -12,20 +13,26 @@ import (
 
 // Estimate the gas of SubmitMinipoolWithdrawable
 func EstimateSubmitMinipoolWithdrawableGas(ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoMinipoolStatus, err := getGoGoMinipoolStatus(ggp)
+	return EstimateSubmitMinipoolWithdrawableGasContext(gogopool.TransactOptsContext(opts), ggp, minipoolAddress, opts)
+}
+func EstimateSubmitMinipoolWithdrawableGasContext(ctx context.Context, ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoMinipoolStatus, err := getGoGoMinipoolStatus(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoMinipoolStatus.GetTransactionGasInfo(opts, "submitMinipoolWithdrawable", minipoolAddress)
+	return gogoMinipoolStatus.GetTransactionGasInfoContext(ctx, opts, "submitMinipoolWithdrawable", minipoolAddress)
 }
 
 // Submit a minipool withdrawable event
 func SubmitMinipoolWithdrawable(ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoMinipoolStatus, err := getGoGoMinipoolStatus(ggp)
+	return SubmitMinipoolWithdrawableContext(gogopool.TransactOptsContext(opts), ggp, minipoolAddress, opts)
+}
+func SubmitMinipoolWithdrawableContext(ctx context.Context, ggp *gogopool.GoGoPool, minipoolAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoMinipoolStatus, err := getGoGoMinipoolStatus(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoMinipoolStatus.Transact(opts, "submitMinipoolWithdrawable", minipoolAddress)
+	hash, err := gogoMinipoolStatus.TransactContext(ctx, opts, "submitMinipoolWithdrawable", minipoolAddress)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not submit minipool withdrawable event: %w", err)
 	}
@@ -35,8 +42,8 @@ func SubmitMinipoolWithdrawable(ggp *gogopool.GoGoPool, minipoolAddress common.A
 // Get contracts
 var gogoMinipoolStatusLock sync.Mutex
 
-func getGoGoMinipoolStatus(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoMinipoolStatus(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoMinipoolStatusLock.Lock()
 	defer gogoMinipoolStatusLock.Unlock()
-	return ggp.GetContract("rocketMinipoolStatus")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractMinipoolStatus))
 }
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,12 +15,15 @@ import (
 
 // Get the block number which network balances are current for
 func GetBalancesBlock(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	return GetBalancesBlockContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetBalancesBlockContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	balancesBlock := new(*big.Int)
-	if err := gogoNetworkBalances.Call(opts, balancesBlock, "getBalancesBlock"); err != nil {
+	if err := gogoNetworkBalances.CallContext(ctx, opts, balancesBlock, "getBalancesBlock"); err != nil {
 		return 0, fmt.Errorf("Could not get network balances block: %w", err)
 	}
 	return (*balancesBlock).Uint64(), nil
@@ -27,12 +31,15 @@ func GetBalancesBlock(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, erro
 
 // Get the current network total ETH balance
 func GetTotalETHBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	return GetTotalETHBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTotalETHBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	totalEthBalance := new(*big.Int)
-	if err := gogoNetworkBalances.Call(opts, totalEthBalance, "getTotalETHBalance"); err != nil {
+	if err := gogoNetworkBalances.CallContext(ctx, opts, totalEthBalance, "getTotalETHBalance"); err != nil {
 		return nil, fmt.Errorf("Could not get network total ETH balance: %w", err)
 	}
 	return *totalEthBalance, nil
@@ -40,12 +47,15 @@ func GetTotalETHBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int,
 
 // Get the current network staking ETH balance
 func GetStakingETHBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	return GetStakingETHBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetStakingETHBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	stakingEthBalance := new(*big.Int)
-	if err := gogoNetworkBalances.Call(opts, stakingEthBalance, "getStakingETHBalance"); err != nil {
+	if err := gogoNetworkBalances.CallContext(ctx, opts, stakingEthBalance, "getStakingETHBalance"); err != nil {
 		return nil, fmt.Errorf("Could not get network staking ETH balance: %w", err)
 	}
 	return *stakingEthBalance, nil
@@ -53,12 +63,15 @@ func GetStakingETHBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int
 
 // Get the current network total rETH supply
 func GetTotalRETHSupply(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	return GetTotalRETHSupplyContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTotalRETHSupplyContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	totalRethSupply := new(*big.Int)
-	if err := gogoNetworkBalances.Call(opts, totalRethSupply, "getTotalRETHSupply"); err != nil {
+	if err := gogoNetworkBalances.CallContext(ctx, opts, totalRethSupply, "getTotalRETHSupply"); err != nil {
 		return nil, fmt.Errorf("Could not get network total rETH supply: %w", err)
 	}
 	return *totalRethSupply, nil
@@ -66,12 +79,15 @@ func GetTotalRETHSupply(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int,
 
 // Get the current network ETH utilization rate
 func GetETHUtilizationRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	return GetETHUtilizationRateContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetETHUtilizationRateContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	ethUtilizationRate := new(*big.Int)
-	if err := gogoNetworkBalances.Call(opts, ethUtilizationRate, "getETHUtilizationRate"); err != nil {
+	if err := gogoNetworkBalances.CallContext(ctx, opts, ethUtilizationRate, "getETHUtilizationRate"); err != nil {
 		return 0, fmt.Errorf("Could not get network ETH utilization rate: %w", err)
 	}
 	return avax.WeiToEth(*ethUtilizationRate), nil
@@ -79,20 +95,26 @@ func GetETHUtilizationRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64
 
 // Estimate the gas of SubmitBalances
 func EstimateSubmitBalancesGas(ggp *gogopool.GoGoPool, block uint64, totalEth, stakingEth, rethSupply *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	return EstimateSubmitBalancesGasContext(gogopool.TransactOptsContext(opts), ggp, block, totalEth, stakingEth, rethSupply, opts)
+}
+func EstimateSubmitBalancesGasContext(ctx context.Context, ggp *gogopool.GoGoPool, block uint64, totalEth, stakingEth, rethSupply *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoNetworkBalances.GetTransactionGasInfo(opts, "submitBalances", big.NewInt(int64(block)), totalEth, stakingEth, rethSupply)
+	return gogoNetworkBalances.GetTransactionGasInfoContext(ctx, opts, "submitBalances", big.NewInt(int64(block)), totalEth, stakingEth, rethSupply)
 }
 
 // Submit network balances for an epoch
 func SubmitBalances(ggp *gogopool.GoGoPool, block uint64, totalEth, stakingEth, rethSupply *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	return SubmitBalancesContext(gogopool.TransactOptsContext(opts), ggp, block, totalEth, stakingEth, rethSupply, opts)
+}
+func SubmitBalancesContext(ctx context.Context, ggp *gogopool.GoGoPool, block uint64, totalEth, stakingEth, rethSupply *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoNetworkBalances.Transact(opts, "submitBalances", big.NewInt(int64(block)), totalEth, stakingEth, rethSupply)
+	hash, err := gogoNetworkBalances.TransactContext(ctx, opts, "submitBalances", big.NewInt(int64(block)), totalEth, stakingEth, rethSupply)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not submit network balances: %w", err)
 	}
@@ -101,12 +123,15 @@ func SubmitBalances(ggp *gogopool.GoGoPool, block uint64, totalEth, stakingEth,
 
 // Returns the latest block number that oracles should be reporting balances for
 func GetLatestReportableBalancesBlock(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	return GetLatestReportableBalancesBlockContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLatestReportableBalancesBlockContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	latestReportableBlock := new(*big.Int)
-	if err := gogoNetworkBalances.Call(opts, latestReportableBlock, "getLatestReportableBlock"); err != nil {
+	if err := gogoNetworkBalances.CallContext(ctx, opts, latestReportableBlock, "getLatestReportableBlock"); err != nil {
 		return nil, fmt.Errorf("Could not get latest reportable block: %w", err)
 	}
 	return *latestReportableBlock, nil
@@ -115,8 +140,8 @@ func GetLatestReportableBalancesBlock(ggp *gogopool.GoGoPool, opts *bind.CallOpt
 // Get contracts
 var gogoNetworkBalancesLock sync.Mutex
 
-func getGoGoNetworkBalances(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoNetworkBalances(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoNetworkBalancesLock.Lock()
 	defer gogoNetworkBalancesLock.Unlock()
-	return ggp.GetContract("rocketNetworkBalances")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNetworkBalances))
 }
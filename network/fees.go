@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -13,12 +14,15 @@ import (
 
 // Get the current network node demand in ETH
 func GetNodeDemand(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNetworkFees, err := getGoGoNetworkFees(ggp)
+	return GetNodeDemandContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeDemandContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNetworkFees, err := getGoGoNetworkFees(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	nodeDemand := new(*big.Int)
-	if err := gogoNetworkFees.Call(opts, nodeDemand, "getNodeDemand"); err != nil {
+	if err := gogoNetworkFees.CallContext(ctx, opts, nodeDemand, "getNodeDemand"); err != nil {
 		return nil, fmt.Errorf("Could not get network node demand: %w", err)
 	}
 	return *nodeDemand, nil
@@ -26,12 +30,15 @@ func GetNodeDemand(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error
 
 // Get the current network node commission rate
 func GetNodeFee(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	gogoNetworkFees, err := getGoGoNetworkFees(ggp)
+	return GetNodeFeeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	gogoNetworkFees, err := getGoGoNetworkFees(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	nodeFee := new(*big.Int)
-	if err := gogoNetworkFees.Call(opts, nodeFee, "getNodeFee"); err != nil {
+	if err := gogoNetworkFees.CallContext(ctx, opts, nodeFee, "getNodeFee"); err != nil {
 		return 0, fmt.Errorf("Could not get network node fee: %w", err)
 	}
 	return avax.WeiToEth(*nodeFee), nil
@@ -39,12 +46,15 @@ func GetNodeFee(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
 
 // Get the network node fee for a node demand value
 func GetNodeFeeByDemand(ggp *gogopool.GoGoPool, nodeDemand *big.Int, opts *bind.CallOpts) (float64, error) {
-	gogoNetworkFees, err := getGoGoNetworkFees(ggp)
+	return GetNodeFeeByDemandContext(gogopool.CallOptsContext(opts), ggp, nodeDemand, opts)
+}
+func GetNodeFeeByDemandContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeDemand *big.Int, opts *bind.CallOpts) (float64, error) {
+	gogoNetworkFees, err := getGoGoNetworkFees(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	nodeFee := new(*big.Int)
-	if err := gogoNetworkFees.Call(opts, nodeFee, "getNodeFeeByDemand", nodeDemand); err != nil {
+	if err := gogoNetworkFees.CallContext(ctx, opts, nodeFee, "getNodeFeeByDemand", nodeDemand); err != nil {
 		return 0, fmt.Errorf("Could not get node fee by node demand: %w", err)
 	}
 	return avax.WeiToEth(*nodeFee), nil
@@ -53,8 +63,8 @@ func GetNodeFeeByDemand(ggp *gogopool.GoGoPool, nodeDemand *big.Int, opts *bind.
 // Get contracts
 var gogoNetworkFeesLock sync.Mutex
 
-func getGoGoNetworkFees(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoNetworkFees(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoNetworkFeesLock.Lock()
 	defer gogoNetworkFeesLock.Unlock()
-	return ggp.GetContract("rocketNetworkFees")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNetworkFees))
 }
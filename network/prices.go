@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -13,12 +14,15 @@ import (
 
 // Get the block number which network prices are current for
 func GetPricesBlock(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoNetworkPrices, err := getGoGoNetworkPrices(ggp)
+	return GetPricesBlockContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetPricesBlockContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoNetworkPrices, err := getGoGoNetworkPrices(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	pricesBlock := new(*big.Int)
-	if err := gogoNetworkPrices.Call(opts, pricesBlock, "getPricesBlock"); err != nil {
+	if err := gogoNetworkPrices.CallContext(ctx, opts, pricesBlock, "getPricesBlock"); err != nil {
 		return 0, fmt.Errorf("Could not get network prices block: %w", err)
 	}
 	return (*pricesBlock).Uint64(), nil
@@ -26,12 +30,15 @@ func GetPricesBlock(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error)
 
 // Get the current network GGP price in ETH
 func GetGGPPrice(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNetworkPrices, err := getGoGoNetworkPrices(ggp)
+	return GetGGPPriceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetGGPPriceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNetworkPrices, err := getGoGoNetworkPrices(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	ggpPrice := new(*big.Int)
-	if err := gogoNetworkPrices.Call(opts, ggpPrice, "getGGPPrice"); err != nil {
+	if err := gogoNetworkPrices.CallContext(ctx, opts, ggpPrice, "getGGPPrice"); err != nil {
 		return nil, fmt.Errorf("Could not get network GGP price: %w", err)
 	}
 	return *ggpPrice, nil
@@ -39,20 +46,26 @@ func GetGGPPrice(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error)
 
 // Estimate the gas of SubmitPrices
 func EstimateSubmitPricesGas(ggp *gogopool.GoGoPool, block uint64, ggpPrice *big.Int, effectiveGgpStake *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoNetworkPrices, err := getGoGoNetworkPrices(ggp)
+	return EstimateSubmitPricesGasContext(gogopool.TransactOptsContext(opts), ggp, block, ggpPrice, effectiveGgpStake, opts)
+}
+func EstimateSubmitPricesGasContext(ctx context.Context, ggp *gogopool.GoGoPool, block uint64, ggpPrice *big.Int, effectiveGgpStake *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoNetworkPrices, err := getGoGoNetworkPrices(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoNetworkPrices.GetTransactionGasInfo(opts, "submitPrices", big.NewInt(int64(block)), ggpPrice, effectiveGgpStake)
+	return gogoNetworkPrices.GetTransactionGasInfoContext(ctx, opts, "submitPrices", big.NewInt(int64(block)), ggpPrice, effectiveGgpStake)
 }
 
 // Submit network prices and total effective GGP stake for an epoch
 func SubmitPrices(ggp *gogopool.GoGoPool, block uint64, ggpPrice, effectiveGgpStake *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoNetworkPrices, err := getGoGoNetworkPrices(ggp)
+	return SubmitPricesContext(gogopool.TransactOptsContext(opts), ggp, block, ggpPrice, effectiveGgpStake, opts)
+}
+func SubmitPricesContext(ctx context.Context, ggp *gogopool.GoGoPool, block uint64, ggpPrice, effectiveGgpStake *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoNetworkPrices, err := getGoGoNetworkPrices(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoNetworkPrices.Transact(opts, "submitPrices", big.NewInt(int64(block)), ggpPrice, effectiveGgpStake)
+	hash, err := gogoNetworkPrices.TransactContext(ctx, opts, "submitPrices", big.NewInt(int64(block)), ggpPrice, effectiveGgpStake)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not submit network prices: %w", err)
 	}
@@ -61,12 +74,15 @@ func SubmitPrices(ggp *gogopool.GoGoPool, block uint64, ggpPrice, effectiveGgpSt
 
 // Check if the network is currently in consensus about the GGP price, or if it is still reaching consensus
 func InConsensus(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	gogoNetworkPrices, err := getGoGoNetworkPrices(ggp)
+	return InConsensusContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func InConsensusContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	gogoNetworkPrices, err := getGoGoNetworkPrices(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	isInConsensus := new(bool)
-	if err := gogoNetworkPrices.Call(opts, isInConsensus, "inConsensus"); err != nil {
+	if err := gogoNetworkPrices.CallContext(ctx, opts, isInConsensus, "inConsensus"); err != nil {
 		return false, fmt.Errorf("Could not get consensus status: %w", err)
 	}
 	return *isInConsensus, nil
@@ -74,12 +90,15 @@ func InConsensus(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
 
 // Returns the latest block number that oracles should be reporting prices for
 func GetLatestReportablePricesBlock(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNetworkPrices, err := getGoGoNetworkPrices(ggp)
+	return GetLatestReportablePricesBlockContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLatestReportablePricesBlockContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNetworkPrices, err := getGoGoNetworkPrices(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	latestReportableBlock := new(*big.Int)
-	if err := gogoNetworkPrices.Call(opts, latestReportableBlock, "getLatestReportableBlock"); err != nil {
+	if err := gogoNetworkPrices.CallContext(ctx, opts, latestReportableBlock, "getLatestReportableBlock"); err != nil {
 		return nil, fmt.Errorf("Could not get latest reportable block: %w", err)
 	}
 	return *latestReportableBlock, nil
@@ -88,8 +107,8 @@ func GetLatestReportablePricesBlock(ggp *gogopool.GoGoPool, opts *bind.CallOpts)
 // Get contracts
 var gogoNetworkPricesLock sync.Mutex
 
-func getGoGoNetworkPrices(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoNetworkPrices(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoNetworkPricesLock.Lock()
 	defer gogoNetworkPricesLock.Unlock()
-	return ggp.GetContract("rocketNetworkPrices")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNetworkPrices))
 }
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -13,37 +14,95 @@ import (
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 )
 
+// The result of a mined node deposit
+type DepositResult struct {
+	gogopool.TransactionResult
+	MinipoolAddress common.Address `json:"minipoolAddress"`
+}
+
 // Estimate the gas of Deposit
 func EstimateDepositGas(ggp *gogopool.GoGoPool, minimumNodeFee float64, validatorPubkey ggptypes.ValidatorPubkey, validatorSignature ggptypes.ValidatorSignature, depositDataRoot common.Hash, salt *big.Int, expectedMinipoolAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoNodeDeposit, err := getGoGoNodeDeposit(ggp)
+	return EstimateDepositGasContext(gogopool.TransactOptsContext(opts), ggp, minimumNodeFee, validatorPubkey, validatorSignature, depositDataRoot, salt, expectedMinipoolAddress, opts)
+}
+func EstimateDepositGasContext(ctx context.Context, ggp *gogopool.GoGoPool, minimumNodeFee float64, validatorPubkey ggptypes.ValidatorPubkey, validatorSignature ggptypes.ValidatorSignature, depositDataRoot common.Hash, salt *big.Int, expectedMinipoolAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoNodeDeposit, err := getGoGoNodeDeposit(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoNodeDeposit.GetTransactionGasInfo(opts, "deposit", avax.EthToWei(minimumNodeFee), validatorPubkey[:], validatorSignature[:], depositDataRoot, salt, expectedMinipoolAddress)
+	return gogoNodeDeposit.GetTransactionGasInfoContext(ctx, opts, "deposit", avax.EthToWei(minimumNodeFee), validatorPubkey[:], validatorSignature[:], depositDataRoot, salt, expectedMinipoolAddress)
+}
+
+// Build an unsigned Deposit transaction for external signing
+func BuildDepositTransaction(ggp *gogopool.GoGoPool, minimumNodeFee float64, validatorPubkey ggptypes.ValidatorPubkey, validatorSignature ggptypes.ValidatorSignature, depositDataRoot common.Hash, salt *big.Int, expectedMinipoolAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildDepositTransactionContext(gogopool.TransactOptsContext(opts), ggp, minimumNodeFee, validatorPubkey, validatorSignature, depositDataRoot, salt, expectedMinipoolAddress, opts)
+}
+func BuildDepositTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, minimumNodeFee float64, validatorPubkey ggptypes.ValidatorPubkey, validatorSignature ggptypes.ValidatorSignature, depositDataRoot common.Hash, salt *big.Int, expectedMinipoolAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoNodeDeposit, err := getGoGoNodeDeposit(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+	return gogoNodeDeposit.BuildTransactionContext(ctx, opts, "deposit", avax.EthToWei(minimumNodeFee), validatorPubkey[:], validatorSignature[:], depositDataRoot, salt, expectedMinipoolAddress)
 }
 
 // Make a node deposit
 func Deposit(ggp *gogopool.GoGoPool, minimumNodeFee float64, validatorPubkey ggptypes.ValidatorPubkey, validatorSignature ggptypes.ValidatorSignature, depositDataRoot common.Hash, salt *big.Int, expectedMinipoolAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoNodeDeposit, err := getGoGoNodeDeposit(ggp)
+	return DepositContext(gogopool.TransactOptsContext(opts), ggp, minimumNodeFee, validatorPubkey, validatorSignature, depositDataRoot, salt, expectedMinipoolAddress, opts)
+}
+func DepositContext(ctx context.Context, ggp *gogopool.GoGoPool, minimumNodeFee float64, validatorPubkey ggptypes.ValidatorPubkey, validatorSignature ggptypes.ValidatorSignature, depositDataRoot common.Hash, salt *big.Int, expectedMinipoolAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoNodeDeposit, err := getGoGoNodeDeposit(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoNodeDeposit.Transact(opts, "deposit", avax.EthToWei(minimumNodeFee), validatorPubkey[:], validatorSignature[:], depositDataRoot, salt, expectedMinipoolAddress)
+	hash, err := gogoNodeDeposit.TransactContext(ctx, opts, "deposit", avax.EthToWei(minimumNodeFee), validatorPubkey[:], validatorSignature[:], depositDataRoot, salt, expectedMinipoolAddress)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not make node deposit: %w", err)
 	}
 	return hash, nil
 }
 
+// Make a node deposit and wait for it to be mined
+// Returns the address of the new minipool
+func DepositAndWait(ggp *gogopool.GoGoPool, minimumNodeFee float64, validatorPubkey ggptypes.ValidatorPubkey, validatorSignature ggptypes.ValidatorSignature, depositDataRoot common.Hash, salt *big.Int, expectedMinipoolAddress common.Address, opts *bind.TransactOpts) (DepositResult, error) {
+	return DepositAndWaitContext(gogopool.TransactOptsContext(opts), ggp, minimumNodeFee, validatorPubkey, validatorSignature, depositDataRoot, salt, expectedMinipoolAddress, opts)
+}
+func DepositAndWaitContext(ctx context.Context, ggp *gogopool.GoGoPool, minimumNodeFee float64, validatorPubkey ggptypes.ValidatorPubkey, validatorSignature ggptypes.ValidatorSignature, depositDataRoot common.Hash, salt *big.Int, expectedMinipoolAddress common.Address, opts *bind.TransactOpts) (DepositResult, error) {
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
+	if err != nil {
+		return DepositResult{}, err
+	}
+	hash, err := DepositContext(ctx, ggp, minimumNodeFee, validatorPubkey, validatorSignature, depositDataRoot, salt, expectedMinipoolAddress, opts)
+	if err != nil {
+		return DepositResult{}, err
+	}
+	txResult, err := gogopool.WaitForResultContext(ctx, ggp.Client, hash)
+	result := DepositResult{TransactionResult: txResult}
+	if err != nil {
+		return result, err
+	}
+	events, err := gogoMinipoolManager.GetTransactionEventArgs(txResult.Receipt, "MinipoolCreated")
+	if err != nil {
+		return result, fmt.Errorf("Could not get new minipool address: %w", err)
+	}
+	minipoolAddress, ok := events[0]["minipool"].(common.Address)
+	if !ok {
+		return result, fmt.Errorf("Could not get new minipool address: invalid MinipoolCreated event")
+	}
+	result.MinipoolAddress = minipoolAddress
+	return result, nil
+}
+
 // Get the type of a deposit based on the amount
 func GetDepositType(ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.CallOpts) (ggptypes.MinipoolDeposit, error) {
-	gogoNodeDeposit, err := getGoGoNodeDeposit(ggp)
+	return GetDepositTypeContext(gogopool.CallOptsContext(opts), ggp, amount, opts)
+}
+func GetDepositTypeContext(ctx context.Context, ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.CallOpts) (ggptypes.MinipoolDeposit, error) {
+	gogoNodeDeposit, err := getGoGoNodeDeposit(ctx, ggp)
 	if err != nil {
 		return ggptypes.Empty, err
 	}
 
 	depositType := new(uint8)
-	if err := gogoNodeDeposit.Call(opts, depositType, "getDepositType", amount); err != nil {
+	if err := gogoNodeDeposit.CallContext(ctx, opts, depositType, "getDepositType", amount); err != nil {
 		return ggptypes.Empty, fmt.Errorf("Could not get deposit type: %w", err)
 	}
 	return ggptypes.MinipoolDeposit(*depositType), nil
@@ -52,8 +111,16 @@ func GetDepositType(ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.CallOpts
 // Get contracts
 var gogoNodeDepositLock sync.Mutex
 
-func getGoGoNodeDeposit(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoNodeDeposit(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoNodeDepositLock.Lock()
 	defer gogoNodeDepositLock.Unlock()
-	return ggp.GetContract("rocketNodeDeposit")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNodeDeposit))
+}
+
+var gogoMinipoolManagerLock sync.Mutex
+
+func getGoGoMinipoolManager(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+	gogoMinipoolManagerLock.Lock()
+	defer gogoMinipoolManagerLock.Unlock()
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractMinipoolManager))
 }
@@ -37,6 +37,12 @@ type NodeDetails struct {
 	TimezoneLocation         string         `json:"timezoneLocation"`
 }
 
+// The result of a mined node registration
+type RegisterNodeResult struct {
+	gogopool.TransactionResult
+	RegistrationTime time.Time `json:"registrationTime"`
+}
+
 // Count of nodes belonging to a timezone
 type TimezoneCount struct {
 	Timezone string   `abi:"timezone"`
@@ -56,41 +62,43 @@ type TrustedNodeParticipation struct {
 
 // Get all node details
 func GetNodes(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]NodeDetails, error) {
+	return GetNodesContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodesContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]NodeDetails, error) {
 
 	// Get node addresses
-	nodeAddresses, err := GetNodeAddresses(ggp, opts)
+	nodeAddresses, err := GetNodeAddressesContext(ctx, ggp, opts)
 	if err != nil {
 		return []NodeDetails{}, err
 	}
 
-	// Load node details in batches
+	// Load node details
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
+	if err != nil {
+		return []NodeDetails{}, err
+	}
 	details := make([]NodeDetails, len(nodeAddresses))
-	for bsi := 0; bsi < len(nodeAddresses); bsi += NodeDetailsBatchSize {
-
-		// Get batch start & end index
-		nsi := bsi
-		nei := bsi + NodeDetailsBatchSize
-		if nei > len(nodeAddresses) {
-			nei = len(nodeAddresses)
+	mc := ggp.NewMultiCaller()
+	for ni, nodeAddress := range nodeAddresses {
+		details[ni].Address = nodeAddress
+		if err := mc.AddCall(gogoNodeManager, &details[ni].Exists, "getNodeExists", nodeAddress); err != nil {
+			return []NodeDetails{}, err
 		}
-
-		// Load details
-		var wg errgroup.Group
-		for ni := nsi; ni < nei; ni++ {
-			ni := ni
-			wg.Go(func() error {
-				nodeAddress := nodeAddresses[ni]
-				nodeDetails, err := GetNodeDetails(ggp, nodeAddress, opts)
-				if err == nil {
-					details[ni] = nodeDetails
-				}
-				return err
-			})
+		if err := mc.AddCall(ggp.GoGoStorageContract, &details[ni].WithdrawalAddress, "getNodeWithdrawalAddress", nodeAddress); err != nil {
+			return []NodeDetails{}, err
 		}
-		if err := wg.Wait(); err != nil {
+		if err := mc.AddCall(ggp.GoGoStorageContract, &details[ni].PendingWithdrawalAddress, "getNodePendingWithdrawalAddress", nodeAddress); err != nil {
 			return []NodeDetails{}, err
 		}
-
+		if err := mc.AddCall(gogoNodeManager, &details[ni].TimezoneLocation, "getNodeTimezoneLocation", nodeAddress); err != nil {
+			return []NodeDetails{}, err
+		}
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []NodeDetails{}, fmt.Errorf("Could not get node details: %w", err)
+	}
+	for ni := range details {
+		details[ni].TimezoneLocation = strings.Sanitize(details[ni].TimezoneLocation)
 	}
 
 	// Return
@@ -100,40 +108,30 @@ func GetNodes(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]NodeDetails, error
 
 // Get all node addresses
 func GetNodeAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Address, error) {
+	return GetNodeAddressesContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeAddressesContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Address, error) {
 
 	// Get node count
-	nodeCount, err := GetNodeCount(ggp, opts)
+	nodeCount, err := GetNodeCountContext(ctx, ggp, opts)
 	if err != nil {
 		return []common.Address{}, err
 	}
 
-	// Load node addresses in batches
+	// Load node addresses
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
+	if err != nil {
+		return []common.Address{}, err
+	}
 	addresses := make([]common.Address, nodeCount)
-	for bsi := uint64(0); bsi < nodeCount; bsi += NodeAddressBatchSize {
-
-		// Get batch start & end index
-		nsi := bsi
-		nei := bsi + NodeAddressBatchSize
-		if nei > nodeCount {
-			nei = nodeCount
-		}
-
-		// Load addresses
-		var wg errgroup.Group
-		for ni := nsi; ni < nei; ni++ {
-			ni := ni
-			wg.Go(func() error {
-				address, err := GetNodeAt(ggp, ni, opts)
-				if err == nil {
-					addresses[ni] = address
-				}
-				return err
-			})
-		}
-		if err := wg.Wait(); err != nil {
+	mc := ggp.NewMultiCaller()
+	for ni := uint64(0); ni < nodeCount; ni++ {
+		if err := mc.AddCall(gogoNodeManager, &addresses[ni], "getNodeAt", big.NewInt(int64(ni))); err != nil {
 			return []common.Address{}, err
 		}
-
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return []common.Address{}, fmt.Errorf("Could not get node addresses: %w", err)
 	}
 
 	// Return
@@ -143,6 +141,9 @@ func GetNodeAddresses(ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]common.Add
 
 // Get a node's details
 func GetNodeDetails(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (NodeDetails, error) {
+	return GetNodeDetailsContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeDetailsContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (NodeDetails, error) {
 
 	// Data
 	var wg errgroup.Group
@@ -154,22 +155,22 @@ func GetNodeDetails(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bi
 	// Load data
 	wg.Go(func() error {
 		var err error
-		exists, err = GetNodeExists(ggp, nodeAddress, opts)
+		exists, err = GetNodeExistsContext(ctx, ggp, nodeAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		withdrawalAddress, err = storage.GetNodeWithdrawalAddress(ggp, nodeAddress, opts)
+		withdrawalAddress, err = storage.GetNodeWithdrawalAddressContext(ctx, ggp, nodeAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		pendingWithdrawalAddress, err = storage.GetNodePendingWithdrawalAddress(ggp, nodeAddress, opts)
+		pendingWithdrawalAddress, err = storage.GetNodePendingWithdrawalAddressContext(ctx, ggp, nodeAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		timezoneLocation, err = GetNodeTimezoneLocation(ggp, nodeAddress, opts)
+		timezoneLocation, err = GetNodeTimezoneLocationContext(ctx, ggp, nodeAddress, opts)
 		return err
 	})
 
@@ -191,12 +192,15 @@ func GetNodeDetails(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bi
 
 // Get the number of nodes in the network
 func GetNodeCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return GetNodeCountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeCountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	nodeCount := new(*big.Int)
-	if err := gogoNodeManager.Call(opts, nodeCount, "getNodeCount"); err != nil {
+	if err := gogoNodeManager.CallContext(ctx, opts, nodeCount, "getNodeCount"); err != nil {
 		return 0, fmt.Errorf("Could not get node count: %w", err)
 	}
 	return (*nodeCount).Uint64(), nil
@@ -204,12 +208,15 @@ func GetNodeCount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
 
 // Get a breakdown of the number of nodes per timezone
 func GetNodeCountPerTimezone(ggp *gogopool.GoGoPool, offset, limit *big.Int, opts *bind.CallOpts) ([]TimezoneCount, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return GetNodeCountPerTimezoneContext(gogopool.CallOptsContext(opts), ggp, offset, limit, opts)
+}
+func GetNodeCountPerTimezoneContext(ctx context.Context, ggp *gogopool.GoGoPool, offset, limit *big.Int, opts *bind.CallOpts) ([]TimezoneCount, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return []TimezoneCount{}, err
 	}
 	timezoneCounts := new([]TimezoneCount)
-	if err := gogoNodeManager.Call(opts, timezoneCounts, "getNodeCountPerTimezone", offset, limit); err != nil {
+	if err := gogoNodeManager.CallContext(ctx, opts, timezoneCounts, "getNodeCountPerTimezone", offset, limit); err != nil {
 		return []TimezoneCount{}, fmt.Errorf("Could not get node count: %w", err)
 	}
 	return *timezoneCounts, nil
@@ -217,12 +224,15 @@ func GetNodeCountPerTimezone(ggp *gogopool.GoGoPool, offset, limit *big.Int, opt
 
 // Get a node address by index
 func GetNodeAt(ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (common.Address, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return GetNodeAtContext(gogopool.CallOptsContext(opts), ggp, index, opts)
+}
+func GetNodeAtContext(ctx context.Context, ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (common.Address, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
 	nodeAddress := new(common.Address)
-	if err := gogoNodeManager.Call(opts, nodeAddress, "getNodeAt", big.NewInt(int64(index))); err != nil {
+	if err := gogoNodeManager.CallContext(ctx, opts, nodeAddress, "getNodeAt", big.NewInt(int64(index))); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get node %d address: %w", index, err)
 	}
 	return *nodeAddress, nil
@@ -230,12 +240,15 @@ func GetNodeAt(ggp *gogopool.GoGoPool, index uint64, opts *bind.CallOpts) (commo
 
 // Check whether a node exists
 func GetNodeExists(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (bool, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return GetNodeExistsContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeExistsContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (bool, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	exists := new(bool)
-	if err := gogoNodeManager.Call(opts, exists, "getNodeExists", nodeAddress); err != nil {
+	if err := gogoNodeManager.CallContext(ctx, opts, exists, "getNodeExists", nodeAddress); err != nil {
 		return false, fmt.Errorf("Could not get node %s exists status: %w", nodeAddress.Hex(), err)
 	}
 	return *exists, nil
@@ -243,12 +256,15 @@ func GetNodeExists(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bin
 
 // Get a node's timezone location
 func GetNodeTimezoneLocation(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (string, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return GetNodeTimezoneLocationContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeTimezoneLocationContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (string, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return "", err
 	}
 	timezoneLocation := new(string)
-	if err := gogoNodeManager.Call(opts, timezoneLocation, "getNodeTimezoneLocation", nodeAddress); err != nil {
+	if err := gogoNodeManager.CallContext(ctx, opts, timezoneLocation, "getNodeTimezoneLocation", nodeAddress); err != nil {
 		return "", fmt.Errorf("Could not get node %s timezone location: %w", nodeAddress.Hex(), err)
 	}
 	return strings.Sanitize(*timezoneLocation), nil
@@ -256,7 +272,10 @@ func GetNodeTimezoneLocation(ggp *gogopool.GoGoPool, nodeAddress common.Address,
 
 // Estimate the gas of RegisterNode
 func EstimateRegisterNodeGas(ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return EstimateRegisterNodeGasContext(gogopool.TransactOptsContext(opts), ggp, timezoneLocation, opts)
+}
+func EstimateRegisterNodeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -264,12 +283,15 @@ func EstimateRegisterNodeGas(ggp *gogopool.GoGoPool, timezoneLocation string, op
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not verify timezone [%s]: %w", timezoneLocation, err)
 	}
-	return gogoNodeManager.GetTransactionGasInfo(opts, "registerNode", timezoneLocation)
+	return gogoNodeManager.GetTransactionGasInfoContext(ctx, opts, "registerNode", timezoneLocation)
 }
 
 // Register a node
 func RegisterNode(ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return RegisterNodeContext(gogopool.TransactOptsContext(opts), ggp, timezoneLocation, opts)
+}
+func RegisterNodeContext(ctx context.Context, ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -277,16 +299,49 @@ func RegisterNode(ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.Tr
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not verify timezone [%s]: %w", timezoneLocation, err)
 	}
-	hash, err := gogoNodeManager.Transact(opts, "registerNode", timezoneLocation)
+	hash, err := gogoNodeManager.TransactContext(ctx, opts, "registerNode", timezoneLocation)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not register node: %w", err)
 	}
 	return hash, nil
 }
 
+// Register a node and wait for it to be mined
+func RegisterNodeAndWait(ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (RegisterNodeResult, error) {
+	return RegisterNodeAndWaitContext(gogopool.TransactOptsContext(opts), ggp, timezoneLocation, opts)
+}
+func RegisterNodeAndWaitContext(ctx context.Context, ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (RegisterNodeResult, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
+	if err != nil {
+		return RegisterNodeResult{}, err
+	}
+	hash, err := RegisterNodeContext(ctx, ggp, timezoneLocation, opts)
+	if err != nil {
+		return RegisterNodeResult{}, err
+	}
+	txResult, err := gogopool.WaitForResultContext(ctx, ggp.Client, hash)
+	result := RegisterNodeResult{TransactionResult: txResult}
+	if err != nil {
+		return result, err
+	}
+	events, err := gogoNodeManager.GetTransactionEventArgs(txResult.Receipt, "NodeRegistered")
+	if err != nil {
+		return result, fmt.Errorf("Could not get node registration time: %w", err)
+	}
+	registrationTime, ok := events[0]["time"].(*big.Int)
+	if !ok {
+		return result, fmt.Errorf("Could not get node registration time: invalid NodeRegistered event")
+	}
+	result.RegistrationTime = time.Unix(registrationTime.Int64(), 0)
+	return result, nil
+}
+
 // Estimate the gas of SetTimezoneLocation
 func EstimateSetTimezoneLocationGas(ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return EstimateSetTimezoneLocationGasContext(gogopool.TransactOptsContext(opts), ggp, timezoneLocation, opts)
+}
+func EstimateSetTimezoneLocationGasContext(ctx context.Context, ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
@@ -294,12 +349,15 @@ func EstimateSetTimezoneLocationGas(ggp *gogopool.GoGoPool, timezoneLocation str
 	if err != nil {
 		return gogopool.GasInfo{}, fmt.Errorf("Could not verify timezone [%s]: %w", timezoneLocation, err)
 	}
-	return gogoNodeManager.GetTransactionGasInfo(opts, "setTimezoneLocation", timezoneLocation)
+	return gogoNodeManager.GetTransactionGasInfoContext(ctx, opts, "setTimezoneLocation", timezoneLocation)
 }
 
 // Set a node's timezone location
 func SetTimezoneLocation(ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoNodeManager, err := getGoGoNodeManager(ggp)
+	return SetTimezoneLocationContext(gogopool.TransactOptsContext(opts), ggp, timezoneLocation, opts)
+}
+func SetTimezoneLocationContext(ctx context.Context, ggp *gogopool.GoGoPool, timezoneLocation string, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoNodeManager, err := getGoGoNodeManager(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -307,7 +365,7 @@ func SetTimezoneLocation(ggp *gogopool.GoGoPool, timezoneLocation string, opts *
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not verify timezone [%s]: %w", timezoneLocation, err)
 	}
-	hash, err := gogoNodeManager.Transact(opts, "setTimezoneLocation", timezoneLocation)
+	hash, err := gogoNodeManager.TransactContext(ctx, opts, "setTimezoneLocation", timezoneLocation)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not set node timezone location: %w", err)
 	}
@@ -316,8 +374,11 @@ func SetTimezoneLocation(ggp *gogopool.GoGoPool, timezoneLocation string, opts *
 
 // Returns an array of block numbers for prices submissions the given trusted node has submitted since fromBlock
 func GetPricesSubmissions(ggp *gogopool.GoGoPool, nodeAddress common.Address, fromBlock uint64, intervalSize *big.Int) (*[]uint64, error) {
+	return GetPricesSubmissionsContext(context.Background(), ggp, nodeAddress, fromBlock, intervalSize)
+}
+func GetPricesSubmissionsContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, fromBlock uint64, intervalSize *big.Int) (*[]uint64, error) {
 	// Get contracts
-	gogoNetworkPrices, err := getGoGoNetworkPrices(ggp)
+	gogoNetworkPrices, err := getGoGoNetworkPrices(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +387,7 @@ func GetPricesSubmissions(ggp *gogopool.GoGoPool, nodeAddress common.Address, fr
 	topicFilter := [][]common.Hash{{gogoNetworkPrices.ABI.Events["PricesSubmitted"].ID}, {nodeAddress.Hash()}}
 
 	// Get the event logs
-	logs, err := avax.GetLogs(ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
+	logs, err := avax.GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -344,8 +405,11 @@ func GetPricesSubmissions(ggp *gogopool.GoGoPool, nodeAddress common.Address, fr
 
 // Returns an array of block numbers for balances submissions the given trusted node has submitted since fromBlock
 func GetBalancesSubmissions(ggp *gogopool.GoGoPool, nodeAddress common.Address, fromBlock uint64, intervalSize *big.Int) (*[]uint64, error) {
+	return GetBalancesSubmissionsContext(context.Background(), ggp, nodeAddress, fromBlock, intervalSize)
+}
+func GetBalancesSubmissionsContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, fromBlock uint64, intervalSize *big.Int) (*[]uint64, error) {
 	// Get contracts
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
@@ -354,7 +418,7 @@ func GetBalancesSubmissions(ggp *gogopool.GoGoPool, nodeAddress common.Address,
 	topicFilter := [][]common.Hash{{gogoNetworkBalances.ABI.Events["BalancesSubmitted"].ID}, {nodeAddress.Hash()}}
 
 	// Get the event logs
-	logs, err := avax.GetLogs(ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
+	logs, err := avax.GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -372,9 +436,9 @@ func GetBalancesSubmissions(ggp *gogopool.GoGoPool, nodeAddress common.Address,
 }
 
 // Returns the most recent block number that the number of trusted nodes changed since fromBlock
-func getLatestMemberCountChangedBlock(ggp *gogopool.GoGoPool, fromBlock uint64, intervalSize *big.Int) (uint64, error) {
+func getLatestMemberCountChangedBlock(ctx context.Context, ggp *gogopool.GoGoPool, fromBlock uint64, intervalSize *big.Int) (uint64, error) {
 	// Get contracts
-	gogoDaoNodeTrustedActions, err := getGoGoDAONodeTrustedActions(ggp)
+	gogoDaoNodeTrustedActions, err := getGoGoDAONodeTrustedActions(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
@@ -383,7 +447,7 @@ func getLatestMemberCountChangedBlock(ggp *gogopool.GoGoPool, fromBlock uint64,
 	topicFilter := [][]common.Hash{{gogoDaoNodeTrustedActions.ABI.Events["ActionJoined"].ID, gogoDaoNodeTrustedActions.ABI.Events["ActionLeave"].ID, gogoDaoNodeTrustedActions.ABI.Events["ActionKick"].ID, gogoDaoNodeTrustedActions.ABI.Events["ActionChallengeDecided"].ID}}
 
 	// Get the event logs
-	logs, err := avax.GetLogs(ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
+	logs, err := avax.GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
 	if err != nil {
 		return 0, err
 	}
@@ -408,25 +472,28 @@ func getLatestMemberCountChangedBlock(ggp *gogopool.GoGoPool, fromBlock uint64,
 
 // Calculates the participation rate of every trusted node on price submission since the last block that member count changed
 func CalculateTrustedNodePricesParticipation(ggp *gogopool.GoGoPool, intervalSize *big.Int, opts *bind.CallOpts) (*TrustedNodeParticipation, error) {
+	return CalculateTrustedNodePricesParticipationContext(gogopool.CallOptsContext(opts), ggp, intervalSize, opts)
+}
+func CalculateTrustedNodePricesParticipationContext(ctx context.Context, ggp *gogopool.GoGoPool, intervalSize *big.Int, opts *bind.CallOpts) (*TrustedNodeParticipation, error) {
 	// Get the update frequency
-	updatePricesFrequency, err := protocol.GetSubmitPricesFrequency(ggp, opts)
+	updatePricesFrequency, err := protocol.GetSubmitPricesFrequencyContext(ctx, ggp, opts)
 	if err != nil {
 		return nil, err
 	}
 	// Get the current block
-	currentBlock, err := ggp.Client.HeaderByNumber(context.Background(), nil)
+	currentBlock, err := ggp.Client.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 	currentBlockNumber := currentBlock.Number.Uint64()
 	// Get the block of the most recent member join (limiting to 50 intervals)
 	minBlock := (currentBlockNumber/updatePricesFrequency - 50) * updatePricesFrequency
-	latestMemberCountChangedBlock, err := getLatestMemberCountChangedBlock(ggp, minBlock, intervalSize)
+	latestMemberCountChangedBlock, err := getLatestMemberCountChangedBlock(ctx, ggp, minBlock, intervalSize)
 	if err != nil {
 		return nil, err
 	}
 	// Get the number of current members
-	memberCount, err := trustednode.GetMemberCount(ggp, nil)
+	memberCount, err := trustednode.GetMemberCountContext(ctx, ggp, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -443,7 +510,7 @@ func CalculateTrustedNodePricesParticipation(ggp *gogopool.GoGoPool, intervalSiz
 	// How many submissions would we expect per member given a random submission
 	expected := float64(intervalsPassed) * consensus / float64(memberCount)
 	// Get trusted members
-	members, err := trustednode.GetMembers(ggp, nil)
+	members, err := trustednode.GetMembersContext(ctx, ggp, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -456,7 +523,7 @@ func CalculateTrustedNodePricesParticipation(ggp *gogopool.GoGoPool, intervalSiz
 		participationTable[member.Address] = make([]bool, intervalsPassed)
 		actual := 0
 		if intervalsPassed > 0 {
-			blocks, err := GetPricesSubmissions(ggp, member.Address, startBlock, intervalSize)
+			blocks, err := GetPricesSubmissionsContext(ctx, ggp, member.Address, startBlock, intervalSize)
 			if err != nil {
 				return nil, err
 			}
@@ -495,25 +562,28 @@ func CalculateTrustedNodePricesParticipation(ggp *gogopool.GoGoPool, intervalSiz
 
 // Calculates the participation rate of every trusted node on balance submission since the last block that member count changed
 func CalculateTrustedNodeBalancesParticipation(ggp *gogopool.GoGoPool, intervalSize *big.Int, opts *bind.CallOpts) (*TrustedNodeParticipation, error) {
+	return CalculateTrustedNodeBalancesParticipationContext(gogopool.CallOptsContext(opts), ggp, intervalSize, opts)
+}
+func CalculateTrustedNodeBalancesParticipationContext(ctx context.Context, ggp *gogopool.GoGoPool, intervalSize *big.Int, opts *bind.CallOpts) (*TrustedNodeParticipation, error) {
 	// Get the update frequency
-	updateBalancesFrequency, err := protocol.GetSubmitBalancesFrequency(ggp, opts)
+	updateBalancesFrequency, err := protocol.GetSubmitBalancesFrequencyContext(ctx, ggp, opts)
 	if err != nil {
 		return nil, err
 	}
 	// Get the current block
-	currentBlock, err := ggp.Client.HeaderByNumber(context.Background(), nil)
+	currentBlock, err := ggp.Client.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 	currentBlockNumber := currentBlock.Number.Uint64()
 	// Get the block of the most recent member join (limiting to 50 intervals)
 	minBlock := (currentBlockNumber/updateBalancesFrequency - 50) * updateBalancesFrequency
-	latestMemberCountChangedBlock, err := getLatestMemberCountChangedBlock(ggp, minBlock, intervalSize)
+	latestMemberCountChangedBlock, err := getLatestMemberCountChangedBlock(ctx, ggp, minBlock, intervalSize)
 	if err != nil {
 		return nil, err
 	}
 	// Get the number of current members
-	memberCount, err := trustednode.GetMemberCount(ggp, nil)
+	memberCount, err := trustednode.GetMemberCountContext(ctx, ggp, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -530,7 +600,7 @@ func CalculateTrustedNodeBalancesParticipation(ggp *gogopool.GoGoPool, intervalS
 	// How many submissions would we expect per member given a random submission
 	expected := float64(intervalsPassed) * consensus / float64(memberCount)
 	// Get trusted members
-	members, err := trustednode.GetMembers(ggp, nil)
+	members, err := trustednode.GetMembersContext(ctx, ggp, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -543,7 +613,7 @@ func CalculateTrustedNodeBalancesParticipation(ggp *gogopool.GoGoPool, intervalS
 		participationTable[member.Address] = make([]bool, intervalsPassed)
 		actual := 0
 		if intervalsPassed > 0 {
-			blocks, err := GetBalancesSubmissions(ggp, member.Address, startBlock, intervalSize)
+			blocks, err := GetBalancesSubmissionsContext(ctx, ggp, member.Address, startBlock, intervalSize)
 			if err != nil {
 				return nil, err
 			}
@@ -582,8 +652,11 @@ func CalculateTrustedNodeBalancesParticipation(ggp *gogopool.GoGoPool, intervalS
 
 // Returns an array of members who submitted a balance since fromBlock
 func GetLatestBalancesSubmissions(ggp *gogopool.GoGoPool, fromBlock uint64, intervalSize *big.Int) ([]common.Address, error) {
+	return GetLatestBalancesSubmissionsContext(context.Background(), ggp, fromBlock, intervalSize)
+}
+func GetLatestBalancesSubmissionsContext(ctx context.Context, ggp *gogopool.GoGoPool, fromBlock uint64, intervalSize *big.Int) ([]common.Address, error) {
 	// Get contracts
-	gogoNetworkBalances, err := getGoGoNetworkBalances(ggp)
+	gogoNetworkBalances, err := getGoGoNetworkBalances(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
@@ -592,7 +665,7 @@ func GetLatestBalancesSubmissions(ggp *gogopool.GoGoPool, fromBlock uint64, inte
 	topicFilter := [][]common.Hash{{gogoNetworkBalances.ABI.Events["BalancesSubmitted"].ID}}
 
 	// Get the event logs
-	logs, err := avax.GetLogs(ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
+	logs, err := avax.GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -608,25 +681,28 @@ func GetLatestBalancesSubmissions(ggp *gogopool.GoGoPool, fromBlock uint64, inte
 
 // Returns a mapping of members and whether they have submitted balances this interval or not
 func GetTrustedNodeLatestBalancesParticipation(ggp *gogopool.GoGoPool, intervalSize *big.Int, opts *bind.CallOpts) (map[common.Address]bool, error) {
+	return GetTrustedNodeLatestBalancesParticipationContext(gogopool.CallOptsContext(opts), ggp, intervalSize, opts)
+}
+func GetTrustedNodeLatestBalancesParticipationContext(ctx context.Context, ggp *gogopool.GoGoPool, intervalSize *big.Int, opts *bind.CallOpts) (map[common.Address]bool, error) {
 	// Get the update frequency
-	updateBalancesFrequency, err := protocol.GetSubmitBalancesFrequency(ggp, opts)
+	updateBalancesFrequency, err := protocol.GetSubmitBalancesFrequencyContext(ctx, ggp, opts)
 	if err != nil {
 		return nil, err
 	}
 	// Get the current block
-	currentBlock, err := ggp.Client.HeaderByNumber(context.Background(), nil)
+	currentBlock, err := ggp.Client.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 	currentBlockNumber := currentBlock.Number.Uint64()
 	// Get trusted members
-	members, err := trustednode.GetMembers(ggp, nil)
+	members, err := trustednode.GetMembersContext(ctx, ggp, nil)
 	if err != nil {
 		return nil, err
 	}
 	// Get submission within the current interval
 	fromBlock := currentBlockNumber / updateBalancesFrequency * updateBalancesFrequency
-	submissions, err := GetLatestBalancesSubmissions(ggp, fromBlock, intervalSize)
+	submissions, err := GetLatestBalancesSubmissionsContext(ctx, ggp, fromBlock, intervalSize)
 	if err != nil {
 		return nil, err
 	}
@@ -643,8 +719,11 @@ func GetTrustedNodeLatestBalancesParticipation(ggp *gogopool.GoGoPool, intervalS
 
 // Returns an array of members who submitted prices since fromBlock
 func GetLatestPricesSubmissions(ggp *gogopool.GoGoPool, fromBlock uint64, intervalSize *big.Int) ([]common.Address, error) {
+	return GetLatestPricesSubmissionsContext(context.Background(), ggp, fromBlock, intervalSize)
+}
+func GetLatestPricesSubmissionsContext(ctx context.Context, ggp *gogopool.GoGoPool, fromBlock uint64, intervalSize *big.Int) ([]common.Address, error) {
 	// Get contracts
-	gogoNetworkPrices, err := getGoGoNetworkPrices(ggp)
+	gogoNetworkPrices, err := getGoGoNetworkPrices(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
@@ -653,7 +732,7 @@ func GetLatestPricesSubmissions(ggp *gogopool.GoGoPool, fromBlock uint64, interv
 	topicFilter := [][]common.Hash{{gogoNetworkPrices.ABI.Events["PricesSubmitted"].ID}}
 
 	// Get the event logs
-	logs, err := avax.GetLogs(ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
+	logs, err := avax.GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, big.NewInt(int64(fromBlock)), nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -669,25 +748,28 @@ func GetLatestPricesSubmissions(ggp *gogopool.GoGoPool, fromBlock uint64, interv
 
 // Returns a mapping of members and whether they have submitted prices this interval or not
 func GetTrustedNodeLatestPricesParticipation(ggp *gogopool.GoGoPool, intervalSize *big.Int, opts *bind.CallOpts) (map[common.Address]bool, error) {
+	return GetTrustedNodeLatestPricesParticipationContext(gogopool.CallOptsContext(opts), ggp, intervalSize, opts)
+}
+func GetTrustedNodeLatestPricesParticipationContext(ctx context.Context, ggp *gogopool.GoGoPool, intervalSize *big.Int, opts *bind.CallOpts) (map[common.Address]bool, error) {
 	// Get the update frequency
-	updatePricesFrequency, err := protocol.GetSubmitPricesFrequency(ggp, opts)
+	updatePricesFrequency, err := protocol.GetSubmitPricesFrequencyContext(ctx, ggp, opts)
 	if err != nil {
 		return nil, err
 	}
 	// Get the current block
-	currentBlock, err := ggp.Client.HeaderByNumber(context.Background(), nil)
+	currentBlock, err := ggp.Client.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 	currentBlockNumber := currentBlock.Number.Uint64()
 	// Get trusted members
-	members, err := trustednode.GetMembers(ggp, nil)
+	members, err := trustednode.GetMembersContext(ctx, ggp, nil)
 	if err != nil {
 		return nil, err
 	}
 	// Get submission within the current interval
 	fromBlock := currentBlockNumber / updatePricesFrequency * updatePricesFrequency
-	submissions, err := GetLatestPricesSubmissions(ggp, fromBlock, intervalSize)
+	submissions, err := GetLatestPricesSubmissionsContext(ctx, ggp, fromBlock, intervalSize)
 	if err != nil {
 		return nil, err
 	}
@@ -705,32 +787,32 @@ func GetTrustedNodeLatestPricesParticipation(ggp *gogopool.GoGoPool, intervalSiz
 // Get contracts
 var gogoNodeManagerLock sync.Mutex
 
-func getGoGoNodeManager(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoNodeManager(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoNodeManagerLock.Lock()
 	defer gogoNodeManagerLock.Unlock()
-	return ggp.GetContract("rocketNodeManager")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNodeManager))
 }
 
 var gogoNetworkPricesLock sync.Mutex
 
-func getGoGoNetworkPrices(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoNetworkPrices(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoNetworkPricesLock.Lock()
 	defer gogoNetworkPricesLock.Unlock()
-	return ggp.GetContract("rocketNetworkPrices")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNetworkPrices))
 }
 
 var gogoNetworkBalancesLock sync.Mutex
 
-func getGoGoNetworkBalances(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoNetworkBalances(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoNetworkBalancesLock.Lock()
 	defer gogoNetworkBalancesLock.Unlock()
-	return ggp.GetContract("rocketNetworkBalances")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNetworkBalances))
 }
 
 var gogoDAONodeTrustedActionsLock sync.Mutex
 
-func getGoGoDAONodeTrustedActions(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoDAONodeTrustedActions(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoDAONodeTrustedActionsLock.Lock()
 	defer gogoDAONodeTrustedActionsLock.Unlock()
-	return ggp.GetContract("rocketDAONodeTrustedActions")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAONodeTrustedActions))
 }
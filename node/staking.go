@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -13,12 +14,15 @@ import (
 
 // Get the total GGP staked in the network
 func GetTotalGGPStake(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return GetTotalGGPStakeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTotalGGPStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	totalGgpStake := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, totalGgpStake, "getTotalGGPStake"); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, totalGgpStake, "getTotalGGPStake"); err != nil {
 		return nil, fmt.Errorf("Could not get total network GGP stake: %w", err)
 	}
 	return *totalGgpStake, nil
@@ -26,12 +30,15 @@ func GetTotalGGPStake(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, er
 
 // Get the effective GGP staked in the network
 func GetTotalEffectiveGGPStake(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return GetTotalEffectiveGGPStakeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTotalEffectiveGGPStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	totalEffectiveGgpStake := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, totalEffectiveGgpStake, "getTotalEffectiveGGPStake"); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, totalEffectiveGgpStake, "getTotalEffectiveGGPStake"); err != nil {
 		return nil, fmt.Errorf("Could not get effective network GGP stake: %w", err)
 	}
 	return *totalEffectiveGgpStake, nil
@@ -39,12 +46,15 @@ func GetTotalEffectiveGGPStake(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*bi
 
 // Get a node's GGP stake
 func GetNodeGGPStake(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return GetNodeGGPStakeContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeGGPStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	nodeGgpStake := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, nodeGgpStake, "getNodeGGPStake", nodeAddress); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, nodeGgpStake, "getNodeGGPStake", nodeAddress); err != nil {
 		return nil, fmt.Errorf("Could not get total node GGP stake: %w", err)
 	}
 	return *nodeGgpStake, nil
@@ -52,12 +62,15 @@ func GetNodeGGPStake(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *b
 
 // Get a node's effective GGP stake
 func GetNodeEffectiveGGPStake(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return GetNodeEffectiveGGPStakeContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeEffectiveGGPStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	nodeEffectiveGgpStake := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, nodeEffectiveGgpStake, "getNodeEffectiveGGPStake", nodeAddress); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, nodeEffectiveGgpStake, "getNodeEffectiveGGPStake", nodeAddress); err != nil {
 		return nil, fmt.Errorf("Could not get effective node GGP stake: %w", err)
 	}
 	return *nodeEffectiveGgpStake, nil
@@ -65,12 +78,15 @@ func GetNodeEffectiveGGPStake(ggp *gogopool.GoGoPool, nodeAddress common.Address
 
 // Get a node's minimum GGP stake to collateralize their minipools
 func GetNodeMinimumGGPStake(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return GetNodeMinimumGGPStakeContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeMinimumGGPStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	nodeMinimumGgpStake := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, nodeMinimumGgpStake, "getNodeMinimumGGPStake", nodeAddress); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, nodeMinimumGgpStake, "getNodeMinimumGGPStake", nodeAddress); err != nil {
 		return nil, fmt.Errorf("Could not get minimum node GGP stake: %w", err)
 	}
 	return *nodeMinimumGgpStake, nil
@@ -78,12 +94,15 @@ func GetNodeMinimumGGPStake(ggp *gogopool.GoGoPool, nodeAddress common.Address,
 
 // Get a node's maximum GGP stake to collateralize their minipools
 func GetNodeMaximumGGPStake(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return GetNodeMaximumGGPStakeContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeMaximumGGPStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	nodeMaximumGgpStake := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, nodeMaximumGgpStake, "getNodeMaximumGGPStake", nodeAddress); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, nodeMaximumGgpStake, "getNodeMaximumGGPStake", nodeAddress); err != nil {
 		return nil, fmt.Errorf("Could not get maximum node GGP stake: %w", err)
 	}
 	return *nodeMaximumGgpStake, nil
@@ -91,12 +110,15 @@ func GetNodeMaximumGGPStake(ggp *gogopool.GoGoPool, nodeAddress common.Address,
 
 // Get the time a node last staked GGP
 func GetNodeGGPStakedTime(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return GetNodeGGPStakedTimeContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeGGPStakedTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	nodeGgpStakedTime := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, nodeGgpStakedTime, "getNodeGGPStakedTime", nodeAddress); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, nodeGgpStakedTime, "getNodeGGPStakedTime", nodeAddress); err != nil {
 		return 0, fmt.Errorf("Could not get node GGP staked time: %w", err)
 	}
 	return (*nodeGgpStakedTime).Uint64(), nil
@@ -104,12 +126,15 @@ func GetNodeGGPStakedTime(ggp *gogopool.GoGoPool, nodeAddress common.Address, op
 
 // Get a node's minipool limit based on GGP stake
 func GetNodeMinipoolLimit(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return GetNodeMinipoolLimitContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeMinipoolLimitContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (uint64, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	minipoolLimit := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, minipoolLimit, "getNodeMinipoolLimit", nodeAddress); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, minipoolLimit, "getNodeMinipoolLimit", nodeAddress); err != nil {
 		return 0, fmt.Errorf("Could not get node minipool limit: %w", err)
 	}
 	return (*minipoolLimit).Uint64(), nil
@@ -117,21 +142,40 @@ func GetNodeMinipoolLimit(ggp *gogopool.GoGoPool, nodeAddress common.Address, op
 
 // Estimate the gas of Stake
 func EstimateStakeGas(ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return EstimateStakeGasContext(gogopool.TransactOptsContext(opts), ggp, ggpAmount, opts)
+}
+func EstimateStakeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
 
-	return gogoNodeStaking.GetTransactionGasInfo(opts, "stakeRPL", ggpAmount)
+	return gogoNodeStaking.GetTransactionGasInfoContext(ctx, opts, "stakeRPL", ggpAmount)
+}
+
+// Build an unsigned StakeGGP transaction for external signing
+func BuildStakeGGPTransaction(ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildStakeGGPTransactionContext(gogopool.TransactOptsContext(opts), ggp, ggpAmount, opts)
+}
+func BuildStakeGGPTransactionContext(ctx context.Context, ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
+	if err != nil {
+		return nil, err
+	}
+
+	return gogoNodeStaking.BuildTransactionContext(ctx, opts, "stakeRPL", ggpAmount)
 }
 
 // Stake GGP
 func StakeGGP(ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return StakeGGPContext(gogopool.TransactOptsContext(opts), ggp, ggpAmount, opts)
+}
+func StakeGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoNodeStaking.Transact(opts, "stakeRPL", ggpAmount)
+	hash, err := gogoNodeStaking.TransactContext(ctx, opts, "stakeRPL", ggpAmount)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not stake GGP: %w", err)
 	}
@@ -140,20 +184,26 @@ func StakeGGP(ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpt
 
 // Estimate the gas of WithdrawGGP
 func EstimateWithdrawGGPGas(ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return EstimateWithdrawGGPGasContext(gogopool.TransactOptsContext(opts), ggp, ggpAmount, opts)
+}
+func EstimateWithdrawGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoNodeStaking.GetTransactionGasInfo(opts, "withdrawGGP", ggpAmount)
+	return gogoNodeStaking.GetTransactionGasInfoContext(ctx, opts, "withdrawGGP", ggpAmount)
 }
 
 // Withdraw staked GGP
 func WithdrawGGP(ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return WithdrawGGPContext(gogopool.TransactOptsContext(opts), ggp, ggpAmount, opts)
+}
+func WithdrawGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoNodeStaking.Transact(opts, "withdrawGGP", ggpAmount)
+	hash, err := gogoNodeStaking.TransactContext(ctx, opts, "withdrawGGP", ggpAmount)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not withdraw staked GGP: %w", err)
 	}
@@ -162,12 +212,15 @@ func WithdrawGGP(ggp *gogopool.GoGoPool, ggpAmount *big.Int, opts *bind.Transact
 
 // Calculate total effective GGP stake
 func CalculateTotalEffectiveGGPStake(ggp *gogopool.GoGoPool, offset, limit, ggpPrice *big.Int, opts *bind.CallOpts) (*big.Int, error) {
-	gogoNodeStaking, err := getGoGoNodeStaking(ggp)
+	return CalculateTotalEffectiveGGPStakeContext(gogopool.CallOptsContext(opts), ggp, offset, limit, ggpPrice, opts)
+}
+func CalculateTotalEffectiveGGPStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, offset, limit, ggpPrice *big.Int, opts *bind.CallOpts) (*big.Int, error) {
+	gogoNodeStaking, err := getGoGoNodeStaking(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	totalEffectiveGgpStake := new(*big.Int)
-	if err := gogoNodeStaking.Call(opts, totalEffectiveGgpStake, "calculateTotalEffectiveGGPStake", offset, limit, ggpPrice); err != nil {
+	if err := gogoNodeStaking.CallContext(ctx, opts, totalEffectiveGgpStake, "calculateTotalEffectiveGGPStake", offset, limit, ggpPrice); err != nil {
 		return nil, fmt.Errorf("Could not get total effective GGP stake: %w", err)
 	}
 	return *totalEffectiveGgpStake, nil
@@ -176,8 +229,8 @@ func CalculateTotalEffectiveGGPStake(ggp *gogopool.GoGoPool, offset, limit, ggpP
 // Get contracts
 var gogoNodeStakingLock sync.Mutex
 
-func getGoGoNodeStaking(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoNodeStaking(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoNodeStakingLock.Lock()
 	defer gogoNodeStakingLock.Unlock()
-	return ggp.GetContract("rocketNodeStaking")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNodeStaking))
 }
@@ -1,6 +1,7 @@
 package rewards
 
 import (
+	"context"
 	"math/big"
 	"sync"
 	"time"
@@ -14,66 +15,87 @@ import (
 
 // Get whether node reward claims are enabled
 func GetNodeClaimsEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	gogoClaimNode, err := getGoGoClaimNode(ggp)
+	return GetNodeClaimsEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeClaimsEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	gogoClaimNode, err := getGoGoClaimNode(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
-	return getEnabled(gogoClaimNode, "node", opts)
+	return getEnabled(ctx, gogoClaimNode, "node", opts)
 }
 
 // Get whether a node rewards claimer can claim
 func GetNodeClaimPossible(ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (bool, error) {
-	gogoClaimNode, err := getGoGoClaimNode(ggp)
+	return GetNodeClaimPossibleContext(gogopool.CallOptsContext(opts), ggp, claimerAddress, opts)
+}
+func GetNodeClaimPossibleContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (bool, error) {
+	gogoClaimNode, err := getGoGoClaimNode(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
-	return getClaimPossible(gogoClaimNode, "node", claimerAddress, opts)
+	return getClaimPossible(ctx, gogoClaimNode, "node", claimerAddress, opts)
 }
 
 // Get the percentage of rewards available for a node rewards claimer
 func GetNodeClaimRewardsPerc(ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (float64, error) {
-	gogoClaimNode, err := getGoGoClaimNode(ggp)
+	return GetNodeClaimRewardsPercContext(gogopool.CallOptsContext(opts), ggp, claimerAddress, opts)
+}
+func GetNodeClaimRewardsPercContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (float64, error) {
+	gogoClaimNode, err := getGoGoClaimNode(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
-	return getClaimRewardsPerc(gogoClaimNode, "node", claimerAddress, opts)
+	return getClaimRewardsPerc(ctx, gogoClaimNode, "node", claimerAddress, opts)
 }
 
 // Get the total amount of rewards available for a node rewards claimer
 func GetNodeClaimRewardsAmount(ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoClaimNode, err := getGoGoClaimNode(ggp)
+	return GetNodeClaimRewardsAmountContext(gogopool.CallOptsContext(opts), ggp, claimerAddress, opts)
+}
+func GetNodeClaimRewardsAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoClaimNode, err := getGoGoClaimNode(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return getClaimRewardsAmount(gogoClaimNode, "node", claimerAddress, opts)
+	return getClaimRewardsAmount(ctx, gogoClaimNode, "node", claimerAddress, opts)
 }
 
 // Estimate the gas of ClaimNodeRewards
 func EstimateClaimNodeRewardsGas(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoClaimNode, err := getGoGoClaimNode(ggp)
+	return EstimateClaimNodeRewardsGasContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func EstimateClaimNodeRewardsGasContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoClaimNode, err := getGoGoClaimNode(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateClaimGas(gogoClaimNode, opts)
+	return estimateClaimGas(ctx, gogoClaimNode, opts)
 }
 
 // Claim node rewards
 func ClaimNodeRewards(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoClaimNode, err := getGoGoClaimNode(ggp)
+	return ClaimNodeRewardsContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func ClaimNodeRewardsContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoClaimNode, err := getGoGoClaimNode(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return claim(gogoClaimNode, "node", opts)
+	return claim(ctx, gogoClaimNode, "node", opts)
 }
 
 // Filters through token claim events and sums the total amount claimed by claimerAddress
 func CalculateLifetimeNodeRewards(ggp *gogopool.GoGoPool, claimerAddress common.Address, intervalSize *big.Int, startBlock *big.Int) (*big.Int, error) {
+	return CalculateLifetimeNodeRewardsContext(context.Background(), ggp, claimerAddress, intervalSize, startBlock)
+}
+func CalculateLifetimeNodeRewardsContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, intervalSize *big.Int, startBlock *big.Int) (*big.Int, error) {
 	// Get contracts
-	gogoRewardsPool, err := getGoGoRewardsPool(ggp)
+	gogoRewardsPool, err := getGoGoRewardsPool(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	gogoClaimNode, err := getGoGoClaimNode(ggp)
+	gogoClaimNode, err := getGoGoClaimNode(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +105,7 @@ func CalculateLifetimeNodeRewards(ggp *gogopool.GoGoPool, claimerAddress common.
 	topicFilter := [][]common.Hash{{gogoRewardsPool.ABI.Events["GGPTokensClaimed"].ID}, {gogoClaimNode.Address.Hash()}, {claimerAddress.Hash()}}
 
 	// Get the event logs
-	logs, err := avax.GetLogs(ggp, addressFilter, topicFilter, intervalSize, startBlock, nil, nil)
+	logs, err := avax.GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, startBlock, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -106,19 +128,25 @@ func CalculateLifetimeNodeRewards(ggp *gogopool.GoGoPool, claimerAddress common.
 
 // Get the time that the user registered as a claimer
 func GetNodeRegistrationTime(ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (time.Time, error) {
-	return getClaimingContractUserRegisteredTime(ggp, "rocketClaimNode", claimerAddress, opts)
+	return GetNodeRegistrationTimeContext(gogopool.CallOptsContext(opts), ggp, claimerAddress, opts)
+}
+func GetNodeRegistrationTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (time.Time, error) {
+	return getClaimingContractUserRegisteredTime(ctx, ggp, ggp.ContractName(gogopool.ContractClaimNode), claimerAddress, opts)
 }
 
 // Get the total rewards claimed for this claiming contract this interval
 func GetNodeTotalClaimed(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	return getClaimingContractTotalClaimed(ggp, "rocketClaimNode", opts)
+	return GetNodeTotalClaimedContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeTotalClaimedContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	return getClaimingContractTotalClaimed(ctx, ggp, ggp.ContractName(gogopool.ContractClaimNode), opts)
 }
 
 // Get contracts
 var gogoClaimNodeLock sync.Mutex
 
-func getGoGoClaimNode(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoClaimNode(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoClaimNodeLock.Lock()
 	defer gogoClaimNodeLock.Unlock()
-	return ggp.GetContract("rocketClaimNode")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractClaimNode))
 }
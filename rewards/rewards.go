@@ -1,6 +1,7 @@
 package rewards
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,9 +15,9 @@ import (
 )
 
 // Get whether a claims contract is enabled
-func getEnabled(claimsContract *gogopool.Contract, claimsName string, opts *bind.CallOpts) (bool, error) {
+func getEnabled(ctx context.Context, claimsContract *gogopool.Contract, claimsName string, opts *bind.CallOpts) (bool, error) {
 	enabled := new(bool)
-	if err := claimsContract.Call(opts, enabled, "getEnabled"); err != nil {
+	if err := claimsContract.CallContext(ctx, opts, enabled, "getEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get %s claims contract enabled status: %w", claimsName, err)
 	}
 	return *enabled, nil
@@ -24,18 +25,18 @@ func getEnabled(claimsContract *gogopool.Contract, claimsName string, opts *bind
 
 // Get whether a claimer can make a claim
 // Use to check whether a claimer is able to make claims at all
-func getClaimPossible(claimsContract *gogopool.Contract, claimsName string, claimerAddress common.Address, opts *bind.CallOpts) (bool, error) {
+func getClaimPossible(ctx context.Context, claimsContract *gogopool.Contract, claimsName string, claimerAddress common.Address, opts *bind.CallOpts) (bool, error) {
 	claimPossible := new(bool)
-	if err := claimsContract.Call(opts, claimPossible, "getClaimPossible", claimerAddress); err != nil {
+	if err := claimsContract.CallContext(ctx, opts, claimPossible, "getClaimPossible", claimerAddress); err != nil {
 		return false, fmt.Errorf("Could not get %s claim possible status for %s: %w", claimsName, claimerAddress.Hex(), err)
 	}
 	return *claimPossible, nil
 }
 
 // Get the percentage of rewards available to a claimer
-func getClaimRewardsPerc(claimsContract *gogopool.Contract, claimsName string, claimerAddress common.Address, opts *bind.CallOpts) (float64, error) {
+func getClaimRewardsPerc(ctx context.Context, claimsContract *gogopool.Contract, claimsName string, claimerAddress common.Address, opts *bind.CallOpts) (float64, error) {
 	claimRewardsPerc := new(*big.Int)
-	if err := claimsContract.Call(opts, claimRewardsPerc, "getClaimRewardsPerc", claimerAddress); err != nil {
+	if err := claimsContract.CallContext(ctx, opts, claimRewardsPerc, "getClaimRewardsPerc", claimerAddress); err != nil {
 		return 0, fmt.Errorf("Could not get %s claim rewards percent for %s: %w", claimsName, claimerAddress.Hex(), err)
 	}
 	return avax.WeiToEth(*claimRewardsPerc), nil
@@ -43,48 +44,48 @@ func getClaimRewardsPerc(claimsContract *gogopool.Contract, claimsName string, c
 
 // Get the total amount of rewards available to a claimer
 // Use to check whether a claimer is able to make a claim for the current interval (returns zero if unable)
-func getClaimRewardsAmount(claimsContract *gogopool.Contract, claimsName string, claimerAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
+func getClaimRewardsAmount(ctx context.Context, claimsContract *gogopool.Contract, claimsName string, claimerAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
 	claimRewardsAmount := new(*big.Int)
-	if err := claimsContract.Call(opts, claimRewardsAmount, "getClaimRewardsAmount", claimerAddress); err != nil {
+	if err := claimsContract.CallContext(ctx, opts, claimRewardsAmount, "getClaimRewardsAmount", claimerAddress); err != nil {
 		return nil, fmt.Errorf("Could not get %s claim rewards amount for %s: %w", claimsName, claimerAddress.Hex(), err)
 	}
 	return *claimRewardsAmount, nil
 }
 
 // Get the time that the user registered as a claimer
-func getClaimingContractUserRegisteredTime(ggp *gogopool.GoGoPool, claimsContract string, claimerAddress common.Address, opts *bind.CallOpts) (time.Time, error) {
-	gogoRewardsPool, err := getGoGoRewardsPool(ggp)
+func getClaimingContractUserRegisteredTime(ctx context.Context, ggp *gogopool.GoGoPool, claimsContract string, claimerAddress common.Address, opts *bind.CallOpts) (time.Time, error) {
+	gogoRewardsPool, err := getGoGoRewardsPool(ctx, ggp)
 	if err != nil {
 		return time.Time{}, err
 	}
 	claimTime := new(*big.Int)
-	if err := gogoRewardsPool.Call(opts, claimTime, "getClaimingContractUserRegisteredTime", claimsContract, claimerAddress); err != nil {
+	if err := gogoRewardsPool.CallContext(ctx, opts, claimTime, "getClaimingContractUserRegisteredTime", claimsContract, claimerAddress); err != nil {
 		return time.Time{}, fmt.Errorf("Could not get claims registration time on contract %s for %s: %w", claimsContract, claimerAddress.Hex(), err)
 	}
 	return time.Unix((*claimTime).Int64(), 0), nil
 }
 
 // Get the total amount claimed in the current interval by the given claiming contract
-func getClaimingContractTotalClaimed(ggp *gogopool.GoGoPool, claimsContract string, opts *bind.CallOpts) (*big.Int, error) {
-	gogoRewardsPool, err := getGoGoRewardsPool(ggp)
+func getClaimingContractTotalClaimed(ctx context.Context, ggp *gogopool.GoGoPool, claimsContract string, opts *bind.CallOpts) (*big.Int, error) {
+	gogoRewardsPool, err := getGoGoRewardsPool(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	totalClaimed := new(*big.Int)
-	if err := gogoRewardsPool.Call(opts, totalClaimed, "getClaimingContractTotalClaimed", claimsContract); err != nil {
+	if err := gogoRewardsPool.CallContext(ctx, opts, totalClaimed, "getClaimingContractTotalClaimed", claimsContract); err != nil {
 		return nil, fmt.Errorf("Could not get total claimed for %s: %w", claimsContract, err)
 	}
 	return *totalClaimed, nil
 }
 
 // Estimate the gas of claim
-func estimateClaimGas(claimsContract *gogopool.Contract, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return claimsContract.GetTransactionGasInfo(opts, "claim")
+func estimateClaimGas(ctx context.Context, claimsContract *gogopool.Contract, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return claimsContract.GetTransactionGasInfoContext(ctx, opts, "claim")
 }
 
 // Claim rewards
-func claim(claimsContract *gogopool.Contract, claimsName string, opts *bind.TransactOpts) (common.Hash, error) {
-	hash, err := claimsContract.Transact(opts, "claim")
+func claim(ctx context.Context, claimsContract *gogopool.Contract, claimsName string, opts *bind.TransactOpts) (common.Hash, error) {
+	hash, err := claimsContract.TransactContext(ctx, opts, "claim")
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not claim %s rewards: %w", claimsName, err)
 	}
@@ -93,12 +94,15 @@ func claim(claimsContract *gogopool.Contract, claimsName string, opts *bind.Tran
 
 // Get the timestamp that the current rewards interval started
 func GetClaimIntervalTimeStart(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (time.Time, error) {
-	gogoRewardsPool, err := getGoGoRewardsPool(ggp)
+	return GetClaimIntervalTimeStartContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetClaimIntervalTimeStartContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (time.Time, error) {
+	gogoRewardsPool, err := getGoGoRewardsPool(ctx, ggp)
 	if err != nil {
 		return time.Time{}, err
 	}
 	unixTime := new(*big.Int)
-	if err := gogoRewardsPool.Call(opts, unixTime, "getClaimIntervalTimeStart"); err != nil {
+	if err := gogoRewardsPool.CallContext(ctx, opts, unixTime, "getClaimIntervalTimeStart"); err != nil {
 		return time.Time{}, fmt.Errorf("Could not get claim interval time start: %w", err)
 	}
 	return time.Unix(int64((*unixTime).Uint64()), 0), nil
@@ -106,12 +110,15 @@ func GetClaimIntervalTimeStart(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (tim
 
 // Get the number of seconds in a claim interval
 func GetClaimIntervalTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (time.Duration, error) {
-	gogoRewardsPool, err := getGoGoRewardsPool(ggp)
+	return GetClaimIntervalTimeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetClaimIntervalTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (time.Duration, error) {
+	gogoRewardsPool, err := getGoGoRewardsPool(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	unixTime := new(*big.Int)
-	if err := gogoRewardsPool.Call(opts, unixTime, "getClaimIntervalTime"); err != nil {
+	if err := gogoRewardsPool.CallContext(ctx, opts, unixTime, "getClaimIntervalTime"); err != nil {
 		return 0, fmt.Errorf("Could not get claim interval time: %w", err)
 	}
 	return time.Duration((*unixTime).Int64()) * time.Second, nil
@@ -119,12 +126,15 @@ func GetClaimIntervalTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (time.Dur
 
 // Get the percent of checkpoint rewards that goes to node operators
 func GetNodeOperatorRewardsPercent(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	gogoRewardsPool, err := getGoGoRewardsPool(ggp)
+	return GetNodeOperatorRewardsPercentContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeOperatorRewardsPercentContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	gogoRewardsPool, err := getGoGoRewardsPool(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	perc := new(*big.Int)
-	if err := gogoRewardsPool.Call(opts, perc, "getClaimingContractPerc", "rocketClaimNode"); err != nil {
+	if err := gogoRewardsPool.CallContext(ctx, opts, perc, "getClaimingContractPerc", ggp.ContractName(gogopool.ContractClaimNode)); err != nil {
 		return 0, fmt.Errorf("Could not get node operator rewards percent: %w", err)
 	}
 	return avax.WeiToEth(*perc), nil
@@ -132,12 +142,15 @@ func GetNodeOperatorRewardsPercent(ggp *gogopool.GoGoPool, opts *bind.CallOpts)
 
 // Get the percent of checkpoint rewards that goes to ODAO members
 func GetTrustedNodeOperatorRewardsPercent(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	gogoRewardsPool, err := getGoGoRewardsPool(ggp)
+	return GetTrustedNodeOperatorRewardsPercentContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTrustedNodeOperatorRewardsPercentContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	gogoRewardsPool, err := getGoGoRewardsPool(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	perc := new(*big.Int)
-	if err := gogoRewardsPool.Call(opts, perc, "getClaimingContractPerc", "rocketClaimTrustedNode"); err != nil {
+	if err := gogoRewardsPool.CallContext(ctx, opts, perc, "getClaimingContractPerc", ggp.ContractName(gogopool.ContractClaimTrustedNode)); err != nil {
 		return 0, fmt.Errorf("Could not get trusted node operator rewards percent: %w", err)
 	}
 	return avax.WeiToEth(*perc), nil
@@ -146,8 +159,8 @@ func GetTrustedNodeOperatorRewardsPercent(ggp *gogopool.GoGoPool, opts *bind.Cal
 // Get contracts
 var gogoRewardsPoolLock sync.Mutex
 
-func getGoGoRewardsPool(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoRewardsPool(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoRewardsPoolLock.Lock()
 	defer gogoRewardsPoolLock.Unlock()
-	return ggp.GetContract("rocketRewardsPool")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractRewardsPool))
 }
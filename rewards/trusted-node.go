@@ -1,6 +1,7 @@
 package rewards
 
 import (
+	"context"
 	"math/big"
 	"sync"
 	"time"
@@ -14,66 +15,87 @@ import (
 
 // Get whether trusted node reward claims are enabled
 func GetTrustedNodeClaimsEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ggp)
+	return GetTrustedNodeClaimsEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTrustedNodeClaimsEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
-	return getEnabled(gogoClaimTrustedNode, "trusted node", opts)
+	return getEnabled(ctx, gogoClaimTrustedNode, "trusted node", opts)
 }
 
 // Get whether a trusted node rewards claimer can claim
 func GetTrustedNodeClaimPossible(ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (bool, error) {
-	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ggp)
+	return GetTrustedNodeClaimPossibleContext(gogopool.CallOptsContext(opts), ggp, claimerAddress, opts)
+}
+func GetTrustedNodeClaimPossibleContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (bool, error) {
+	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
-	return getClaimPossible(gogoClaimTrustedNode, "trusted node", claimerAddress, opts)
+	return getClaimPossible(ctx, gogoClaimTrustedNode, "trusted node", claimerAddress, opts)
 }
 
 // Get the percentage of rewards available for a trusted node rewards claimer
 func GetTrustedNodeClaimRewardsPerc(ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (float64, error) {
-	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ggp)
+	return GetTrustedNodeClaimRewardsPercContext(gogopool.CallOptsContext(opts), ggp, claimerAddress, opts)
+}
+func GetTrustedNodeClaimRewardsPercContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (float64, error) {
+	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
-	return getClaimRewardsPerc(gogoClaimTrustedNode, "trusted node", claimerAddress, opts)
+	return getClaimRewardsPerc(ctx, gogoClaimTrustedNode, "trusted node", claimerAddress, opts)
 }
 
 // Get the total amount of rewards available for a trusted node rewards claimer
 func GetTrustedNodeClaimRewardsAmount(ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ggp)
+	return GetTrustedNodeClaimRewardsAmountContext(gogopool.CallOptsContext(opts), ggp, claimerAddress, opts)
+}
+func GetTrustedNodeClaimRewardsAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return getClaimRewardsAmount(gogoClaimTrustedNode, "trusted node", claimerAddress, opts)
+	return getClaimRewardsAmount(ctx, gogoClaimTrustedNode, "trusted node", claimerAddress, opts)
 }
 
 // Estimate the gas of ClaimTrustedNodeRewards
 func EstimateClaimTrustedNodeRewardsGas(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ggp)
+	return EstimateClaimTrustedNodeRewardsGasContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func EstimateClaimTrustedNodeRewardsGasContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateClaimGas(gogoClaimTrustedNode, opts)
+	return estimateClaimGas(ctx, gogoClaimTrustedNode, opts)
 }
 
 // Claim trusted node rewards
 func ClaimTrustedNodeRewards(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ggp)
+	return ClaimTrustedNodeRewardsContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func ClaimTrustedNodeRewardsContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return claim(gogoClaimTrustedNode, "trusted node", opts)
+	return claim(ctx, gogoClaimTrustedNode, "trusted node", opts)
 }
 
 // Filters through token claim events and sums the total amount claimed by claimerAddress
 func CalculateLifetimeTrustedNodeRewards(ggp *gogopool.GoGoPool, claimerAddress common.Address, intervalSize *big.Int, startBlock *big.Int) (*big.Int, error) {
+	return CalculateLifetimeTrustedNodeRewardsContext(context.Background(), ggp, claimerAddress, intervalSize, startBlock)
+}
+func CalculateLifetimeTrustedNodeRewardsContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, intervalSize *big.Int, startBlock *big.Int) (*big.Int, error) {
 	// Get contracts
-	gogoRewardsPool, err := getGoGoRewardsPool(ggp)
+	gogoRewardsPool, err := getGoGoRewardsPool(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ggp)
+	gogoClaimTrustedNode, err := getGoGoClaimTrustedNode(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +105,7 @@ func CalculateLifetimeTrustedNodeRewards(ggp *gogopool.GoGoPool, claimerAddress
 	topicFilter := [][]common.Hash{{gogoRewardsPool.ABI.Events["GGPTokensClaimed"].ID}, {gogoClaimTrustedNode.Address.Hash()}, {claimerAddress.Hash()}}
 
 	// Get the event logs
-	logs, err := avax.GetLogs(ggp, addressFilter, topicFilter, intervalSize, startBlock, nil, nil)
+	logs, err := avax.GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, startBlock, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -106,19 +128,25 @@ func CalculateLifetimeTrustedNodeRewards(ggp *gogopool.GoGoPool, claimerAddress
 
 // Get the time that the user registered as a claimer
 func GetTrustedNodeRegistrationTime(ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (time.Time, error) {
-	return getClaimingContractUserRegisteredTime(ggp, "rocketClaimTrustedNode", claimerAddress, opts)
+	return GetTrustedNodeRegistrationTimeContext(gogopool.CallOptsContext(opts), ggp, claimerAddress, opts)
+}
+func GetTrustedNodeRegistrationTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, claimerAddress common.Address, opts *bind.CallOpts) (time.Time, error) {
+	return getClaimingContractUserRegisteredTime(ctx, ggp, ggp.ContractName(gogopool.ContractClaimTrustedNode), claimerAddress, opts)
 }
 
 // Get the total rewards claimed for this claiming contract this interval
 func GetTrustedNodeTotalClaimed(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	return getClaimingContractTotalClaimed(ggp, "rocketClaimTrustedNode", opts)
+	return GetTrustedNodeTotalClaimedContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTrustedNodeTotalClaimedContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	return getClaimingContractTotalClaimed(ctx, ggp, ggp.ContractName(gogopool.ContractClaimTrustedNode), opts)
 }
 
 // Get contracts
 var gogoClaimTrustedNodeLock sync.Mutex
 
-func getGoGoClaimTrustedNode(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoClaimTrustedNode(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoClaimTrustedNodeLock.Lock()
 	defer gogoClaimTrustedNodeLock.Unlock()
-	return ggp.GetContract("rocketClaimTrustedNode")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractClaimTrustedNode))
 }
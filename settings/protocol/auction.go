@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,125 +15,169 @@ import (
 )
 
 // Config
+//
+// Deprecated: use ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction), which follows contract name overrides
 const AuctionSettingsContractName = "rocketDAOProtocolSettingsAuction"
 
 // Lot creation currently enabled
 func GetCreateLotEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	auctionSettingsContract, err := getAuctionSettingsContract(ggp)
+	return GetCreateLotEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetCreateLotEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	auctionSettingsContract, err := getAuctionSettingsContract(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	value := new(bool)
-	if err := auctionSettingsContract.Call(opts, value, "getCreateLotEnabled"); err != nil {
+	if err := auctionSettingsContract.CallContext(ctx, opts, value, "getCreateLotEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get lot creation enabled status: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapCreateLotEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapBool(ggp, AuctionSettingsContractName, "auction.lot.create.enabled", value, opts)
+	return BootstrapCreateLotEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapCreateLotEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapBoolContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction), "auction.lot.create.enabled", value, opts)
 }
 
 // Lot bidding currently enabled
 func GetBidOnLotEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	auctionSettingsContract, err := getAuctionSettingsContract(ggp)
+	return GetBidOnLotEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetBidOnLotEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	auctionSettingsContract, err := getAuctionSettingsContract(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	value := new(bool)
-	if err := auctionSettingsContract.Call(opts, value, "getBidOnLotEnabled"); err != nil {
+	if err := auctionSettingsContract.CallContext(ctx, opts, value, "getBidOnLotEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get lot bidding enabled status: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapBidOnLotEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapBool(ggp, AuctionSettingsContractName, "auction.lot.bidding.enabled", value, opts)
+	return BootstrapBidOnLotEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapBidOnLotEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapBoolContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction), "auction.lot.bidding.enabled", value, opts)
 }
 
 // The minimum lot size in ETH value
 func GetLotMinimumEthValue(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	auctionSettingsContract, err := getAuctionSettingsContract(ggp)
+	return GetLotMinimumEthValueContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLotMinimumEthValueContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	auctionSettingsContract, err := getAuctionSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := auctionSettingsContract.Call(opts, value, "getLotMinimumEthValue"); err != nil {
+	if err := auctionSettingsContract.CallContext(ctx, opts, value, "getLotMinimumEthValue"); err != nil {
 		return nil, fmt.Errorf("Could not get lot minimum ETH value: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapLotMinimumEthValue(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, AuctionSettingsContractName, "auction.lot.value.minimum", value, opts)
+	return BootstrapLotMinimumEthValueContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapLotMinimumEthValueContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction), "auction.lot.value.minimum", value, opts)
 }
 
 // The maximum lot size in ETH value
 func GetLotMaximumEthValue(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	auctionSettingsContract, err := getAuctionSettingsContract(ggp)
+	return GetLotMaximumEthValueContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLotMaximumEthValueContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	auctionSettingsContract, err := getAuctionSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := auctionSettingsContract.Call(opts, value, "getLotMaximumEthValue"); err != nil {
+	if err := auctionSettingsContract.CallContext(ctx, opts, value, "getLotMaximumEthValue"); err != nil {
 		return nil, fmt.Errorf("Could not get lot maximum ETH value: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapLotMaximumEthValue(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, AuctionSettingsContractName, "auction.lot.value.maximum", value, opts)
+	return BootstrapLotMaximumEthValueContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapLotMaximumEthValueContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction), "auction.lot.value.maximum", value, opts)
 }
 
 // The lot duration in blocks
 func GetLotDuration(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	auctionSettingsContract, err := getAuctionSettingsContract(ggp)
+	return GetLotDurationContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLotDurationContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	auctionSettingsContract, err := getAuctionSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := auctionSettingsContract.Call(opts, value, "getLotDuration"); err != nil {
+	if err := auctionSettingsContract.CallContext(ctx, opts, value, "getLotDuration"); err != nil {
 		return 0, fmt.Errorf("Could not get lot duration: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapLotDuration(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, AuctionSettingsContractName, "auction.lot.duration", big.NewInt(int64(value)), opts)
+	return BootstrapLotDurationContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapLotDurationContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction), "auction.lot.duration", big.NewInt(int64(value)), opts)
 }
 
 // The starting price relative to current ETH price, as a fraction
 func GetLotStartingPriceRatio(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	auctionSettingsContract, err := getAuctionSettingsContract(ggp)
+	return GetLotStartingPriceRatioContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLotStartingPriceRatioContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	auctionSettingsContract, err := getAuctionSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := auctionSettingsContract.Call(opts, value, "getStartingPriceRatio"); err != nil {
+	if err := auctionSettingsContract.CallContext(ctx, opts, value, "getStartingPriceRatio"); err != nil {
 		return 0, fmt.Errorf("Could not get lot starting price ratio: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapLotStartingPriceRatio(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, AuctionSettingsContractName, "auction.price.start", avax.EthToWei(value), opts)
+	return BootstrapLotStartingPriceRatioContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapLotStartingPriceRatioContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction), "auction.price.start", avax.EthToWei(value), opts)
 }
 
 // The reserve price relative to current ETH price, as a fraction
 func GetLotReservePriceRatio(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	auctionSettingsContract, err := getAuctionSettingsContract(ggp)
+	return GetLotReservePriceRatioContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetLotReservePriceRatioContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	auctionSettingsContract, err := getAuctionSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := auctionSettingsContract.Call(opts, value, "getReservePriceRatio"); err != nil {
+	if err := auctionSettingsContract.CallContext(ctx, opts, value, "getReservePriceRatio"); err != nil {
 		return 0, fmt.Errorf("Could not get lot reserve price ratio: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapLotReservePriceRatio(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, AuctionSettingsContractName, "auction.price.reserve", avax.EthToWei(value), opts)
+	return BootstrapLotReservePriceRatioContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapLotReservePriceRatioContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction), "auction.price.reserve", avax.EthToWei(value), opts)
 }
 
 // Get contracts
 var auctionSettingsContractLock sync.Mutex
 
-func getAuctionSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getAuctionSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	auctionSettingsContractLock.Lock()
 	defer auctionSettingsContractLock.Unlock()
-	return ggp.GetContract(AuctionSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProtocolSettingsAuction))
 }
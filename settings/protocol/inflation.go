@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,45 +15,59 @@ import (
 )
 
 // Config
+//
+// Deprecated: use ggp.ContractName(gogopool.ContractDAOProtocolSettingsInflation), which follows contract name overrides
 const InflationSettingsContractName = "rocketDAOProtocolSettingsInflation"
 
 // GGP inflation rate per interval
 func GetInflationIntervalRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	inflationSettingsContract, err := getInflationSettingsContract(ggp)
+	return GetInflationIntervalRateContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetInflationIntervalRateContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	inflationSettingsContract, err := getInflationSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := inflationSettingsContract.Call(opts, value, "getInflationIntervalRate"); err != nil {
+	if err := inflationSettingsContract.CallContext(ctx, opts, value, "getInflationIntervalRate"); err != nil {
 		return 0, fmt.Errorf("Could not get inflation rate: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapInflationIntervalRate(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, InflationSettingsContractName, "ggp.inflation.interval.rate", avax.EthToWei(value), opts)
+	return BootstrapInflationIntervalRateContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapInflationIntervalRateContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsInflation), "ggp.inflation.interval.rate", avax.EthToWei(value), opts)
 }
 
 // GGP inflation start time
 func GetInflationStartTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	inflationSettingsContract, err := getInflationSettingsContract(ggp)
+	return GetInflationStartTimeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetInflationStartTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	inflationSettingsContract, err := getInflationSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := inflationSettingsContract.Call(opts, value, "getInflationIntervalStartTime"); err != nil {
+	if err := inflationSettingsContract.CallContext(ctx, opts, value, "getInflationIntervalStartTime"); err != nil {
 		return 0, fmt.Errorf("Could not get inflation start time: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapInflationStartTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, InflationSettingsContractName, "ggp.inflation.interval.start", big.NewInt(int64(value)), opts)
+	return BootstrapInflationStartTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapInflationStartTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsInflation), "ggp.inflation.interval.start", big.NewInt(int64(value)), opts)
 }
 
 // Get contracts
 var inflationSettingsContractLock sync.Mutex
 
-func getInflationSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getInflationSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	inflationSettingsContractLock.Lock()
 	defer inflationSettingsContractLock.Unlock()
-	return ggp.GetContract(InflationSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProtocolSettingsInflation))
 }
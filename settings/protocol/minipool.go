@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,16 +15,21 @@ import (
 )
 
 // Config
+//
+// Deprecated: use ggp.ContractName(gogopool.ContractDAOProtocolSettingsMinipool), which follows contract name overrides
 const MinipoolSettingsContractName = "rocketDAOProtocolSettingsMinipool"
 
 // Get the minipool launch balance
 func GetMinipoolLaunchBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolLaunchBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolLaunchBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getLaunchBalance"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getLaunchBalance"); err != nil {
 		return nil, fmt.Errorf("Could not get minipool launch balance: %w", err)
 	}
 	return *value, nil
@@ -31,34 +37,43 @@ func GetMinipoolLaunchBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big
 
 // Required node deposit amounts
 func GetMinipoolFullDepositNodeAmount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolFullDepositNodeAmountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolFullDepositNodeAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getFullDepositNodeAmount"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getFullDepositNodeAmount"); err != nil {
 		return nil, fmt.Errorf("Could not get full minipool deposit node amount: %w", err)
 	}
 	return *value, nil
 }
 func GetMinipoolHalfDepositNodeAmount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolHalfDepositNodeAmountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolHalfDepositNodeAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getHalfDepositNodeAmount"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getHalfDepositNodeAmount"); err != nil {
 		return nil, fmt.Errorf("Could not get half minipool deposit node amount: %w", err)
 	}
 	return *value, nil
 }
 func GetMinipoolEmptyDepositNodeAmount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolEmptyDepositNodeAmountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolEmptyDepositNodeAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getEmptyDepositNodeAmount"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getEmptyDepositNodeAmount"); err != nil {
 		return nil, fmt.Errorf("Could not get empty minipool deposit node amount: %w", err)
 	}
 	return *value, nil
@@ -66,34 +81,43 @@ func GetMinipoolEmptyDepositNodeAmount(ggp *gogopool.GoGoPool, opts *bind.CallOp
 
 // Required user deposit amounts
 func GetMinipoolFullDepositUserAmount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolFullDepositUserAmountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolFullDepositUserAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getFullDepositUserAmount"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getFullDepositUserAmount"); err != nil {
 		return nil, fmt.Errorf("Could not get full minipool deposit user amount: %w", err)
 	}
 	return *value, nil
 }
 func GetMinipoolHalfDepositUserAmount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolHalfDepositUserAmountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolHalfDepositUserAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getHalfDepositUserAmount"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getHalfDepositUserAmount"); err != nil {
 		return nil, fmt.Errorf("Could not get half minipool deposit user amount: %w", err)
 	}
 	return *value, nil
 }
 func GetMinipoolEmptyDepositUserAmount(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolEmptyDepositUserAmountContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolEmptyDepositUserAmountContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getEmptyDepositUserAmount"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getEmptyDepositUserAmount"); err != nil {
 		return nil, fmt.Errorf("Could not get empty minipool deposit user amount: %w", err)
 	}
 	return *value, nil
@@ -101,42 +125,54 @@ func GetMinipoolEmptyDepositUserAmount(ggp *gogopool.GoGoPool, opts *bind.CallOp
 
 // Minipool withdrawable event submissions currently enabled
 func GetMinipoolSubmitWithdrawableEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolSubmitWithdrawableEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolSubmitWithdrawableEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	value := new(bool)
-	if err := minipoolSettingsContract.Call(opts, value, "getSubmitWithdrawableEnabled"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getSubmitWithdrawableEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get minipool withdrawable submissions enabled status: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapMinipoolSubmitWithdrawableEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapBool(ggp, MinipoolSettingsContractName, "minipool.submit.withdrawable.enabled", value, opts)
+	return BootstrapMinipoolSubmitWithdrawableEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapMinipoolSubmitWithdrawableEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapBoolContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsMinipool), "minipool.submit.withdrawable.enabled", value, opts)
 }
 
 // Timeout period in seconds for prelaunch minipools to launch
 func GetMinipoolLaunchTimeout(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (time.Duration, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetMinipoolLaunchTimeoutContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolLaunchTimeoutContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (time.Duration, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getLaunchTimeout"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getLaunchTimeout"); err != nil {
 		return 0, fmt.Errorf("Could not get minipool launch timeout: %w", err)
 	}
 	seconds := time.Duration((*value).Int64()) * time.Second
 	return seconds, nil
 }
 func BootstrapMinipoolLaunchTimeout(ggp *gogopool.GoGoPool, value time.Duration, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, MinipoolSettingsContractName, "minipool.launch.timeout", big.NewInt(int64(value.Seconds())), opts)
+	return BootstrapMinipoolLaunchTimeoutContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapMinipoolLaunchTimeoutContext(ctx context.Context, ggp *gogopool.GoGoPool, value time.Duration, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsMinipool), "minipool.launch.timeout", big.NewInt(int64(value.Seconds())), opts)
 }
 
 // Get contracts
 var minipoolSettingsContractLock sync.Mutex
 
-func getMinipoolSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getMinipoolSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	minipoolSettingsContractLock.Lock()
 	defer minipoolSettingsContractLock.Unlock()
-	return ggp.GetContract(MinipoolSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProtocolSettingsMinipool))
 }
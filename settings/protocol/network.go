@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,173 +15,235 @@ import (
 )
 
 // Config
+//
+// Deprecated: use ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), which follows contract name overrides
 const NetworkSettingsContractName = "rocketDAOProtocolSettingsNetwork"
 
 // The threshold of trusted nodes that must reach consensus on oracle data to commit it
 func GetNodeConsensusThreshold(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetNodeConsensusThresholdContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeConsensusThresholdContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := networkSettingsContract.Call(opts, value, "getNodeConsensusThreshold"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getNodeConsensusThreshold"); err != nil {
 		return 0, fmt.Errorf("Could not get trusted node consensus threshold: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapNodeConsensusThreshold(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NetworkSettingsContractName, "network.consensus.threshold", avax.EthToWei(value), opts)
+	return BootstrapNodeConsensusThresholdContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapNodeConsensusThresholdContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.consensus.threshold", avax.EthToWei(value), opts)
 }
 
 // Network balance submissions currently enabled
 func GetSubmitBalancesEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetSubmitBalancesEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetSubmitBalancesEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	value := new(bool)
-	if err := networkSettingsContract.Call(opts, value, "getSubmitBalancesEnabled"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getSubmitBalancesEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get network balance submissions enabled status: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapSubmitBalancesEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapBool(ggp, NetworkSettingsContractName, "network.submit.balances.enabled", value, opts)
+	return BootstrapSubmitBalancesEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapSubmitBalancesEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapBoolContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.submit.balances.enabled", value, opts)
 }
 
 // The frequency in blocks at which network balances should be submitted by trusted nodes
 func GetSubmitBalancesFrequency(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetSubmitBalancesFrequencyContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetSubmitBalancesFrequencyContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := networkSettingsContract.Call(opts, value, "getSubmitBalancesFrequency"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getSubmitBalancesFrequency"); err != nil {
 		return 0, fmt.Errorf("Could not get network balance submission frequency: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapSubmitBalancesFrequency(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NetworkSettingsContractName, "network.submit.balances.frequency", big.NewInt(int64(value)), opts)
+	return BootstrapSubmitBalancesFrequencyContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapSubmitBalancesFrequencyContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.submit.balances.frequency", big.NewInt(int64(value)), opts)
 }
 
 // Network price submissions currently enabled
 func GetSubmitPricesEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetSubmitPricesEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetSubmitPricesEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	value := new(bool)
-	if err := networkSettingsContract.Call(opts, value, "getSubmitPricesEnabled"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getSubmitPricesEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get network price submissions enabled status: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapSubmitPricesEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapBool(ggp, NetworkSettingsContractName, "network.submit.prices.enabled", value, opts)
+	return BootstrapSubmitPricesEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapSubmitPricesEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapBoolContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.submit.prices.enabled", value, opts)
 }
 
 // The frequency in blocks at which network prices should be submitted by trusted nodes
 func GetSubmitPricesFrequency(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetSubmitPricesFrequencyContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetSubmitPricesFrequencyContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := networkSettingsContract.Call(opts, value, "getSubmitPricesFrequency"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getSubmitPricesFrequency"); err != nil {
 		return 0, fmt.Errorf("Could not get network price submission frequency: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapSubmitPricesFrequency(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NetworkSettingsContractName, "network.submit.prices.frequency", big.NewInt(int64(value)), opts)
+	return BootstrapSubmitPricesFrequencyContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapSubmitPricesFrequencyContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.submit.prices.frequency", big.NewInt(int64(value)), opts)
 }
 
 // Minimum node commission rate
 func GetMinimumNodeFee(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetMinimumNodeFeeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinimumNodeFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := networkSettingsContract.Call(opts, value, "getMinimumNodeFee"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getMinimumNodeFee"); err != nil {
 		return 0, fmt.Errorf("Could not get minimum node fee: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapMinimumNodeFee(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NetworkSettingsContractName, "network.node.fee.minimum", avax.EthToWei(value), opts)
+	return BootstrapMinimumNodeFeeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapMinimumNodeFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.node.fee.minimum", avax.EthToWei(value), opts)
 }
 
 // Target node commission rate
 func GetTargetNodeFee(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetTargetNodeFeeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTargetNodeFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := networkSettingsContract.Call(opts, value, "getTargetNodeFee"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getTargetNodeFee"); err != nil {
 		return 0, fmt.Errorf("Could not get target node fee: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapTargetNodeFee(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NetworkSettingsContractName, "network.node.fee.target", avax.EthToWei(value), opts)
+	return BootstrapTargetNodeFeeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapTargetNodeFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.node.fee.target", avax.EthToWei(value), opts)
 }
 
 // Maximum node commission rate
 func GetMaximumNodeFee(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetMaximumNodeFeeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMaximumNodeFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := networkSettingsContract.Call(opts, value, "getMaximumNodeFee"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getMaximumNodeFee"); err != nil {
 		return 0, fmt.Errorf("Could not get maximum node fee: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapMaximumNodeFee(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NetworkSettingsContractName, "network.node.fee.maximum", avax.EthToWei(value), opts)
+	return BootstrapMaximumNodeFeeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapMaximumNodeFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.node.fee.maximum", avax.EthToWei(value), opts)
 }
 
 // The range of node demand values to base fee calculations on
 func GetNodeFeeDemandRange(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetNodeFeeDemandRangeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeFeeDemandRangeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := networkSettingsContract.Call(opts, value, "getNodeFeeDemandRange"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getNodeFeeDemandRange"); err != nil {
 		return nil, fmt.Errorf("Could not get node fee demand range: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapNodeFeeDemandRange(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NetworkSettingsContractName, "network.node.fee.demand.range", value, opts)
+	return BootstrapNodeFeeDemandRangeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapNodeFeeDemandRangeContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.node.fee.demand.range", value, opts)
 }
 
 // The target collateralization rate for the rETH contract as a fraction
 func GetTargetRethCollateralRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	networkSettingsContract, err := getNetworkSettingsContract(ggp)
+	return GetTargetRethCollateralRateContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetTargetRethCollateralRateContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	networkSettingsContract, err := getNetworkSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := networkSettingsContract.Call(opts, value, "getTargetRethCollateralRate"); err != nil {
+	if err := networkSettingsContract.CallContext(ctx, opts, value, "getTargetRethCollateralRate"); err != nil {
 		return 0, fmt.Errorf("Could not get target rETH contract collateralization rate: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapTargetRethCollateralRate(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NetworkSettingsContractName, "network.reth.collateral.target", avax.EthToWei(value), opts)
+	return BootstrapTargetRethCollateralRateContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapTargetRethCollateralRateContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork), "network.reth.collateral.target", avax.EthToWei(value), opts)
 }
 
 // Get contracts
 var networkSettingsContractLock sync.Mutex
 
-func getNetworkSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getNetworkSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	networkSettingsContractLock.Lock()
 	defer networkSettingsContractLock.Unlock()
-	return ggp.GetContract(NetworkSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNetwork))
 }
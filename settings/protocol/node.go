@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,77 +15,103 @@ import (
 )
 
 // Config
+//
+// Deprecated: use ggp.ContractName(gogopool.ContractDAOProtocolSettingsNode), which follows contract name overrides
 const NodeSettingsContractName = "rocketDAOProtocolSettingsNode"
 
 // Node registrations currently enabled
 func GetNodeRegistrationEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	nodeSettingsContract, err := getNodeSettingsContract(ggp)
+	return GetNodeRegistrationEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeRegistrationEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	nodeSettingsContract, err := getNodeSettingsContract(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	value := new(bool)
-	if err := nodeSettingsContract.Call(opts, value, "getRegistrationEnabled"); err != nil {
+	if err := nodeSettingsContract.CallContext(ctx, opts, value, "getRegistrationEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get node registrations enabled status: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapNodeRegistrationEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapBool(ggp, NodeSettingsContractName, "node.registration.enabled", value, opts)
+	return BootstrapNodeRegistrationEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapNodeRegistrationEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapBoolContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNode), "node.registration.enabled", value, opts)
 }
 
 // Node deposits currently enabled
 func GetNodeDepositEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	nodeSettingsContract, err := getNodeSettingsContract(ggp)
+	return GetNodeDepositEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetNodeDepositEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	nodeSettingsContract, err := getNodeSettingsContract(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	value := new(bool)
-	if err := nodeSettingsContract.Call(opts, value, "getDepositEnabled"); err != nil {
+	if err := nodeSettingsContract.CallContext(ctx, opts, value, "getDepositEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get node deposits enabled status: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapNodeDepositEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapBool(ggp, NodeSettingsContractName, "node.deposit.enabled", value, opts)
+	return BootstrapNodeDepositEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapNodeDepositEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapBoolContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNode), "node.deposit.enabled", value, opts)
 }
 
 // The minimum GGP stake per minipool as a fraction of assigned user ETH
 func GetMinimumPerMinipoolStake(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	nodeSettingsContract, err := getNodeSettingsContract(ggp)
+	return GetMinimumPerMinipoolStakeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinimumPerMinipoolStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	nodeSettingsContract, err := getNodeSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := nodeSettingsContract.Call(opts, value, "getMinimumPerMinipoolStake"); err != nil {
+	if err := nodeSettingsContract.CallContext(ctx, opts, value, "getMinimumPerMinipoolStake"); err != nil {
 		return 0, fmt.Errorf("Could not get minimum GGP stake per minipool: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapMinimumPerMinipoolStake(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NodeSettingsContractName, "node.per.minipool.stake.minimum", avax.EthToWei(value), opts)
+	return BootstrapMinimumPerMinipoolStakeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapMinimumPerMinipoolStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNode), "node.per.minipool.stake.minimum", avax.EthToWei(value), opts)
 }
 
 // The maximum GGP stake per minipool as a fraction of assigned user ETH
 func GetMaximumPerMinipoolStake(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	nodeSettingsContract, err := getNodeSettingsContract(ggp)
+	return GetMaximumPerMinipoolStakeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMaximumPerMinipoolStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	nodeSettingsContract, err := getNodeSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := nodeSettingsContract.Call(opts, value, "getMaximumPerMinipoolStake"); err != nil {
+	if err := nodeSettingsContract.CallContext(ctx, opts, value, "getMaximumPerMinipoolStake"); err != nil {
 		return 0, fmt.Errorf("Could not get maximum GGP stake per minipool: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapMaximumPerMinipoolStake(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, NodeSettingsContractName, "node.per.minipool.stake.maximum", avax.EthToWei(value), opts)
+	return BootstrapMaximumPerMinipoolStakeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapMaximumPerMinipoolStakeContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNode), "node.per.minipool.stake.maximum", avax.EthToWei(value), opts)
 }
 
 // Get contracts
 var nodeSettingsContractLock sync.Mutex
 
-func getNodeSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getNodeSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	nodeSettingsContractLock.Lock()
 	defer nodeSettingsContractLock.Unlock()
-	return ggp.GetContract(NodeSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProtocolSettingsNode))
 }
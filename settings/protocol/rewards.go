@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,16 +15,21 @@ import (
 )
 
 // Config
+//
+// Deprecated: use ggp.ContractName(gogopool.ContractDAOProtocolSettingsRewards), which follows contract name overrides
 const RewardsSettingsContractName = "rocketDAOProtocolSettingsRewards"
 
 // The claim amount for a claimer as a fraction
 func GetRewardsClaimerPerc(ggp *gogopool.GoGoPool, contractName string, opts *bind.CallOpts) (float64, error) {
-	rewardsSettingsContract, err := getRewardsSettingsContract(ggp)
+	return GetRewardsClaimerPercContext(gogopool.CallOptsContext(opts), ggp, contractName, opts)
+}
+func GetRewardsClaimerPercContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName string, opts *bind.CallOpts) (float64, error) {
+	rewardsSettingsContract, err := getRewardsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := rewardsSettingsContract.Call(opts, value, "getRewardsClaimerPerc", contractName); err != nil {
+	if err := rewardsSettingsContract.CallContext(ctx, opts, value, "getRewardsClaimerPerc", contractName); err != nil {
 		return 0, fmt.Errorf("Could not get rewards claimer percent: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
@@ -31,12 +37,15 @@ func GetRewardsClaimerPerc(ggp *gogopool.GoGoPool, contractName string, opts *bi
 
 // The time that a claimer's share was last updated
 func GetRewardsClaimerPercTimeUpdated(ggp *gogopool.GoGoPool, contractName string, opts *bind.CallOpts) (uint64, error) {
-	rewardsSettingsContract, err := getRewardsSettingsContract(ggp)
+	return GetRewardsClaimerPercTimeUpdatedContext(gogopool.CallOptsContext(opts), ggp, contractName, opts)
+}
+func GetRewardsClaimerPercTimeUpdatedContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName string, opts *bind.CallOpts) (uint64, error) {
+	rewardsSettingsContract, err := getRewardsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := rewardsSettingsContract.Call(opts, value, "getRewardsClaimerPercTimeUpdated", contractName); err != nil {
+	if err := rewardsSettingsContract.CallContext(ctx, opts, value, "getRewardsClaimerPercTimeUpdated", contractName); err != nil {
 		return 0, fmt.Errorf("Could not get rewards claimer updated time: %w", err)
 	}
 	return (*value).Uint64(), nil
@@ -44,12 +53,15 @@ func GetRewardsClaimerPercTimeUpdated(ggp *gogopool.GoGoPool, contractName strin
 
 // The total claim amount for all claimers as a fraction
 func GetRewardsClaimersPercTotal(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	rewardsSettingsContract, err := getRewardsSettingsContract(ggp)
+	return GetRewardsClaimersPercTotalContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetRewardsClaimersPercTotalContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	rewardsSettingsContract, err := getRewardsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := rewardsSettingsContract.Call(opts, value, "getRewardsClaimersPercTotal"); err != nil {
+	if err := rewardsSettingsContract.CallContext(ctx, opts, value, "getRewardsClaimersPercTotal"); err != nil {
 		return 0, fmt.Errorf("Could not get rewards claimers total percent: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
@@ -57,25 +69,31 @@ func GetRewardsClaimersPercTotal(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (f
 
 // Rewards claim interval time
 func GetRewardsClaimIntervalTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	rewardsSettingsContract, err := getRewardsSettingsContract(ggp)
+	return GetRewardsClaimIntervalTimeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetRewardsClaimIntervalTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	rewardsSettingsContract, err := getRewardsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := rewardsSettingsContract.Call(opts, value, "getRewardsClaimIntervalTime"); err != nil {
+	if err := rewardsSettingsContract.CallContext(ctx, opts, value, "getRewardsClaimIntervalTime"); err != nil {
 		return 0, fmt.Errorf("Could not get rewards claim interval: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapRewardsClaimIntervalTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return protocoldao.BootstrapUint(ggp, RewardsSettingsContractName, "ggp.rewards.claim.period.time", big.NewInt(int64(value)), opts)
+	return BootstrapRewardsClaimIntervalTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapRewardsClaimIntervalTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return protocoldao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAOProtocolSettingsRewards), "ggp.rewards.claim.period.time", big.NewInt(int64(value)), opts)
 }
 
 // Get contracts
 var rewardsSettingsContractLock sync.Mutex
 
-func getRewardsSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getRewardsSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	rewardsSettingsContractLock.Lock()
 	defer rewardsSettingsContractLock.Unlock()
-	return ggp.GetContract(RewardsSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAOProtocolSettingsRewards))
 }
@@ -1,6 +1,7 @@
 package trustednode
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -15,7 +16,9 @@ import (
 
 // Config
 const (
-	MembersSettingsContractName       = "rocketDAONodeTrustedSettingsMembers"
+	// Deprecated: use ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), which follows contract name overrides
+	MembersSettingsContractName = "rocketDAONodeTrustedSettingsMembers"
+
 	QuorumSettingPath                 = "members.quorum"
 	GGPBondSettingPath                = "members.ggpbond"
 	MinipoolUnbondedMaxSettingPath    = "members.minipool.unbonded.max"
@@ -27,163 +30,247 @@ const (
 
 // Member proposal quorum threshold
 func GetQuorum(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	membersSettingsContract, err := getMembersSettingsContract(ggp)
+	return GetQuorumContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetQuorumContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	membersSettingsContract, err := getMembersSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := membersSettingsContract.Call(opts, value, "getQuorum"); err != nil {
+	if err := membersSettingsContract.CallContext(ctx, opts, value, "getQuorum"); err != nil {
 		return 0, fmt.Errorf("Could not get member quorum threshold: %w", err)
 	}
 	return avax.WeiToEth(*value), nil
 }
 func BootstrapQuorum(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, MembersSettingsContractName, QuorumSettingPath, avax.EthToWei(value), opts)
+	return BootstrapQuorumContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapQuorumContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), QuorumSettingPath, avax.EthToWei(value), opts)
 }
 func ProposeQuorum(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", QuorumSettingPath), MembersSettingsContractName, QuorumSettingPath, avax.EthToWei(value), opts)
+	return ProposeQuorumContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeQuorumContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", QuorumSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), QuorumSettingPath, avax.EthToWei(value), opts)
 }
 func EstimateProposeQuorumGas(ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", QuorumSettingPath), MembersSettingsContractName, QuorumSettingPath, avax.EthToWei(value), opts)
+	return EstimateProposeQuorumGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeQuorumGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value float64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", QuorumSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), QuorumSettingPath, avax.EthToWei(value), opts)
 }
 
 // GGP bond required for a member
 func GetGGPBond(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	membersSettingsContract, err := getMembersSettingsContract(ggp)
+	return GetGGPBondContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetGGPBondContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	membersSettingsContract, err := getMembersSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := membersSettingsContract.Call(opts, value, "getGGPBond"); err != nil {
+	if err := membersSettingsContract.CallContext(ctx, opts, value, "getGGPBond"); err != nil {
 		return nil, fmt.Errorf("Could not get member GGP bond amount: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapGGPBond(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, MembersSettingsContractName, GGPBondSettingPath, value, opts)
+	return BootstrapGGPBondContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapGGPBondContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), GGPBondSettingPath, value, opts)
 }
 func ProposeGGPBond(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", GGPBondSettingPath), MembersSettingsContractName, GGPBondSettingPath, value, opts)
+	return ProposeGGPBondContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeGGPBondContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", GGPBondSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), GGPBondSettingPath, value, opts)
 }
 func EstimateProposeGGPBondGas(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", GGPBondSettingPath), MembersSettingsContractName, GGPBondSettingPath, value, opts)
+	return EstimateProposeGGPBondGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeGGPBondGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", GGPBondSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), GGPBondSettingPath, value, opts)
 }
 
 // The maximum number of unbonded minipools a member can run
 func GetMinipoolUnbondedMax(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	membersSettingsContract, err := getMembersSettingsContract(ggp)
+	return GetMinipoolUnbondedMaxContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolUnbondedMaxContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	membersSettingsContract, err := getMembersSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := membersSettingsContract.Call(opts, value, "getMinipoolUnbondedMax"); err != nil {
+	if err := membersSettingsContract.CallContext(ctx, opts, value, "getMinipoolUnbondedMax"); err != nil {
 		return 0, fmt.Errorf("Could not get member unbonded minipool limit: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapMinipoolUnbondedMax(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, MembersSettingsContractName, MinipoolUnbondedMaxSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapMinipoolUnbondedMaxContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapMinipoolUnbondedMaxContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), MinipoolUnbondedMaxSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeMinipoolUnbondedMax(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", MinipoolUnbondedMaxSettingPath), MembersSettingsContractName, MinipoolUnbondedMaxSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeMinipoolUnbondedMaxContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeMinipoolUnbondedMaxContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", MinipoolUnbondedMaxSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), MinipoolUnbondedMaxSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeMinipoolUnbondedMaxGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", MinipoolUnbondedMaxSettingPath), MembersSettingsContractName, MinipoolUnbondedMaxSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeMinipoolUnbondedMaxGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeMinipoolUnbondedMaxGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", MinipoolUnbondedMaxSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), MinipoolUnbondedMaxSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // The minimum commission rate before unbonded minipools are allowed
 func GetMinipoolUnbondedMinFee(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	membersSettingsContract, err := getMembersSettingsContract(ggp)
+	return GetMinipoolUnbondedMinFeeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetMinipoolUnbondedMinFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	membersSettingsContract, err := getMembersSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := membersSettingsContract.Call(opts, value, "getMinipoolUnbondedMinFee"); err != nil {
+	if err := membersSettingsContract.CallContext(ctx, opts, value, "getMinipoolUnbondedMinFee"); err != nil {
 		return 0, fmt.Errorf("Could not get member unbonded minipool minimum fee: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapMinipoolUnbondedMinFee(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, MembersSettingsContractName, MinipoolUnbondedMinFeeSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapMinipoolUnbondedMinFeeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapMinipoolUnbondedMinFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), MinipoolUnbondedMinFeeSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeMinipoolUnbondedMinFee(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", MinipoolUnbondedMinFeeSettingPath), MembersSettingsContractName, MinipoolUnbondedMinFeeSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeMinipoolUnbondedMinFeeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeMinipoolUnbondedMinFeeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", MinipoolUnbondedMinFeeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), MinipoolUnbondedMinFeeSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeMinipoolUnbondedMinFeeGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", MinipoolUnbondedMinFeeSettingPath), MembersSettingsContractName, MinipoolUnbondedMinFeeSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeMinipoolUnbondedMinFeeGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeMinipoolUnbondedMinFeeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", MinipoolUnbondedMinFeeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), MinipoolUnbondedMinFeeSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // The period a member must wait for before submitting another challenge, in blocks
 func GetChallengeCooldown(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	membersSettingsContract, err := getMembersSettingsContract(ggp)
+	return GetChallengeCooldownContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetChallengeCooldownContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	membersSettingsContract, err := getMembersSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := membersSettingsContract.Call(opts, value, "getChallengeCooldown"); err != nil {
+	if err := membersSettingsContract.CallContext(ctx, opts, value, "getChallengeCooldown"); err != nil {
 		return 0, fmt.Errorf("Could not get member challenge cooldown period: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapChallengeCooldown(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, MembersSettingsContractName, ChallengeCooldownSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapChallengeCooldownContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapChallengeCooldownContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeCooldownSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeChallengeCooldown(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", ChallengeCooldownSettingPath), MembersSettingsContractName, ChallengeCooldownSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeChallengeCooldownContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeChallengeCooldownContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", ChallengeCooldownSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeCooldownSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeChallengeCooldownGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", ChallengeCooldownSettingPath), MembersSettingsContractName, ChallengeCooldownSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeChallengeCooldownGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeChallengeCooldownGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", ChallengeCooldownSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeCooldownSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // The period during which a member can respond to a challenge, in blocks
 func GetChallengeWindow(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	membersSettingsContract, err := getMembersSettingsContract(ggp)
+	return GetChallengeWindowContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetChallengeWindowContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	membersSettingsContract, err := getMembersSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := membersSettingsContract.Call(opts, value, "getChallengeWindow"); err != nil {
+	if err := membersSettingsContract.CallContext(ctx, opts, value, "getChallengeWindow"); err != nil {
 		return 0, fmt.Errorf("Could not get member challenge window period: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapChallengeWindow(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, MembersSettingsContractName, ChallengeWindowSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapChallengeWindowContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapChallengeWindowContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeWindowSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeChallengeWindow(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", ChallengeWindowSettingPath), MembersSettingsContractName, ChallengeWindowSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeChallengeWindowContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeChallengeWindowContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", ChallengeWindowSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeWindowSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeChallengeWindowGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", ChallengeWindowSettingPath), MembersSettingsContractName, ChallengeWindowSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeChallengeWindowGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeChallengeWindowGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", ChallengeWindowSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeWindowSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // The fee for a non-member to challenge a member, in wei
 func GetChallengeCost(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	membersSettingsContract, err := getMembersSettingsContract(ggp)
+	return GetChallengeCostContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetChallengeCostContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	membersSettingsContract, err := getMembersSettingsContract(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	value := new(*big.Int)
-	if err := membersSettingsContract.Call(opts, value, "getChallengeCost"); err != nil {
+	if err := membersSettingsContract.CallContext(ctx, opts, value, "getChallengeCost"); err != nil {
 		return nil, fmt.Errorf("Could not get member challenge cost: %w", err)
 	}
 	return *value, nil
 }
 func BootstrapChallengeCost(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, MembersSettingsContractName, ChallengeCostSettingPath, value, opts)
+	return BootstrapChallengeCostContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapChallengeCostContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeCostSettingPath, value, opts)
 }
 func ProposeChallengeCost(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", ChallengeCostSettingPath), MembersSettingsContractName, ChallengeCostSettingPath, value, opts)
+	return ProposeChallengeCostContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeChallengeCostContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", ChallengeCostSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeCostSettingPath, value, opts)
 }
 func EstimateProposeChallengeCostGas(ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", ChallengeCostSettingPath), MembersSettingsContractName, ChallengeCostSettingPath, value, opts)
+	return EstimateProposeChallengeCostGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeChallengeCostGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", ChallengeCostSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers), ChallengeCostSettingPath, value, opts)
 }
 
 // Get contracts
 var membersSettingsContractLock sync.Mutex
 
-func getMembersSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getMembersSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	membersSettingsContractLock.Lock()
 	defer membersSettingsContractLock.Unlock()
-	return ggp.GetContract(MembersSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMembers))
 }
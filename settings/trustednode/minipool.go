@@ -1,6 +1,7 @@
 package trustednode
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,60 +15,86 @@ import (
 
 // Config
 const (
+	// Deprecated: use ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMinipool), which follows contract name overrides
 	MinipoolSettingsContractName = "rocketDAONodeTrustedSettingsMinipool"
-	ScrubPeriodPath              = "minipool.scrub.period"
-	ScrubPenaltyEnabledPath      = "minipool.scrub.penalty.enabled"
+
+	ScrubPeriodPath         = "minipool.scrub.period"
+	ScrubPenaltyEnabledPath = "minipool.scrub.penalty.enabled"
 )
 
 // The cooldown period a member must wait after making a proposal before making another in seconds
 func GetScrubPeriod(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetScrubPeriodContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetScrubPeriodContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := minipoolSettingsContract.Call(opts, value, "getScrubPeriod"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getScrubPeriod"); err != nil {
 		return 0, fmt.Errorf("Could not get scrub period: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapScrubPeriod(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, MinipoolSettingsContractName, ScrubPeriodPath, big.NewInt(int64(value)), opts)
+	return BootstrapScrubPeriodContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapScrubPeriodContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMinipool), ScrubPeriodPath, big.NewInt(int64(value)), opts)
 }
 func ProposeScrubPeriod(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", ScrubPeriodPath), MinipoolSettingsContractName, ScrubPeriodPath, big.NewInt(int64(value)), opts)
+	return ProposeScrubPeriodContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeScrubPeriodContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", ScrubPeriodPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMinipool), ScrubPeriodPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeScrubPeriodGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", ScrubPeriodPath), MinipoolSettingsContractName, ScrubPeriodPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeScrubPeriodGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeScrubPeriodGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", ScrubPeriodPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMinipool), ScrubPeriodPath, big.NewInt(int64(value)), opts)
 }
 
 // Whether or not the GGP slashing penalty is applied to scrubbed minipools
 func GetScrubPenaltyEnabled(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
-	minipoolSettingsContract, err := getMinipoolSettingsContract(ggp)
+	return GetScrubPenaltyEnabledContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetScrubPenaltyEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (bool, error) {
+	minipoolSettingsContract, err := getMinipoolSettingsContract(ctx, ggp)
 	if err != nil {
 		return false, err
 	}
 	value := new(bool)
-	if err := minipoolSettingsContract.Call(opts, value, "getScrubPenaltyEnabled"); err != nil {
+	if err := minipoolSettingsContract.CallContext(ctx, opts, value, "getScrubPenaltyEnabled"); err != nil {
 		return false, fmt.Errorf("Could not get scrub penalty setting: %w", err)
 	}
 	return (*value), nil
 }
 func BootstrapScrubPenaltyEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapBool(ggp, MinipoolSettingsContractName, ScrubPenaltyEnabledPath, value, opts)
+	return BootstrapScrubPenaltyEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapScrubPenaltyEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapBoolContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMinipool), ScrubPenaltyEnabledPath, value, opts)
 }
 func ProposeScrubPenaltyEnabled(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetBool(ggp, fmt.Sprintf("set %s", ScrubPenaltyEnabledPath), MinipoolSettingsContractName, ScrubPenaltyEnabledPath, value, opts)
+	return ProposeScrubPenaltyEnabledContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeScrubPenaltyEnabledContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetBoolContext(ctx, ggp, fmt.Sprintf("set %s", ScrubPenaltyEnabledPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMinipool), ScrubPenaltyEnabledPath, value, opts)
 }
 func EstimateProposeScrubPenaltyEnabledGas(ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetBoolGas(ggp, fmt.Sprintf("set %s", ScrubPenaltyEnabledPath), MinipoolSettingsContractName, ScrubPenaltyEnabledPath, value, opts)
+	return EstimateProposeScrubPenaltyEnabledGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeScrubPenaltyEnabledGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetBoolGasContext(ctx, ggp, fmt.Sprintf("set %s", ScrubPenaltyEnabledPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMinipool), ScrubPenaltyEnabledPath, value, opts)
 }
 
 // Get contracts
 var minipoolSettingsContractLock sync.Mutex
 
-func getMinipoolSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getMinipoolSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	minipoolSettingsContractLock.Lock()
 	defer minipoolSettingsContractLock.Unlock()
-	return ggp.GetContract(MinipoolSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsMinipool))
 }
@@ -1,6 +1,7 @@
 package trustednode
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -14,129 +15,191 @@ import (
 
 // Config
 const (
+	// Deprecated: use ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), which follows contract name overrides
 	ProposalsSettingsContractName = "rocketDAONodeTrustedSettingsProposals"
-	CooldownTimeSettingPath       = "proposal.cooldown.time"
-	VoteTimeSettingPath           = "proposal.vote.time"
-	VoteDelayTimeSettingPath      = "proposal.vote.delay.time"
-	ExecuteTimeSettingPath        = "proposal.execute.time"
-	ActionTimeSettingPath         = "proposal.action.time"
+
+	CooldownTimeSettingPath  = "proposal.cooldown.time"
+	VoteTimeSettingPath      = "proposal.vote.time"
+	VoteDelayTimeSettingPath = "proposal.vote.delay.time"
+	ExecuteTimeSettingPath   = "proposal.execute.time"
+	ActionTimeSettingPath    = "proposal.action.time"
 )
 
 // The cooldown period a member must wait after making a proposal before making another in seconds
 func GetProposalCooldownTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	proposalsSettingsContract, err := getProposalsSettingsContract(ggp)
+	return GetProposalCooldownTimeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetProposalCooldownTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	proposalsSettingsContract, err := getProposalsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := proposalsSettingsContract.Call(opts, value, "getCooldownTime"); err != nil {
+	if err := proposalsSettingsContract.CallContext(ctx, opts, value, "getCooldownTime"); err != nil {
 		return 0, fmt.Errorf("Could not get proposal cooldown period: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapProposalCooldownTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, ProposalsSettingsContractName, CooldownTimeSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapProposalCooldownTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapProposalCooldownTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), CooldownTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeProposalCooldownTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", CooldownTimeSettingPath), ProposalsSettingsContractName, CooldownTimeSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeProposalCooldownTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeProposalCooldownTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", CooldownTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), CooldownTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeProposalCooldownTimeGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", CooldownTimeSettingPath), ProposalsSettingsContractName, CooldownTimeSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeProposalCooldownTimeGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeProposalCooldownTimeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", CooldownTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), CooldownTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // The period a proposal can be voted on for in seconds
 func GetProposalVoteTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	proposalsSettingsContract, err := getProposalsSettingsContract(ggp)
+	return GetProposalVoteTimeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetProposalVoteTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	proposalsSettingsContract, err := getProposalsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := proposalsSettingsContract.Call(opts, value, "getVoteTime"); err != nil {
+	if err := proposalsSettingsContract.CallContext(ctx, opts, value, "getVoteTime"); err != nil {
 		return 0, fmt.Errorf("Could not get proposal voting period: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapProposalVoteTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, ProposalsSettingsContractName, VoteTimeSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapProposalVoteTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapProposalVoteTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), VoteTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeProposalVoteTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", VoteTimeSettingPath), ProposalsSettingsContractName, VoteTimeSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeProposalVoteTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeProposalVoteTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", VoteTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), VoteTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeProposalVoteTimeGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", VoteTimeSettingPath), ProposalsSettingsContractName, VoteTimeSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeProposalVoteTimeGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeProposalVoteTimeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", VoteTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), VoteTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // The delay after creation before a proposal can be voted on in seconds
 func GetProposalVoteDelayTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	proposalsSettingsContract, err := getProposalsSettingsContract(ggp)
+	return GetProposalVoteDelayTimeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetProposalVoteDelayTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	proposalsSettingsContract, err := getProposalsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := proposalsSettingsContract.Call(opts, value, "getVoteDelayTime"); err != nil {
+	if err := proposalsSettingsContract.CallContext(ctx, opts, value, "getVoteDelayTime"); err != nil {
 		return 0, fmt.Errorf("Could not get proposal voting delay: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapProposalVoteDelayTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, ProposalsSettingsContractName, VoteDelayTimeSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapProposalVoteDelayTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapProposalVoteDelayTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), VoteDelayTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeProposalVoteDelayTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", VoteDelayTimeSettingPath), ProposalsSettingsContractName, VoteDelayTimeSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeProposalVoteDelayTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeProposalVoteDelayTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", VoteDelayTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), VoteDelayTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeProposalVoteDelayTimeGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", VoteDelayTimeSettingPath), ProposalsSettingsContractName, VoteDelayTimeSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeProposalVoteDelayTimeGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeProposalVoteDelayTimeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", VoteDelayTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), VoteDelayTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // The period during which a passed proposal can be executed in time
 func GetProposalExecuteTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	proposalsSettingsContract, err := getProposalsSettingsContract(ggp)
+	return GetProposalExecuteTimeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetProposalExecuteTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	proposalsSettingsContract, err := getProposalsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := proposalsSettingsContract.Call(opts, value, "getExecuteTime"); err != nil {
+	if err := proposalsSettingsContract.CallContext(ctx, opts, value, "getExecuteTime"); err != nil {
 		return 0, fmt.Errorf("Could not get proposal execution period: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapProposalExecuteTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, ProposalsSettingsContractName, ExecuteTimeSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapProposalExecuteTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapProposalExecuteTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), ExecuteTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeProposalExecuteTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", ExecuteTimeSettingPath), ProposalsSettingsContractName, ExecuteTimeSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeProposalExecuteTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeProposalExecuteTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", ExecuteTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), ExecuteTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeProposalExecuteTimeGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", ExecuteTimeSettingPath), ProposalsSettingsContractName, ExecuteTimeSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeProposalExecuteTimeGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeProposalExecuteTimeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", ExecuteTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), ExecuteTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // The period during which an action can be performed on an executed proposal in seconds
 func GetProposalActionTime(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
-	proposalsSettingsContract, err := getProposalsSettingsContract(ggp)
+	return GetProposalActionTimeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetProposalActionTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (uint64, error) {
+	proposalsSettingsContract, err := getProposalsSettingsContract(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	value := new(*big.Int)
-	if err := proposalsSettingsContract.Call(opts, value, "getActionTime"); err != nil {
+	if err := proposalsSettingsContract.CallContext(ctx, opts, value, "getActionTime"); err != nil {
 		return 0, fmt.Errorf("Could not get proposal action period: %w", err)
 	}
 	return (*value).Uint64(), nil
 }
 func BootstrapProposalActionTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
-	return trustednodedao.BootstrapUint(ggp, ProposalsSettingsContractName, ActionTimeSettingPath, big.NewInt(int64(value)), opts)
+	return BootstrapProposalActionTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func BootstrapProposalActionTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (common.Hash, error) {
+	return trustednodedao.BootstrapUintContext(ctx, ggp, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), ActionTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func ProposeProposalActionTime(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
-	return trustednodedao.ProposeSetUint(ggp, fmt.Sprintf("set %s", ActionTimeSettingPath), ProposalsSettingsContractName, ActionTimeSettingPath, big.NewInt(int64(value)), opts)
+	return ProposeProposalActionTimeContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func ProposeProposalActionTimeContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (uint64, common.Hash, error) {
+	return trustednodedao.ProposeSetUintContext(ctx, ggp, fmt.Sprintf("set %s", ActionTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), ActionTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 func EstimateProposeProposalActionTimeGas(ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return trustednodedao.EstimateProposeSetUintGas(ggp, fmt.Sprintf("set %s", ActionTimeSettingPath), ProposalsSettingsContractName, ActionTimeSettingPath, big.NewInt(int64(value)), opts)
+	return EstimateProposeProposalActionTimeGasContext(gogopool.TransactOptsContext(opts), ggp, value, opts)
+}
+func EstimateProposeProposalActionTimeGasContext(ctx context.Context, ggp *gogopool.GoGoPool, value uint64, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return trustednodedao.EstimateProposeSetUintGasContext(ctx, ggp, fmt.Sprintf("set %s", ActionTimeSettingPath), ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals), ActionTimeSettingPath, big.NewInt(int64(value)), opts)
 }
 
 // Get contracts
 var proposalsSettingsContractLock sync.Mutex
 
-func getProposalsSettingsContract(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getProposalsSettingsContract(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	proposalsSettingsContractLock.Lock()
 	defer proposalsSettingsContractLock.Unlock()
-	return ggp.GetContract(ProposalsSettingsContractName)
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAONodeTrustedSettingsProposals))
 }
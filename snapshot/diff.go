@@ -0,0 +1,195 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/multisig-labs/gogopool-go/gogopool"
+	"github.com/multisig-labs/gogopool-go/settings"
+)
+
+// Change types
+type ChangeType string
+
+const (
+	ChangeAdded    ChangeType = "added"
+	ChangeRemoved  ChangeType = "removed"
+	ChangeModified ChangeType = "modified"
+)
+
+// A change to an entity between two snapshots
+// Field is empty for added & removed entities
+type Change struct {
+	Entity string      `json:"entity"`
+	Field  string      `json:"field,omitempty"`
+	Type   ChangeType  `json:"type"`
+	Old    interface{} `json:"old,omitempty"`
+	New    interface{} `json:"new,omitempty"`
+}
+
+// The changes between two snapshots, ordered by entity & field
+type Diff struct {
+	FromBlock uint64   `json:"fromBlock"`
+	ToBlock   uint64   `json:"toBlock"`
+	Changes   []Change `json:"changes"`
+}
+
+// Get a human-readable description of a change
+func (c Change) String() string {
+	switch c.Type {
+	case ChangeAdded:
+		return fmt.Sprintf("%s: added", c.Entity)
+	case ChangeRemoved:
+		return fmt.Sprintf("%s: removed", c.Entity)
+	default:
+		return fmt.Sprintf("%s %s: %v -> %v", c.Entity, c.Field, c.Old, c.New)
+	}
+}
+
+// Compare two snapshots
+func Compare(from *Snapshot, to *Snapshot) (*Diff, error) {
+
+	// Get entity fields
+	fromEntities, err := from.entities()
+	if err != nil {
+		return nil, err
+	}
+	toEntities, err := to.entities()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get entity names
+	names := []string{}
+	for name := range fromEntities {
+		names = append(names, name)
+	}
+	for name := range toEntities {
+		if _, ok := fromEntities[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	// Compare entities
+	diff := &Diff{
+		FromBlock: from.Block,
+		ToBlock:   to.Block,
+		Changes:   []Change{},
+	}
+	for _, name := range names {
+		fromFields, inFrom := fromEntities[name]
+		toFields, inTo := toEntities[name]
+		if !inFrom {
+			diff.Changes = append(diff.Changes, Change{Entity: name, Type: ChangeAdded, New: toFields})
+			continue
+		}
+		if !inTo {
+			diff.Changes = append(diff.Changes, Change{Entity: name, Type: ChangeRemoved, Old: fromFields})
+			continue
+		}
+		diff.Changes = append(diff.Changes, compareFields(name, fromFields, toFields)...)
+	}
+
+	// Return
+	return diff, nil
+
+}
+
+// Take snapshots at two blocks and compare them
+func CompareBlocks(ggp *gogopool.GoGoPool, fromBlock uint64, toBlock uint64) (*Diff, error) {
+	return CompareBlocksContext(context.Background(), ggp, fromBlock, toBlock)
+}
+func CompareBlocksContext(ctx context.Context, ggp *gogopool.GoGoPool, fromBlock uint64, toBlock uint64) (*Diff, error) {
+	from, err := TakeContext(ctx, ggp, &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(fromBlock)})
+	if err != nil {
+		return nil, fmt.Errorf("Could not take snapshot at block %d: %w", fromBlock, err)
+	}
+	to, err := TakeContext(ctx, ggp, &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(toBlock)})
+	if err != nil {
+		return nil, fmt.Errorf("Could not take snapshot at block %d: %w", toBlock, err)
+	}
+	return Compare(from, to)
+}
+
+// Get the flattened fields of each entity in the snapshot
+func (s *Snapshot) entities() (map[string]map[string]interface{}, error) {
+	entities := make(map[string]map[string]interface{})
+	add := func(name string, value interface{}) error {
+		fields, err := settings.ToFields(value)
+		if err != nil {
+			return fmt.Errorf("Could not encode snapshot entity %s: %w", name, err)
+		}
+		entities[name] = fields
+		return nil
+	}
+	for groupName, group := range s.Settings.Groups() {
+		if err := add(fmt.Sprintf("settings %s", groupName), group); err != nil {
+			return nil, err
+		}
+	}
+	if err := add("network", s.Network); err != nil {
+		return nil, err
+	}
+	if err := add("tokens", s.Tokens); err != nil {
+		return nil, err
+	}
+	for _, node := range s.Nodes {
+		if err := add(fmt.Sprintf("node %s", node.Address.Hex()), node); err != nil {
+			return nil, err
+		}
+	}
+	for _, minipool := range s.Minipools {
+		if err := add(fmt.Sprintf("minipool %s", minipool.Address.Hex()), minipool); err != nil {
+			return nil, err
+		}
+	}
+	for _, lot := range s.Lots {
+		if err := add(fmt.Sprintf("lot %d", lot.Index), lot); err != nil {
+			return nil, err
+		}
+	}
+	for _, proposal := range s.Proposals {
+		if err := add(fmt.Sprintf("proposal %d", proposal.ID), proposal); err != nil {
+			return nil, err
+		}
+	}
+	for _, member := range s.Members {
+		if err := add(fmt.Sprintf("member %s", member.Address.Hex()), member); err != nil {
+			return nil, err
+		}
+	}
+	return entities, nil
+}
+
+// Compare the fields of an entity
+func compareFields(entity string, from map[string]interface{}, to map[string]interface{}) []Change {
+	fields := []string{}
+	for field := range from {
+		fields = append(fields, field)
+	}
+	for field := range to {
+		if _, ok := from[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	changes := []Change{}
+	for _, field := range fields {
+		if !reflect.DeepEqual(from[field], to[field]) {
+			changes = append(changes, Change{
+				Entity: entity,
+				Field:  field,
+				Type:   ChangeModified,
+				Old:    from[field],
+				New:    to[field],
+			})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,392 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/multisig-labs/gogopool-go/auction"
+	"github.com/multisig-labs/gogopool-go/dao"
+	trustednodedao "github.com/multisig-labs/gogopool-go/dao/trustednode"
+	"github.com/multisig-labs/gogopool-go/deposit"
+	"github.com/multisig-labs/gogopool-go/gogopool"
+	"github.com/multisig-labs/gogopool-go/minipool"
+	"github.com/multisig-labs/gogopool-go/network"
+	"github.com/multisig-labs/gogopool-go/node"
+	"github.com/multisig-labs/gogopool-go/settings"
+	"github.com/multisig-labs/gogopool-go/tokens"
+	ggptypes "github.com/multisig-labs/gogopool-go/types"
+)
+
+// The snapshot document format version
+const Version = 1
+
+// The protocol state at a block
+type Snapshot struct {
+	Version        int                            `json:"version"`
+	ChainID        uint64                         `json:"chainId"`
+	Block          uint64                         `json:"block"`
+	BlockTime      uint64                         `json:"blockTime"`
+	StorageAddress common.Address                 `json:"storageAddress"`
+	Settings       settings.Settings              `json:"settings"`
+	Network        NetworkState                   `json:"network"`
+	Tokens         TokenState                     `json:"tokens"`
+	Nodes          []NodeState                    `json:"nodes"`
+	Minipools      []MinipoolState                `json:"minipools"`
+	Lots           []auction.LotDetails           `json:"lots"`
+	Proposals      []dao.ProposalDetails          `json:"proposals"`
+	Members        []trustednodedao.MemberDetails `json:"members"`
+}
+
+// Network balances, prices & staking totals
+type NetworkState struct {
+	BalancesBlock          uint64   `json:"balancesBlock"`
+	TotalETHBalance        *big.Int `json:"totalEthBalance"`
+	StakingETHBalance      *big.Int `json:"stakingEthBalance"`
+	TotalRETHSupply        *big.Int `json:"totalRethSupply"`
+	PricesBlock            uint64   `json:"pricesBlock"`
+	GGPPrice               *big.Int `json:"ggpPrice"`
+	NodeDemand             *big.Int `json:"nodeDemand"`
+	NodeFee                float64  `json:"nodeFee"`
+	DepositPoolBalance     *big.Int `json:"depositPoolBalance"`
+	TotalGGPStake          *big.Int `json:"totalGgpStake"`
+	TotalEffectiveGGPStake *big.Int `json:"totalEffectiveGgpStake"`
+}
+
+// Token supplies & exchange rates
+type TokenState struct {
+	GGPTotalSupply            *big.Int `json:"ggpTotalSupply"`
+	FixedSupplyGGPTotalSupply *big.Int `json:"fixedSupplyGgpTotalSupply"`
+	RETHTotalSupply           *big.Int `json:"rethTotalSupply"`
+	RETHExchangeRate          float64  `json:"rethExchangeRate"`
+	RETHTotalCollateral       *big.Int `json:"rethTotalCollateral"`
+}
+
+// A node's details & staking
+type NodeState struct {
+	node.NodeDetails
+	GGPStake          *big.Int `json:"ggpStake"`
+	EffectiveGGPStake *big.Int `json:"effectiveGgpStake"`
+	MinipoolLimit     *big.Int `json:"minipoolLimit"`
+}
+
+// A minipool's details & status
+type MinipoolState struct {
+	minipool.MinipoolDetails
+	Status      ggptypes.MinipoolStatus  `json:"status"`
+	StatusBlock *big.Int                 `json:"statusBlock"`
+	StatusTime  *big.Int                 `json:"statusTime"`
+	NodeAddress common.Address           `json:"nodeAddress"`
+	DepositType ggptypes.MinipoolDeposit `json:"depositType"`
+}
+
+// Take a snapshot of the protocol state
+// The snapshot is taken at opts.BlockNumber if set, otherwise at the latest block, so every value is read at the same block
+func Take(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*Snapshot, error) {
+	return TakeContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func TakeContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*Snapshot, error) {
+
+	// Pin the block
+	opts, err := ggp.PinCallOptsContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Data
+	snapshot := &Snapshot{
+		Version:        Version,
+		Block:          opts.BlockNumber.Uint64(),
+		StorageAddress: *ggp.GoGoStorageContract.Address,
+	}
+	var wg errgroup.Group
+
+	// Load data
+	wg.Go(func() error {
+		chainId, err := ggp.Client.ChainID(ctx)
+		if err != nil {
+			return fmt.Errorf("Could not get chain ID: %w", err)
+		}
+		snapshot.ChainID = chainId.Uint64()
+		return nil
+	})
+	wg.Go(func() error {
+		header, err := ggp.Client.HeaderByNumber(ctx, opts.BlockNumber)
+		if err != nil {
+			return fmt.Errorf("Could not get block %d header: %w", snapshot.Block, err)
+		}
+		snapshot.BlockTime = header.Time
+		return nil
+	})
+	wg.Go(func() error {
+		allSettings, err := settings.LoadAllSettingsContext(ctx, ggp, opts)
+		if err != nil {
+			return err
+		}
+		snapshot.Settings = *allSettings
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		snapshot.Network, err = getNetworkState(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		snapshot.Tokens, err = getTokenState(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		snapshot.Nodes, err = getNodeStates(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		snapshot.Minipools, err = getMinipoolStates(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		snapshot.Lots, err = auction.GetLotsContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		snapshot.Proposals, err = dao.GetProposalsContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		snapshot.Members, err = trustednodedao.GetMembersContext(ctx, ggp, opts)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Return
+	return snapshot, nil
+
+}
+
+// Load a snapshot from a JSON file
+func Load(path string) (*Snapshot, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read snapshot file %s: %w", path, err)
+	}
+	return Parse(bytes)
+}
+
+// Parse a snapshot from JSON
+func Parse(data []byte) (*Snapshot, error) {
+	snapshot := new(Snapshot)
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("Could not decode snapshot: %w", err)
+	}
+	if snapshot.Version != Version {
+		return nil, fmt.Errorf("Unsupported snapshot version %d", snapshot.Version)
+	}
+	return snapshot, nil
+}
+
+// Write a snapshot to a JSON file
+func (s *Snapshot) Save(path string) error {
+	bytes, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Could not encode snapshot: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("Could not write snapshot file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Get network balances, prices & staking totals
+func getNetworkState(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (NetworkState, error) {
+	var state NetworkState
+	var wg errgroup.Group
+	wg.Go(func() error {
+		var err error
+		state.BalancesBlock, err = network.GetBalancesBlockContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.TotalETHBalance, err = network.GetTotalETHBalanceContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.StakingETHBalance, err = network.GetStakingETHBalanceContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.TotalRETHSupply, err = network.GetTotalRETHSupplyContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.PricesBlock, err = network.GetPricesBlockContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.GGPPrice, err = network.GetGGPPriceContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.NodeDemand, err = network.GetNodeDemandContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.NodeFee, err = network.GetNodeFeeContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.DepositPoolBalance, err = deposit.GetBalanceContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.TotalGGPStake, err = node.GetTotalGGPStakeContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.TotalEffectiveGGPStake, err = node.GetTotalEffectiveGGPStakeContext(ctx, ggp, opts)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return NetworkState{}, err
+	}
+	return state, nil
+}
+
+// Get token supplies & exchange rates
+func getTokenState(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (TokenState, error) {
+	var state TokenState
+	var wg errgroup.Group
+	wg.Go(func() error {
+		var err error
+		state.GGPTotalSupply, err = tokens.GetGGPTotalSupplyContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.FixedSupplyGGPTotalSupply, err = tokens.GetFixedSupplyGGPTotalSupplyContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.RETHTotalSupply, err = tokens.GetRETHTotalSupplyContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.RETHExchangeRate, err = tokens.GetRETHExchangeRateContext(ctx, ggp, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		state.RETHTotalCollateral, err = tokens.GetRETHTotalCollateralContext(ctx, ggp, opts)
+		return err
+	})
+	if err := wg.Wait(); err != nil {
+		return TokenState{}, err
+	}
+	return state, nil
+}
+
+// Get all node details & staking
+func getNodeStates(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]NodeState, error) {
+
+	// Get node details
+	nodes, err := node.GetNodesContext(ctx, ggp, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load node staking
+	gogoNodeStaking, err := ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractNodeStaking))
+	if err != nil {
+		return nil, err
+	}
+	states := make([]NodeState, len(nodes))
+	mc := ggp.NewMultiCaller()
+	for ni, details := range nodes {
+		states[ni].NodeDetails = details
+		if err := mc.AddCall(gogoNodeStaking, &states[ni].GGPStake, "getNodeGGPStake", details.Address); err != nil {
+			return nil, err
+		}
+		if err := mc.AddCall(gogoNodeStaking, &states[ni].EffectiveGGPStake, "getNodeEffectiveGGPStake", details.Address); err != nil {
+			return nil, err
+		}
+		if err := mc.AddCall(gogoNodeStaking, &states[ni].MinipoolLimit, "getNodeMinipoolLimit", details.Address); err != nil {
+			return nil, err
+		}
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return nil, fmt.Errorf("Could not get node staking details: %w", err)
+	}
+
+	// Return
+	return states, nil
+
+}
+
+// Get all minipool details & statuses
+func getMinipoolStates(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) ([]MinipoolState, error) {
+
+	// Get minipool details
+	minipools, err := minipool.GetMinipoolsContext(ctx, ggp, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load minipool statuses
+	states := make([]MinipoolState, len(minipools))
+	mc := ggp.NewMultiCaller()
+	for mi, details := range minipools {
+		states[mi].MinipoolDetails = details
+		contract, err := ggp.MakeContractContext(ctx, ggp.ContractName(gogopool.ContractMinipool), details.Address)
+		if err != nil {
+			return nil, err
+		}
+		if err := mc.AddCall(contract, (*uint8)(&states[mi].Status), "getStatus"); err != nil {
+			return nil, err
+		}
+		if err := mc.AddCall(contract, &states[mi].StatusBlock, "getStatusBlock"); err != nil {
+			return nil, err
+		}
+		if err := mc.AddCall(contract, &states[mi].StatusTime, "getStatusTime"); err != nil {
+			return nil, err
+		}
+		if err := mc.AddCall(contract, &states[mi].NodeAddress, "getNodeAddress"); err != nil {
+			return nil, err
+		}
+		if err := mc.AddCall(contract, (*uint8)(&states[mi].DepositType), "getDepositType"); err != nil {
+			return nil, err
+		}
+	}
+	if err := mc.FlushContext(ctx, opts); err != nil {
+		return nil, fmt.Errorf("Could not get minipool statuses: %w", err)
+	}
+
+	// Return
+	return states, nil
+
+}
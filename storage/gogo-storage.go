@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -10,8 +11,11 @@ import (
 
 // Get a node's withdrawal address
 func GetNodeWithdrawalAddress(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (common.Address, error) {
+	return GetNodeWithdrawalAddressContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodeWithdrawalAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (common.Address, error) {
 	withdrawalAddress := new(common.Address)
-	if err := ggp.GoGoStorageContract.Call(opts, withdrawalAddress, "getNodeWithdrawalAddress", nodeAddress); err != nil {
+	if err := ggp.GoGoStorageContract.CallContext(ctx, opts, withdrawalAddress, "getNodeWithdrawalAddress", nodeAddress); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get node %s withdrawal address: %w", nodeAddress.Hex(), err)
 	}
 	return *withdrawalAddress, nil
@@ -19,8 +23,11 @@ func GetNodeWithdrawalAddress(ggp *gogopool.GoGoPool, nodeAddress common.Address
 
 // Get a node's pending withdrawal address
 func GetNodePendingWithdrawalAddress(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (common.Address, error) {
+	return GetNodePendingWithdrawalAddressContext(gogopool.CallOptsContext(opts), ggp, nodeAddress, opts)
+}
+func GetNodePendingWithdrawalAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.CallOpts) (common.Address, error) {
 	withdrawalAddress := new(common.Address)
-	if err := ggp.GoGoStorageContract.Call(opts, withdrawalAddress, "getNodePendingWithdrawalAddress", nodeAddress); err != nil {
+	if err := ggp.GoGoStorageContract.CallContext(ctx, opts, withdrawalAddress, "getNodePendingWithdrawalAddress", nodeAddress); err != nil {
 		return common.Address{}, fmt.Errorf("Could not get node %s pending withdrawal address: %w", nodeAddress.Hex(), err)
 	}
 	return *withdrawalAddress, nil
@@ -28,12 +35,18 @@ func GetNodePendingWithdrawalAddress(ggp *gogopool.GoGoPool, nodeAddress common.
 
 // Estimate the gas of SetWithdrawalAddress
 func EstimateSetWithdrawalAddressGas(ggp *gogopool.GoGoPool, nodeAddress common.Address, withdrawalAddress common.Address, confirm bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return ggp.GoGoStorageContract.GetTransactionGasInfo(opts, "setWithdrawalAddress", nodeAddress, withdrawalAddress, confirm)
+	return EstimateSetWithdrawalAddressGasContext(gogopool.TransactOptsContext(opts), ggp, nodeAddress, withdrawalAddress, confirm, opts)
+}
+func EstimateSetWithdrawalAddressGasContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, withdrawalAddress common.Address, confirm bool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return ggp.GoGoStorageContract.GetTransactionGasInfoContext(ctx, opts, "setWithdrawalAddress", nodeAddress, withdrawalAddress, confirm)
 }
 
 // Set a node's withdrawal address
 func SetWithdrawalAddress(ggp *gogopool.GoGoPool, nodeAddress common.Address, withdrawalAddress common.Address, confirm bool, opts *bind.TransactOpts) (common.Hash, error) {
-	hash, err := ggp.GoGoStorageContract.Transact(opts, "setWithdrawalAddress", nodeAddress, withdrawalAddress, confirm)
+	return SetWithdrawalAddressContext(gogopool.TransactOptsContext(opts), ggp, nodeAddress, withdrawalAddress, confirm, opts)
+}
+func SetWithdrawalAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, withdrawalAddress common.Address, confirm bool, opts *bind.TransactOpts) (common.Hash, error) {
+	hash, err := ggp.GoGoStorageContract.TransactContext(ctx, opts, "setWithdrawalAddress", nodeAddress, withdrawalAddress, confirm)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not set node withdrawal address: %w", err)
 	}
@@ -42,12 +55,18 @@ func SetWithdrawalAddress(ggp *gogopool.GoGoPool, nodeAddress common.Address, wi
 
 // Estimate the gas of ConfirmWithdrawalAddress
 func EstimateConfirmWithdrawalAddressGas(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return ggp.GoGoStorageContract.GetTransactionGasInfo(opts, "confirmWithdrawalAddress", nodeAddress)
+	return EstimateConfirmWithdrawalAddressGasContext(gogopool.TransactOptsContext(opts), ggp, nodeAddress, opts)
+}
+func EstimateConfirmWithdrawalAddressGasContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return ggp.GoGoStorageContract.GetTransactionGasInfoContext(ctx, opts, "confirmWithdrawalAddress", nodeAddress)
 }
 
 // Set a node's withdrawal address
 func ConfirmWithdrawalAddress(ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
-	hash, err := ggp.GoGoStorageContract.Transact(opts, "confirmWithdrawalAddress", nodeAddress)
+	return ConfirmWithdrawalAddressContext(gogopool.TransactOptsContext(opts), ggp, nodeAddress, opts)
+}
+func ConfirmWithdrawalAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, opts *bind.TransactOpts) (common.Hash, error) {
+	hash, err := ggp.GoGoStorageContract.TransactContext(ctx, opts, "confirmWithdrawalAddress", nodeAddress)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not confirm node withdrawal address: %w", err)
 	}
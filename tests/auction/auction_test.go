@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/multisig-labs/gogopool-go/settings/trustednode"
 
 	"github.com/multisig-labs/gogopool-go/auction"
@@ -12,12 +14,14 @@ import (
 	"github.com/multisig-labs/gogopool-go/tokens"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	auctionutils "github.com/multisig-labs/gogopool-go/tests/testutils/auction"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
 )
 
 func TestAuctionDetails(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -126,6 +130,7 @@ func TestAuctionDetails(t *testing.T) {
 }
 
 func TestLotDetails(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -191,28 +196,40 @@ func TestLotDetails(t *testing.T) {
 	}
 
 	// Create lots
-	lot1Index, _, err := auction.CreateLot(ggp, userAccount1.GetTransactor())
+	lot1, err := auction.CreateLotAndWait(ggp, userAccount1.GetTransactor())
 	if err != nil {
 		t.Fatal(err)
 	}
-	lot2Index, _, err := auction.CreateLot(ggp, userAccount1.GetTransactor())
+	lot2, err := auction.CreateLotAndWait(ggp, userAccount1.GetTransactor())
 	if err != nil {
 		t.Fatal(err)
 	}
+	lot1Index := lot1.LotIndex
+	lot2Index := lot2.LotIndex
+	if lot1Index != 0 || lot2Index != 1 {
+		t.Errorf("Incorrect created lot indexes %d / %d", lot1Index, lot2Index)
+	}
+	if lot1.TxHash == (common.Hash{}) || lot1.GasUsed == 0 {
+		t.Errorf("Incorrect create lot transaction result %+v", lot1.TransactionResult)
+	}
 
 	// Place bid on lot 1
 	bidAmount := avax.EthToWei(1)
 	bid1Opts := userAccount1.GetTransactor()
 	bid1Opts.Value = bidAmount
-	if _, err := auction.PlaceBid(ggp, lot1Index, bid1Opts); err != nil {
+	if bid, err := auction.PlaceBidAndWait(ggp, lot1Index, bid1Opts); err != nil {
 		t.Fatal(err)
+	} else if bid.LotIndex != lot1Index || bid.BidAmount.Cmp(bidAmount) != 0 {
+		t.Errorf("Incorrect bid result on lot %d: %s", bid.LotIndex, bid.BidAmount.String())
 	}
 
-	// Place another bid on lot 1 to clear it
+	// Place another bid on lot 1 to clear it; the bid is capped at the lot's remaining value
 	bid2Opts := userAccount2.GetTransactor()
 	bid2Opts.Value = avax.EthToWei(1000)
-	if _, err := auction.PlaceBid(ggp, lot1Index, bid2Opts); err != nil {
+	if bid, err := auction.PlaceBidAndWait(ggp, lot1Index, bid2Opts); err != nil {
 		t.Fatal(err)
+	} else if bid.BidAmount.Sign() != 1 || bid.BidAmount.Cmp(bid2Opts.Value) >= 0 {
+		t.Errorf("Incorrect capped bid amount %s", bid.BidAmount.String())
 	}
 
 	// Mine blocks until lot 2 hits reserve price & recover unclaimed GGP from it
@@ -318,6 +335,13 @@ func TestLotDetails(t *testing.T) {
 		t.Errorf("Incorrect initial bidder GGP balance %s", ggpBalance.String())
 	}
 
+	// Simulate claiming bid on lot 1
+	if result, err := auction.SimulateClaimBid(ggp, lot1Index, userAccount1.GetTransactor()); err != nil {
+		t.Error(err)
+	} else if result.EventsTraced && len(result.Events) == 0 {
+		t.Error("Simulated bid claim did not emit any events")
+	}
+
 	// Claim bid on lot 1
 	if _, err := auction.ClaimBid(ggp, lot1Index, userAccount1.GetTransactor()); err != nil {
 		t.Fatal(err)
@@ -30,8 +30,18 @@ var (
 func TestMain(m *testing.M) {
 	var err error
 
-	// Initialize eth client
-	client = uc.NewEth1ClientProxy(0, tests.Eth1ProviderAddress)
+	// Initialize eth client, recording or replaying its calls if set by the environment
+	rpcSession, err := tests.GetRPCSession()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Without a fixture to replay, run the tests without setting up the node so that each one is skipped
+	if rpcSession.Skipped {
+		log.Println(rpcSession.SkipMessage())
+		os.Exit(m.Run())
+	}
+	client = rpcSession.NewClient(tests.Eth1ProviderAddress)
 
 	// Initialize contract manager
 	ggp, err = gogopool.NewGoGoPool(client, common.HexToAddress(tests.GoGoStorageAddress))
@@ -69,6 +79,10 @@ func TestMain(m *testing.M) {
 	utils.Stage4Bootstrap(ggp, ownerAccount)
 
 	// Run tests
-	os.Exit(m.Run())
+	code := m.Run()
+	if err := rpcSession.Close(); err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(code)
 
 }
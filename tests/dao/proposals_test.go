@@ -7,15 +7,18 @@ import (
 
 	"github.com/multisig-labs/gogopool-go/dao"
 	trustednodedao "github.com/multisig-labs/gogopool-go/dao/trustednode"
+	"github.com/multisig-labs/gogopool-go/gogopool"
 	"github.com/multisig-labs/gogopool-go/node"
 	trustednodesettings "github.com/multisig-labs/gogopool-go/settings/trustednode"
 	ggptypes "github.com/multisig-labs/gogopool-go/types"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
 )
 
 func TestProposalDetails(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -105,10 +108,22 @@ func TestProposalDetails(t *testing.T) {
 	}
 
 	// Submit invite member proposal & cancel it
-	cancelledProposalId, _, err := trustednodedao.ProposeInviteMember(ggp, "cancel this", nodeAccount.Address, "cancel", "cancel@gogopool.net", trustedNodeAccount1.GetTransactor())
+	proposalsContract, err := ggp.GetContract(ggp.ContractName(gogopool.ContractDAONodeTrustedProposals))
 	if err != nil {
 		t.Fatal(err)
 	}
+	cancelledPayload, err := proposalsContract.ABI.Pack("proposalInvite", "cancel", "cancel@gogopool.net", nodeAccount.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelledProposal, err := trustednodedao.SubmitProposalAndWait(ggp, "cancel this", cancelledPayload, trustedNodeAccount1.GetTransactor())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelledProposalId := cancelledProposal.ProposalID
+	if cancelledProposalId != proposalId+1 {
+		t.Errorf("Incorrect submitted proposal ID %d", cancelledProposalId)
+	}
 	if _, err := trustednodedao.CancelProposal(ggp, cancelledProposalId, trustedNodeAccount1.GetTransactor()); err != nil {
 		t.Fatal(err)
 	}
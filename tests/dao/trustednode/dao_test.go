@@ -11,12 +11,14 @@ import (
 	trustednodesettings "github.com/multisig-labs/gogopool-go/settings/trustednode"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	minipoolutils "github.com/multisig-labs/gogopool-go/tests/testutils/minipool"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
 )
 
 func TestMemberDetails(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -153,6 +155,7 @@ func TestMemberDetails(t *testing.T) {
 }
 
 func TestUpgradeContract(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
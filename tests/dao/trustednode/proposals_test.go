@@ -14,6 +14,7 @@ import (
 	trustednodesettings "github.com/multisig-labs/gogopool-go/settings/trustednode"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/accounts"
 	daoutils "github.com/multisig-labs/gogopool-go/tests/testutils/dao"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
@@ -21,6 +22,7 @@ import (
 )
 
 func TestProposeInviteMember(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -105,6 +107,7 @@ func TestProposeInviteMember(t *testing.T) {
 }
 
 func TestProposeMemberLeave(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -189,6 +192,7 @@ func TestProposeMemberLeave(t *testing.T) {
 }
 
 func TestProposeKickMember(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -254,6 +258,7 @@ func TestProposeKickMember(t *testing.T) {
 }
 
 func TestProposeUpgradeContract(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -8,11 +8,13 @@ import (
 	"github.com/multisig-labs/gogopool-go/settings/protocol"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	minipoolutils "github.com/multisig-labs/gogopool-go/tests/testutils/minipool"
 )
 
 func TestDeposit(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -48,6 +50,7 @@ func TestDeposit(t *testing.T) {
 }
 
 func TestAssignDeposits(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -0,0 +1,281 @@
+package gogopool
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/multisig-labs/gogopool-go/contracts"
+	"github.com/multisig-labs/gogopool-go/gogopool"
+	"github.com/multisig-labs/gogopool-go/tests/testutils/rpcserver"
+)
+
+// The upgrade contract ABI, with the upgrade events
+const upgradeContractAbi = `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"bytes32","name":"name","type":"bytes32"},{"indexed":true,"internalType":"address","name":"oldAddress","type":"address"},{"indexed":true,"internalType":"address","name":"newAddress","type":"address"},{"indexed":false,"internalType":"uint256","name":"time","type":"uint256"}],"name":"ContractUpgraded","type":"event"}]`
+
+// The timestamp of the fake chain's genesis block, and the time between its blocks
+const (
+	fakeGenesisTime   = 1600000000
+	fakeBlockInterval = 2
+)
+
+// A fake chain serving GoGoStorage reads, Multicall3 batches of them, block headers and logs over JSON-RPC
+type fakeChain struct {
+	server         *rpcserver.Server
+	storageAddress common.Address
+	storageAbi     abi.ABI
+	multicallAbi   abi.ABI
+	head           uint64
+	addresses      map[common.Hash]common.Address
+	strings        map[common.Hash]string
+	uints          map[common.Hash]*big.Int
+	logs           []types.Log
+	logRanges      [][2]uint64
+	lock           sync.Mutex
+}
+
+func newFakeChain(t *testing.T, head uint64) *fakeChain {
+	storageAbi, err := abi.JSON(strings.NewReader(contracts.GoGoStorageABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	multicallAbi, err := abi.JSON(strings.NewReader(gogopool.Multicall3ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &fakeChain{
+		storageAddress: common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+		storageAbi:     storageAbi,
+		multicallAbi:   multicallAbi,
+		head:           head,
+		addresses:      map[common.Hash]common.Address{},
+		strings:        map[common.Hash]string{},
+		uints:          map[common.Hash]*big.Int{},
+	}
+	c.server = rpcserver.New(t)
+	c.server.Handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return (*hexutil.Big)(big.NewInt(1337)), nil
+	})
+	c.server.Handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return hexutil.Uint64(c.head), nil
+	})
+	c.server.Handle("eth_getBlockByNumber", c.getBlockByNumber)
+	c.server.Handle("eth_call", c.call)
+	c.server.Handle("eth_getLogs", c.getLogs)
+	return c
+}
+
+// Register a contract in storage
+func (c *fakeChain) setContract(t *testing.T, contractName string, address common.Address, abiStr string) {
+	abiEncoded, err := gogopool.EncodeAbiStr(abiStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.addresses[crypto.Keccak256Hash([]byte("contract.address"), []byte(contractName))] = address
+	c.strings[crypto.Keccak256Hash([]byte("contract.abi"), []byte(contractName))] = abiEncoded
+}
+
+// Add a log and advance the head
+func (c *fakeChain) addLog(log types.Log, head uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.logs = append(c.logs, log)
+	c.head = head
+}
+
+// Advance the head
+func (c *fakeChain) setHead(head uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.head = head
+}
+
+// Get the block ranges of the log queries made
+func (c *fakeChain) getLogRanges() [][2]uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	ranges := make([][2]uint64, len(c.logRanges))
+	copy(ranges, c.logRanges)
+	return ranges
+}
+
+// Run a call of GoGoStorage or Multicall3
+func (c *fakeChain) call(params []json.RawMessage) (interface{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var call struct {
+		To   common.Address `json:"to"`
+		Data hexutil.Bytes  `json:"data"`
+	}
+	if err := json.Unmarshal(params[0], &call); err != nil {
+		return nil, err
+	}
+	if call.To == gogopool.Multicall3Address {
+		output, err := c.aggregate(call.Data)
+		return hexutil.Bytes(output), err
+	}
+	output, err := c.callStorage(call.Data)
+	return hexutil.Bytes(output), err
+}
+
+// Get a block header, with a timestamp fakeBlockInterval seconds after the previous block
+func (c *fakeChain) getBlockByNumber(params []json.RawMessage) (interface{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var block string
+	if err := json.Unmarshal(params[0], &block); err != nil {
+		return nil, err
+	}
+	number := c.head
+	if block != "latest" && block != "pending" {
+		parsed, err := hexutil.DecodeUint64(block)
+		if err != nil {
+			return nil, err
+		}
+		number = parsed
+	}
+	if number > c.head {
+		return nil, nil
+	}
+	return &types.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Time:       blockTime(number),
+		Difficulty: big.NewInt(0),
+	}, nil
+}
+
+// Get the logs matching a filter query
+func (c *fakeChain) getLogs(params []json.RawMessage) (interface{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var query struct {
+		Address   []common.Address `json:"address"`
+		Topics    [][]common.Hash  `json:"topics"`
+		FromBlock hexutil.Uint64   `json:"fromBlock"`
+		ToBlock   hexutil.Uint64   `json:"toBlock"`
+	}
+	if err := json.Unmarshal(params[0], &query); err != nil {
+		return nil, err
+	}
+	c.logRanges = append(c.logRanges, [2]uint64{uint64(query.FromBlock), uint64(query.ToBlock)})
+	logs := []types.Log{}
+	for _, log := range c.logs {
+		if log.BlockNumber < uint64(query.FromBlock) || log.BlockNumber > uint64(query.ToBlock) {
+			continue
+		}
+		if len(query.Address) > 0 && !containsAddress(query.Address, log.Address) {
+			continue
+		}
+		if !matchesTopics(query.Topics, log.Topics) {
+			continue
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// Run a GoGoStorage read
+func (c *fakeChain) callStorage(data []byte) ([]byte, error) {
+	method, err := c.storageAbi.MethodById(data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	key := common.Hash(args[0].([32]byte))
+	switch method.Name {
+	case "getAddress":
+		return method.Outputs.Pack(c.addresses[key])
+	case "getString":
+		return method.Outputs.Pack(c.strings[key])
+	case "getUint":
+		value := c.uints[key]
+		if value == nil {
+			value = big.NewInt(0)
+		}
+		return method.Outputs.Pack(value)
+	}
+	return nil, nil
+}
+
+// A Multicall3 aggregate3 call input
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// Run a Multicall3 aggregate3 call of GoGoStorage reads; reads of other contracts fail
+func (c *fakeChain) aggregate(data []byte) ([]byte, error) {
+	method, err := c.multicallAbi.MethodById(data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	calls := *abi.ConvertType(args[0], new([]multicall3Call)).(*[]multicall3Call)
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+	results := make([]result, len(calls))
+	for ci, call := range calls {
+		if call.Target != c.storageAddress {
+			continue
+		}
+		output, err := c.callStorage(call.CallData)
+		results[ci] = result{Success: err == nil, ReturnData: output}
+	}
+	return method.Outputs.Pack(results)
+}
+
+// Get the timestamp of a fake chain block
+func blockTime(blockNumber uint64) uint64 {
+	return fakeGenesisTime + blockNumber*fakeBlockInterval
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesTopics(query [][]common.Hash, topics []common.Hash) bool {
+	for ti, hashes := range query {
+		if len(hashes) == 0 {
+			continue
+		}
+		if ti >= len(topics) || !containsHash(hashes, topics[ti]) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsHash(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
@@ -2,13 +2,31 @@ package gogopool
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/multisig-labs/gogopool-go/gogopool"
+	"github.com/multisig-labs/gogopool-go/tests"
+	"github.com/multisig-labs/gogopool-go/tests/testutils/observer"
+	"github.com/multisig-labs/gogopool-go/tests/testutils/rpcserver"
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
 )
 
 func TestGetAddress(t *testing.T) {
+	tests.RequireNode(t)
 
 	// Get contract address
 	address1, err := ggp.GetAddress("rocketDepositPool")
@@ -29,6 +47,7 @@ func TestGetAddress(t *testing.T) {
 }
 
 func TestGetAddresses(t *testing.T) {
+	tests.RequireNode(t)
 
 	// Get contract addresses
 	addresses1, err := ggp.GetAddresses("rocketNodeManager", "rocketNodeDeposit")
@@ -57,6 +76,7 @@ func TestGetAddresses(t *testing.T) {
 }
 
 func TestGetABI(t *testing.T) {
+	tests.RequireNode(t)
 
 	// Get ABI
 	abi1, err := ggp.GetABI("rocketDepositPool")
@@ -85,6 +105,7 @@ func TestGetABI(t *testing.T) {
 }
 
 func TestGetABIs(t *testing.T) {
+	tests.RequireNode(t)
 
 	// Get ABIs
 	abis1, err := ggp.GetABIs("rocketNodeManager", "rocketNodeDeposit")
@@ -115,6 +136,7 @@ func TestGetABIs(t *testing.T) {
 }
 
 func TestGetContract(t *testing.T) {
+	tests.RequireNode(t)
 
 	// Get contract
 	if _, err := ggp.GetContract("rocketDepositPool"); err != nil {
@@ -129,6 +151,7 @@ func TestGetContract(t *testing.T) {
 }
 
 func TestGetContracts(t *testing.T) {
+	tests.RequireNode(t)
 
 	// Get contracts
 	if _, err := ggp.GetContracts("rocketNodeManager", "rocketNodeDeposit"); err != nil {
@@ -143,6 +166,7 @@ func TestGetContracts(t *testing.T) {
 }
 
 func TestMakeContract(t *testing.T) {
+	tests.RequireNode(t)
 
 	// Make contract
 	if _, err := ggp.MakeContract("rocketMinipool", common.HexToAddress("0x1111111111111111111111111111111111111111")); err != nil {
@@ -155,3 +179,1051 @@ func TestMakeContract(t *testing.T) {
 	}
 
 }
+
+func TestGetContractContextCancelled(t *testing.T) {
+
+	// Cancel context before loading an uncached contract
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Get contract
+	if _, err := ggp.GetContractContext(ctx, "rocketDAOProtocol"); err == nil {
+		t.Error("Contract was loaded with a cancelled context")
+	}
+
+}
+
+func TestMultiCallerFallback(t *testing.T) {
+	tests.RequireNode(t)
+
+	// Get contract addresses individually
+	addresses, err := ggp.GetAddresses("rocketNodeManager", "rocketNodeDeposit")
+	if err != nil {
+		t.Fatalf("Could not get contract addresses: %s", err)
+	}
+
+	// Queue contract address calls without a multicall contract configured
+	mc := ggp.NewMultiCaller()
+	multicallAddresses := make([]common.Address, 2)
+	for ci, contractName := range []string{"rocketNodeManager", "rocketNodeDeposit"} {
+		key := crypto.Keccak256Hash([]byte("contract.address"), []byte(contractName))
+		if err := mc.AddCall(ggp.GoGoStorageContract, &multicallAddresses[ci], "getAddress", key); err != nil {
+			t.Fatalf("Could not queue contract address call: %s", err)
+		}
+	}
+	if mc.Len() != 2 {
+		t.Errorf("Incorrect queued call count %d", mc.Len())
+	}
+
+	// Flush calls
+	if err := mc.Flush(nil); err != nil {
+		t.Fatalf("Could not flush multicaller: %s", err)
+	} else if mc.Len() != 0 {
+		t.Errorf("Multicaller was not cleared after flushing")
+	}
+	for ai := range addresses {
+		if !bytes.Equal(multicallAddresses[ai].Bytes(), addresses[ai].Bytes()) {
+			t.Errorf("Multicaller contract address %d did not match original contract address", ai)
+		}
+	}
+
+}
+
+func TestSyncCache(t *testing.T) {
+	tests.RequireNode(t)
+
+	// Initialize cache sync state
+	if err := ggp.SyncCache(); err != nil {
+		t.Fatalf("Could not initialize cache sync: %s", err)
+	}
+
+	// Load a contract and sync again with no upgrades
+	contract1, err := ggp.GetContract("rocketNodeManager")
+	if err != nil {
+		t.Fatalf("Could not get contract: %s", err)
+	}
+	if err := ggp.SyncCache(); err != nil {
+		t.Fatalf("Could not sync cache: %s", err)
+	}
+
+	// Check that the cached contract was retained
+	contract2, err := ggp.GetContract("rocketNodeManager")
+	if err != nil {
+		t.Fatalf("Could not get cached contract: %s", err)
+	} else if contract2 != contract1 {
+		t.Error("Cached contract was evicted without an upgrade")
+	}
+
+}
+
+func TestMultiCallerObserver(t *testing.T) {
+
+	// Create a contract manager on a fake chain with Multicall3, and observe its calls
+	chain := newFakeChain(t, 100)
+	managerAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	chain.setContract(t, "rocketNodeManager", managerAddress, "[]")
+	client := uc.NewEth1ClientProxy(0, chain.server.URL)
+	recorder := &observer.Recorder{}
+	client.SetObserver(recorder)
+	chainGgp, err := gogopool.NewGoGoPool(client, chain.storageAddress)
+	if err != nil {
+		t.Fatalf("Could not create contract manager: %s", err)
+	}
+	if err := chainGgp.SetMulticallAddress(gogopool.Multicall3Address); err != nil {
+		t.Fatalf("Could not set multicall address: %s", err)
+	}
+
+	// Queue a storage read & a read from a contract the multicall can't serve
+	other := &gogopool.Contract{
+		Contract: chainGgp.GoGoStorageContract.Contract,
+		Address:  &managerAddress,
+		ABI:      chainGgp.GoGoStorageContract.ABI,
+		Client:   client,
+		Name:     "rocketNodeManager",
+	}
+	key := crypto.Keccak256Hash([]byte("contract.address"), []byte("rocketNodeManager"))
+	var address common.Address
+	var otherAddress common.Address
+	mc := chainGgp.NewMultiCaller()
+	if err := mc.AddCall(chainGgp.GoGoStorageContract, &address, "getAddress", key); err != nil {
+		t.Fatal(err)
+	}
+	if err := mc.AddCall(other, &otherAddress, "getAddress", key); err != nil {
+		t.Fatal(err)
+	}
+	if err := mc.Flush(&bind.CallOpts{BlockNumber: big.NewInt(90)}); err == nil {
+		t.Fatal("Failed multicall was not reported")
+	}
+	if address != managerAddress {
+		t.Errorf("Incorrect address %s", address.Hex())
+	}
+
+	// Check each queued call was reported with its own outcome, along with the aggregate call
+	calls := recorder.CallsOfKind(uc.CallKindCall)
+	if len(calls) != 3 {
+		t.Fatalf("Incorrect contract call count %d: %+v", len(calls), calls)
+	}
+	reported := map[string]uc.CallInfo{}
+	for _, call := range calls {
+		reported[call.Contract+"."+call.Method] = call
+	}
+	if call, ok := reported["GoGoStorage.getAddress"]; !ok || call.Err != nil || call.Block != "90" {
+		t.Errorf("Incorrect storage call %+v", call)
+	}
+	if call, ok := reported["rocketNodeManager.getAddress"]; !ok || call.Err == nil {
+		t.Errorf("Incorrect failed call %+v", call)
+	}
+	if _, ok := reported["Multicall3.aggregate3"]; !ok {
+		t.Errorf("Aggregate call was not reported")
+	}
+
+}
+
+func TestSyncCacheUpgrade(t *testing.T) {
+
+	// Create a contract manager on a fake chain
+	chain := newFakeChain(t, 2500)
+	upgradeAddress := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	oldAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	newAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	chain.setContract(t, "rocketDAONodeTrustedUpgrade", upgradeAddress, upgradeContractAbi)
+	chain.setContract(t, "rocketNodeManager", oldAddress, "[]")
+	chainGgp, err := gogopool.NewGoGoPool(uc.NewEth1ClientProxy(0, chain.server.URL), chain.storageAddress)
+	if err != nil {
+		t.Fatalf("Could not create contract manager: %s", err)
+	}
+
+	// Initialize cache sync state and load a contract
+	if err := chainGgp.SyncCache(); err != nil {
+		t.Fatalf("Could not initialize cache sync: %s", err)
+	}
+	if contract, err := chainGgp.GetContract("rocketNodeManager"); err != nil {
+		t.Fatalf("Could not get contract: %s", err)
+	} else if *contract.Address != oldAddress {
+		t.Fatalf("Incorrect contract address %s", contract.Address.Hex())
+	}
+
+	// Upgrade the contract, more than one log interval after the sync block
+	chain.setContract(t, "rocketNodeManager", newAddress, "[]")
+	chain.addLog(types.Log{
+		Address:     upgradeAddress,
+		Topics:      []common.Hash{crypto.Keccak256Hash([]byte("ContractUpgraded(bytes32,address,address,uint256)")), crypto.Keccak256Hash([]byte("rocketNodeManager")), common.BytesToHash(oldAddress.Bytes()), common.BytesToHash(newAddress.Bytes())},
+		Data:        common.LeftPadBytes(big.NewInt(1).Bytes(), 32),
+		BlockNumber: 3400,
+	}, 2500+gogopool.DefaultLogIntervalSize+100)
+	if contract, err := chainGgp.GetContract("rocketNodeManager"); err != nil {
+		t.Fatalf("Could not get cached contract: %s", err)
+	} else if *contract.Address != oldAddress {
+		t.Error("Cached contract was evicted before syncing")
+	}
+
+	// Sync the cache
+	if err := chainGgp.SyncCache(); err != nil {
+		t.Fatalf("Could not sync cache: %s", err)
+	}
+	if contract, err := chainGgp.GetContract("rocketNodeManager"); err != nil {
+		t.Fatalf("Could not get upgraded contract: %s", err)
+	} else if *contract.Address != newAddress {
+		t.Errorf("Upgraded contract was not evicted, got address %s", contract.Address.Hex())
+	}
+
+	// Check the logs were queried in intervals covering the synced range
+	ranges := chain.getLogRanges()
+	if len(ranges) != 2 {
+		t.Fatalf("Incorrect log query count %d", len(ranges))
+	}
+	next := uint64(2501)
+	for _, r := range ranges {
+		if r[0] != next || r[1]-r[0]+1 > gogopool.DefaultLogIntervalSize {
+			t.Errorf("Incorrect log query range %d-%d", r[0], r[1])
+		}
+		next = r[1] + 1
+	}
+	if next != 2500+gogopool.DefaultLogIntervalSize+101 {
+		t.Errorf("Log queries ended at block %d", next-1)
+	}
+
+}
+
+func TestRPCFixture(t *testing.T) {
+
+	// Replay contract loading from a fixture, or re-record it against a fake chain in record mode
+	managerAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	storageAddress := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	mode := tests.RPCModeReplay
+	url := "http://127.0.0.1:0"
+	if tests.GetRPCMode() == tests.RPCModeRecord {
+		chain := newFakeChain(t, 100)
+		chain.setContract(t, "rocketNodeManager", managerAddress, upgradeContractAbi)
+		mode = tests.RPCModeRecord
+		url = chain.server.URL
+	}
+	session, err := tests.NewRPCSession(mode, "testdata/contracts.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainGgp, err := gogopool.NewGoGoPool(session.NewClient(url), storageAddress)
+	if err != nil {
+		t.Fatalf("Could not create contract manager: %s", err)
+	}
+
+	// Load a contract
+	contract, err := chainGgp.GetContract("rocketNodeManager")
+	if err != nil {
+		t.Fatalf("Could not get contract: %s", err)
+	}
+	if *contract.Address != managerAddress {
+		t.Errorf("Incorrect contract address %s", contract.Address.Hex())
+	}
+	if _, ok := contract.ABI.Events["ContractUpgraded"]; !ok {
+		t.Errorf("Incorrect contract ABI")
+	}
+
+	// Check every call was recorded
+	if err := session.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestGetBlockAtTime(t *testing.T) {
+	tests.RequireNode(t)
+
+	// Get the latest block
+	header, err := client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Could not get latest block header: %s", err)
+	}
+	blockTime := time.Unix(int64(header.Time), 0)
+
+	// Resolve the latest block time
+	blockNumber, err := ggp.GetBlockAtTime(blockTime)
+	if err != nil {
+		t.Fatalf("Could not get block at time: %s", err)
+	} else if blockNumber != header.Number.Uint64() {
+		t.Errorf("Incorrect block at time %d, expected %d", blockNumber, header.Number.Uint64())
+	}
+
+	// Get call options at the latest block time
+	opts, err := ggp.CallOptsAt(blockTime.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Could not get call options at time: %s", err)
+	} else if opts.BlockNumber.Uint64() < header.Number.Uint64() {
+		t.Errorf("Incorrect call options block %s", opts.BlockNumber.String())
+	}
+
+	// Resolve a time before the first block
+	if _, err := ggp.GetBlockAtTime(time.Unix(0, 0)); !errors.Is(err, gogopool.ErrBlockNotFound) {
+		t.Errorf("Incorrect error for a time before the first block: %v", err)
+	}
+
+}
+
+func TestBlockResolver(t *testing.T) {
+
+	// Create a resolver on a fake chain
+	chain := newFakeChain(t, 1000)
+	resolver := gogopool.NewBlockResolver(uc.NewEth1ClientProxy(0, chain.server.URL))
+
+	// Resolve a time between blocks
+	blockNumber, err := resolver.GetBlockAtTime(time.Unix(int64(blockTime(345)+1), 0))
+	if err != nil {
+		t.Fatalf("Could not get block at mid-chain time: %s", err)
+	} else if blockNumber != 345 {
+		t.Errorf("Incorrect block at mid-chain time %d, expected 345", blockNumber)
+	}
+
+	// Resolve the exact time of a block
+	blockNumber, err = resolver.GetBlockAtTime(time.Unix(int64(blockTime(678)), 0))
+	if err != nil {
+		t.Fatalf("Could not get block at block time: %s", err)
+	} else if blockNumber != 678 {
+		t.Errorf("Incorrect block at block time %d, expected 678", blockNumber)
+	}
+
+	// Resolve the exact time of the genesis block
+	blockNumber, err = resolver.GetBlockAtTime(time.Unix(fakeGenesisTime, 0))
+	if err != nil {
+		t.Fatalf("Could not get block at genesis time: %s", err)
+	} else if blockNumber != 0 {
+		t.Errorf("Incorrect block at genesis time %d, expected 0", blockNumber)
+	}
+
+	// Resolve a time between cached blocks after the head advances, which should only need the latest header
+	chain.setHead(1500)
+	requests := chain.server.Count("eth_getBlockByNumber")
+	blockNumber, err = resolver.GetBlockAtTime(time.Unix(int64(blockTime(678)+1), 0))
+	if err != nil {
+		t.Fatalf("Could not get block at cached time: %s", err)
+	} else if blockNumber != 678 {
+		t.Errorf("Incorrect block at cached time %d, expected 678", blockNumber)
+	}
+	if count := chain.server.Count("eth_getBlockByNumber") - requests; count != 1 {
+		t.Errorf("Incorrect header request count for a cached time %d, expected 1", count)
+	}
+
+	// Resolve a time before the genesis block
+	if _, err := resolver.GetBlockAtTime(time.Unix(fakeGenesisTime-1, 0)); !errors.Is(err, gogopool.ErrBlockNotFound) {
+		t.Errorf("Incorrect error for a time before the genesis block: %v", err)
+	}
+
+}
+
+func TestDiskCache(t *testing.T) {
+
+	// Create cache
+	path := filepath.Join(t.TempDir(), "cache.json")
+	cache1, err := gogopool.NewDiskCache(path)
+	if err != nil {
+		t.Fatalf("Could not create disk cache: %s", err)
+	}
+	if err := cache1.Set("1/0x00/abi/rocketNodeManager", gogopool.CacheEntry{Value: []byte("[]"), Time: 1}); err != nil {
+		t.Fatalf("Could not set disk cache entry: %s", err)
+	}
+
+	// Reload cache from disk
+	cache2, err := gogopool.NewDiskCache(path)
+	if err != nil {
+		t.Fatalf("Could not reload disk cache: %s", err)
+	}
+	if entry, ok := cache2.Get("1/0x00/abi/rocketNodeManager"); !ok {
+		t.Error("Disk cache entry was not persisted")
+	} else if string(entry.Value) != "[]" || entry.Time != 1 {
+		t.Errorf("Incorrect disk cache entry %s at %d", string(entry.Value), entry.Time)
+	}
+
+	// Delete entry
+	if err := cache2.Delete("1/0x00/abi/rocketNodeManager"); err != nil {
+		t.Fatalf("Could not delete disk cache entry: %s", err)
+	}
+	if len(cache2.Keys()) != 0 {
+		t.Error("Disk cache entry was not deleted")
+	}
+
+}
+
+func TestManifest(t *testing.T) {
+
+	// Save & load a manifest
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	manifest := &gogopool.Manifest{
+		StorageAddress: common.HexToAddress(tests.GoGoStorageAddress),
+		Contracts: map[string]gogopool.ManifestContract{
+			"rocketNodeManager": {
+				Address: common.HexToAddress("0x1111111111111111111111111111111111111111"),
+				ABI:     json.RawMessage(`[{"inputs":[],"name":"getNodeCount","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`),
+			},
+		},
+	}
+	if err := manifest.Save(path); err != nil {
+		t.Fatalf("Could not save manifest: %s", err)
+	}
+	manifest, err := gogopool.LoadManifest(path)
+	if err != nil {
+		t.Fatalf("Could not load manifest: %s", err)
+	}
+
+	// Create an offline contract manager from the manifest
+	offlineGgp, err := gogopool.NewGoGoPoolFromManifest(nil, manifest)
+	if err != nil {
+		t.Fatalf("Could not create contract manager from manifest: %s", err)
+	}
+
+	// Get a manifest contract and encode a call
+	contract, err := offlineGgp.GetContract("rocketNodeManager")
+	if err != nil {
+		t.Fatalf("Could not get manifest contract: %s", err)
+	} else if contract.Address.Hex() != "0x1111111111111111111111111111111111111111" {
+		t.Errorf("Incorrect manifest contract address %s", contract.Address.Hex())
+	}
+	if _, err := contract.ABI.Pack("getNodeCount"); err != nil {
+		t.Errorf("Could not encode manifest contract call: %s", err)
+	}
+
+	// Get a contract missing from the manifest
+	if _, err := offlineGgp.GetContract("rocketDepositPool"); err == nil {
+		t.Error("Contract missing from the manifest was loaded")
+	}
+
+}
+
+func TestManifestChecks(t *testing.T) {
+
+	// Create a contract manager on a fake chain
+	chain := newFakeChain(t, 100)
+	nodeManagerAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	chain.setContract(t, "rocketNodeManager", nodeManagerAddress, "[]")
+	chainClient := uc.NewEth1ClientProxy(0, chain.server.URL)
+	chainGgp, err := gogopool.NewGoGoPool(chainClient, chain.storageAddress)
+	if err != nil {
+		t.Fatalf("Could not create contract manager: %s", err)
+	}
+
+	// Export a registered contract and an unregistered contract
+	manifest, err := chainGgp.ExportManifest([]string{"rocketNodeManager"}, nil)
+	if err != nil {
+		t.Fatalf("Could not export manifest: %s", err)
+	} else if manifest.ChainID != 1337 || manifest.Contracts["rocketNodeManager"].Address != nodeManagerAddress {
+		t.Errorf("Incorrect manifest %+v", manifest)
+	}
+	if _, err := chainGgp.ExportManifest([]string{"rocketNodeManager", "rocketDepositPool"}, nil); !errors.Is(err, gogopool.ErrContractNotRegistered) {
+		t.Errorf("Incorrect unregistered contract error: %v", err)
+	}
+
+	// Load the manifest on the same chain and on another chain
+	if _, err := gogopool.NewGoGoPoolFromManifest(chainClient, manifest); err != nil {
+		t.Errorf("Could not load manifest on its chain: %s", err)
+	}
+	manifest.ChainID = 1
+	if _, err := gogopool.NewGoGoPoolFromManifest(chainClient, manifest); !errors.Is(err, gogopool.ErrChainMismatch) {
+		t.Errorf("Incorrect chain mismatch error: %v", err)
+	}
+	if _, err := gogopool.NewGoGoPoolFromManifest(nil, manifest); err != nil {
+		t.Errorf("Could not load manifest offline: %s", err)
+	}
+
+}
+
+func TestContractNames(t *testing.T) {
+
+	// Check default contract names
+	if name := ggp.ContractName(gogopool.ContractNodeManager); name != "rocketNodeManager" {
+		t.Errorf("Incorrect default node manager contract name %s", name)
+	}
+
+	// Create a contract manager with overridden contract names
+	customGgp, err := gogopool.NewGoGoPool(client, common.HexToAddress(tests.GoGoStorageAddress), gogopool.ContractNames{
+		gogopool.ContractNodeManager: "ggpNodeManager",
+	})
+	if err != nil {
+		t.Fatalf("Could not create contract manager: %s", err)
+	}
+
+	// Check contract names
+	if name := customGgp.ContractName(gogopool.ContractNodeManager); name != "ggpNodeManager" {
+		t.Errorf("Incorrect overridden node manager contract name %s", name)
+	}
+	if name := customGgp.ContractName(gogopool.ContractDepositPool); name != "rocketDepositPool" {
+		t.Errorf("Incorrect default deposit pool contract name %s", name)
+	}
+
+}
+
+func TestDecodeRevertData(t *testing.T) {
+
+	// Decode an Error(string) revert
+	reasonData := common.FromHex("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"000000000000000000000000000000000000000000000000000000000000000b" +
+		"6e6f7420616c6c6f776564000000000000000000000000000000000000000000")
+	revertErr := gogopool.DecodeRevertData(reasonData)
+	if revertErr.Name != gogopool.RevertErrorName {
+		t.Errorf("Incorrect revert error name %s", revertErr.Name)
+	}
+	if revertErr.Reason != "not allowed" {
+		t.Errorf("Incorrect revert reason %q", revertErr.Reason)
+	}
+
+	// Decode a Panic(uint256) revert
+	panicData := common.FromHex("0x4e487b71" + "0000000000000000000000000000000000000000000000000000000000000011")
+	revertErr = gogopool.DecodeRevertData(panicData)
+	if revertErr.Name != gogopool.RevertPanicName {
+		t.Errorf("Incorrect panic error name %s", revertErr.Name)
+	}
+	if revertErr.PanicCode == nil || revertErr.PanicCode.Uint64() != 0x11 {
+		t.Errorf("Incorrect panic code %s", revertErr.PanicCode)
+	}
+
+	// Decode a custom error
+	contractAbi, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"Unauthorized","inputs":[{"name":"caller","type":"address"}]}]`))
+	if err != nil {
+		t.Fatalf("Could not parse custom error ABI: %s", err)
+	}
+	caller := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	customError := contractAbi.Errors["Unauthorized"]
+	customData, err := customError.Inputs.Pack(caller)
+	if err != nil {
+		t.Fatalf("Could not encode custom error: %s", err)
+	}
+	customData = append(append([]byte{}, customError.ID[:4]...), customData...)
+	revertErr = gogopool.DecodeRevertData(customData, &contractAbi)
+	if revertErr.Name != "Unauthorized" {
+		t.Errorf("Incorrect custom error name %s", revertErr.Name)
+	} else if len(revertErr.Args) != 1 || revertErr.Args[0] != caller {
+		t.Errorf("Incorrect custom error args %v", revertErr.Args)
+	}
+
+}
+
+func TestErrorKinds(t *testing.T) {
+
+	// Create an offline contract manager
+	offlineGgp, err := gogopool.NewGoGoPoolFromManifest(nil, &gogopool.Manifest{
+		StorageAddress: common.HexToAddress(tests.GoGoStorageAddress),
+		Contracts: map[string]gogopool.ManifestContract{
+			"rocketNodeManager": {
+				Address: common.HexToAddress("0x1111111111111111111111111111111111111111"),
+				ABI:     json.RawMessage(`[{"inputs":[],"name":"getNodeCount","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Could not create contract manager from manifest: %s", err)
+	}
+
+	// Check unregistered contract errors
+	_, err = offlineGgp.GetContract("rocketDepositPool")
+	var notRegisteredErr *gogopool.ContractNotRegisteredError
+	if !errors.Is(err, gogopool.ErrContractNotRegistered) {
+		t.Errorf("Incorrect unregistered contract error kind: %v", err)
+	} else if !errors.As(err, &notRegisteredErr) || notRegisteredErr.Contract != "rocketDepositPool" {
+		t.Errorf("Incorrect unregistered contract error details: %v", err)
+	}
+
+	// Check missing method errors
+	contract, err := offlineGgp.GetContract("rocketNodeManager")
+	if err != nil {
+		t.Fatalf("Could not get manifest contract: %s", err)
+	}
+	var nodeCount interface{}
+	err = contract.Call(nil, &nodeCount, "getNodeAt")
+	var methodErr *gogopool.MethodNotFoundError
+	if !errors.Is(err, gogopool.ErrMethodNotFound) {
+		t.Errorf("Incorrect missing method error kind: %v", err)
+	} else if !errors.As(err, &methodErr) || methodErr.Method != "getNodeAt" || methodErr.Contract != "rocketNodeManager" {
+		t.Errorf("Incorrect missing method error details: %v", err)
+	}
+
+	// Check offline errors
+	var noClientErr *gogopool.NoClientError
+	if err := contract.Call(nil, &nodeCount, "getNodeCount"); !errors.Is(err, gogopool.ErrNoClient) {
+		t.Errorf("Incorrect offline call error kind: %v", err)
+	} else if !errors.As(err, &noClientErr) || noClientErr.Method != "getNodeCount" || noClientErr.Contract != "rocketNodeManager" {
+		t.Errorf("Incorrect offline call error details: %v", err)
+	}
+	if _, err := contract.Transact(&bind.TransactOpts{}, "getNodeCount"); !errors.Is(err, gogopool.ErrNoClient) {
+		t.Errorf("Incorrect offline transaction error kind: %v", err)
+	}
+	if _, err := contract.Simulate(&bind.TransactOpts{}, "getNodeCount"); !errors.Is(err, gogopool.ErrNoClient) {
+		t.Errorf("Incorrect offline simulation error kind: %v", err)
+	}
+
+	// Check revert errors
+	if revertErr := gogopool.DecodeRevertData(nil); !errors.Is(revertErr, gogopool.ErrReverted) {
+		t.Errorf("Incorrect revert error kind: %v", revertErr)
+	}
+
+}
+
+func TestGasCosts(t *testing.T) {
+
+	// Fee estimates
+	estimates := gogopool.FeeEstimates{
+		BaseFee: big.NewInt(25000000000),
+		Presets: map[gogopool.FeeSpeed]gogopool.FeePreset{
+			gogopool.FeeSpeedNormal: {
+				Speed:     gogopool.FeeSpeedNormal,
+				GasTipCap: big.NewInt(2000000000),
+				GasFeeCap: big.NewInt(52000000000),
+			},
+		},
+	}
+
+	// Get & check costs
+	costs := estimates.GetGasCosts(100000, 150000)
+	cost, ok := costs[gogopool.FeeSpeedNormal]
+	if !ok {
+		t.Fatal("Normal preset cost was not returned")
+	}
+	if cost.ExpectedCost.Cmp(big.NewInt(2700000000000000)) != 0 {
+		t.Errorf("Incorrect expected cost %s", cost.ExpectedCost.String())
+	}
+	if cost.MaxCost.Cmp(big.NewInt(7800000000000000)) != 0 {
+		t.Errorf("Incorrect max cost %s", cost.MaxCost.String())
+	}
+	if cost.MaxCostEth != 0.0078 {
+		t.Errorf("Incorrect max cost in AVAX %f", cost.MaxCostEth)
+	}
+
+}
+
+func TestFeeOracleMinBaseFee(t *testing.T) {
+
+	// Initialize a fee market with a base fee below the C-Chain minimum
+	chainId := big.NewInt(43114)
+	server := rpcserver.New(t)
+	server.Handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return (*hexutil.Big)(chainId), nil
+	})
+	server.Handle("eth_maxPriorityFeePerGas", func(params []json.RawMessage) (interface{}, error) {
+		return (*hexutil.Big)(big.NewInt(1000000000)), nil
+	})
+	server.Handle("eth_feeHistory", func(params []json.RawMessage) (interface{}, error) {
+		tip := (*hexutil.Big)(big.NewInt(1000000000))
+		return map[string]interface{}{
+			"oldestBlock":   hexutil.Uint64(1),
+			"baseFeePerGas": []*hexutil.Big{(*hexutil.Big)(big.NewInt(500000000)), (*hexutil.Big)(big.NewInt(500000000))},
+			"gasUsedRatio":  []float64{0.5},
+			"reward":        [][]*hexutil.Big{{tip, tip, tip}},
+		}, nil
+	})
+	oracle := gogopool.NewFeeOracle(uc.NewEth1ClientProxy(0, server.URL))
+
+	// The C-Chain floor applies on Avalanche chains only
+	for _, test := range []struct {
+		chainId int64
+		baseFee int64
+	}{
+		{43114, gogopool.AvalancheMinBaseFee},
+		{43113, gogopool.AvalancheMinBaseFee},
+		{1337, 500000000},
+	} {
+		chainId.SetInt64(test.chainId)
+		fees, err := oracle.GetFees()
+		if err != nil {
+			t.Fatalf("Could not get fees on chain %d: %s", test.chainId, err)
+		}
+		if fees.BaseFee.Int64() != test.baseFee {
+			t.Errorf("Incorrect base fee on chain %d: expected %d, got %s", test.chainId, test.baseFee, fees.BaseFee)
+		}
+	}
+
+	// An explicit floor overrides the chain's
+	oracle.MinBaseFee = big.NewInt(5000000000)
+	if fees, err := oracle.GetFees(); err != nil {
+		t.Fatal(err)
+	} else if fees.BaseFee.Int64() != 5000000000 {
+		t.Errorf("Incorrect base fee with an explicit floor %s", fees.BaseFee)
+	}
+
+}
+
+func TestUnsignedTransaction(t *testing.T) {
+
+	// Build an unsigned transaction
+	to := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	unsignedTx := &gogopool.UnsignedTransaction{
+		From:      common.HexToAddress("0x2222222222222222222222222222222222222222"),
+		ChainID:   (*hexutil.Big)(big.NewInt(43114)),
+		Nonce:     7,
+		To:        &to,
+		Value:     (*hexutil.Big)(big.NewInt(1000)),
+		Data:      common.FromHex("0x12345678"),
+		Gas:       100000,
+		GasTipCap: (*hexutil.Big)(big.NewInt(2000000000)),
+		GasFeeCap: (*hexutil.Big)(big.NewInt(52000000000)),
+	}
+
+	// Round trip through JSON
+	txJson, err := json.Marshal(unsignedTx)
+	if err != nil {
+		t.Fatalf("Could not encode unsigned transaction: %s", err)
+	}
+	parsedTx, err := gogopool.ParseUnsignedTransaction(txJson)
+	if err != nil {
+		t.Fatalf("Could not decode unsigned transaction: %s", err)
+	}
+	if parsedTx.SigningHash() != unsignedTx.SigningHash() {
+		t.Error("Decoded unsigned transaction does not match")
+	}
+
+	// Sign the transaction & check it matches the unsigned encoding
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signedTx, err := types.SignTx(parsedTx.Transaction(), types.LatestSignerForChainID(big.NewInt(43114)), privateKey)
+	if err != nil {
+		t.Fatalf("Could not sign transaction: %s", err)
+	}
+	unsignedRlp, err := unsignedTx.RLP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.Keccak256Hash(unsignedRlp) != unsignedTx.SigningHash() {
+		t.Error("Unsigned transaction encoding does not match the signing hash")
+	}
+	if signedTx.Nonce() != 7 || signedTx.Gas() != 100000 || *signedTx.To() != to || signedTx.GasFeeCap().Cmp(big.NewInt(52000000000)) != 0 {
+		t.Error("Signed transaction does not match the unsigned transaction")
+	}
+
+}
+
+func TestSafeBatch(t *testing.T) {
+
+	// Create an offline contract
+	contractAbi, err := abi.JSON(strings.NewReader(`[{"inputs":[{"name":"_settingContractName","type":"string"},{"name":"_settingPath","type":"string"},{"name":"_value","type":"uint256"}],"name":"bootstrapSettingUint","outputs":[],"stateMutability":"nonpayable","type":"function"}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	contractAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	contract := &gogopool.Contract{
+		Address: &contractAddress,
+		ABI:     &contractAbi,
+		Name:    "rocketDAOProtocol",
+	}
+
+	// Build a batch with a single call
+	safeAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	batch := gogopool.NewSafeBatch(big.NewInt(43114), safeAddress)
+	if err := batch.AddCall(contract, "bootstrapSettingUint", "rocketDAOProtocolSettingsNode", "node.minimum.stake", big.NewInt(1)); err != nil {
+		t.Fatalf("Could not add call to Safe batch: %s", err)
+	}
+	safeTx, err := batch.SafeTransaction(0)
+	if err != nil {
+		t.Fatal(err)
+	} else if safeTx.To != contractAddress || safeTx.Operation != gogopool.SafeOperationCall {
+		t.Error("Single call Safe transaction was not sent to the contract directly")
+	}
+
+	// Add a second call and check it is sent through MultiSend
+	if err := batch.AddCall(contract, "bootstrapSettingUint", "rocketDAOProtocolSettingsNode", "node.maximum.stake", big.NewInt(2)); err != nil {
+		t.Fatalf("Could not add call to Safe batch: %s", err)
+	}
+	safeTx, err = batch.SafeTransaction(5)
+	if err != nil {
+		t.Fatal(err)
+	} else if safeTx.To != batch.MultiSendAddress || safeTx.Operation != gogopool.SafeOperationDelegateCall {
+		t.Error("Multiple call Safe transaction was not sent through MultiSend")
+	}
+
+	// Check the Safe transaction hash depends on the nonce
+	nextSafeTx, err := batch.SafeTransaction(6)
+	if err != nil {
+		t.Fatal(err)
+	} else if nextSafeTx.Hash() == safeTx.Hash() {
+		t.Error("Safe transaction hash does not depend on the nonce")
+	}
+
+	// Check the Safe transaction hash against a known answer, computed with the EIP-712 typed data encoder
+	knownSafeTx := &gogopool.SafeTransaction{
+		ChainID:   (*hexutil.Big)(big.NewInt(43114)),
+		Safe:      safeAddress,
+		To:        contractAddress,
+		Value:     (*hexutil.Big)(big.NewInt(1000000000000000000)),
+		Data:      hexutil.MustDecode("0xdeadbeef"),
+		Operation: gogopool.SafeOperationCall,
+		SafeTxGas: (*hexutil.Big)(big.NewInt(0)),
+		BaseGas:   (*hexutil.Big)(big.NewInt(0)),
+		GasPrice:  (*hexutil.Big)(big.NewInt(0)),
+		Nonce:     7,
+	}
+	if hash := knownSafeTx.Hash().Hex(); hash != "0x22ccea9a9a2229944c02588c7203ce6cd783d2cb2509f638bfa853e668d4f8f6" {
+		t.Errorf("Incorrect Safe transaction hash %s", hash)
+	}
+
+	// Check a call without a value is treated as a zero-value call
+	valuelessBatch := gogopool.NewSafeBatch(big.NewInt(43114), safeAddress)
+	valuelessBatch.Transactions = append(valuelessBatch.Transactions, gogopool.SafeBatchTransaction{To: contractAddress})
+	if valuelessSafeTx, err := valuelessBatch.SafeTransaction(0); err != nil {
+		t.Fatal(err)
+	} else if hash := valuelessSafeTx.Hash().Hex(); hash != "0xcdd63914b08e410779e0e23d9497a67d29ab4559ce525abf81888a5e429fae3d" {
+		t.Errorf("Incorrect zero-value Safe transaction hash %s", hash)
+	}
+	valuelessBatch.Transactions = append(valuelessBatch.Transactions, gogopool.SafeBatchTransaction{To: contractAddress})
+	if _, err := valuelessBatch.SafeTransaction(1); err != nil {
+		t.Errorf("Could not build MultiSend transaction for calls without values: %s", err)
+	}
+
+	// Check the Transaction Builder output
+	builderJson, err := batch.BuilderJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var builderBatch struct {
+		ChainID      string `json:"chainId"`
+		Transactions []struct {
+			To   string `json:"to"`
+			Data string `json:"data"`
+		} `json:"transactions"`
+	}
+	if err := json.Unmarshal(builderJson, &builderBatch); err != nil {
+		t.Fatalf("Could not decode Transaction Builder batch: %s", err)
+	}
+	if builderBatch.ChainID != "43114" || len(builderBatch.Transactions) != 2 {
+		t.Errorf("Incorrect Transaction Builder batch %s", string(builderJson))
+	}
+
+	// Check calls to missing methods are rejected
+	if err := batch.AddCall(contract, "bootstrapSettingBool", "rocketDAOProtocolSettingsNode", "node.registration.enabled", true); !errors.Is(err, gogopool.ErrMethodNotFound) {
+		t.Errorf("Incorrect missing method error: %v", err)
+	}
+
+}
+
+func TestDecodeReceipt(t *testing.T) {
+
+	// Create an offline contract manager
+	nodeManagerAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	offlineGgp, err := gogopool.NewGoGoPoolFromManifest(nil, &gogopool.Manifest{
+		StorageAddress: common.HexToAddress(tests.GoGoStorageAddress),
+		Contracts: map[string]gogopool.ManifestContract{
+			"rocketNodeManager": {
+				Address: nodeManagerAddress,
+				ABI:     json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"node","type":"address"},{"indexed":false,"internalType":"uint256","name":"time","type":"uint256"}],"name":"NodeRegistered","type":"event"}]`),
+			},
+			"rocketMinipool": {
+				ABI: json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint8","name":"status","type":"uint8"},{"indexed":false,"internalType":"uint256","name":"time","type":"uint256"}],"name":"StatusUpdated","type":"event"}]`),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Could not create contract manager from manifest: %s", err)
+	}
+
+	// Build a receipt with a registered contract log, a minipool log and an unknown log
+	nodeAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	minipoolAddress := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	receipt := &types.Receipt{Logs: []*types.Log{
+		{
+			Address: nodeManagerAddress,
+			Topics:  []common.Hash{crypto.Keccak256Hash([]byte("NodeRegistered(address,uint256)")), common.BytesToHash(nodeAddress.Bytes())},
+			Data:    common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+			Index:   0,
+		},
+		{
+			Address: minipoolAddress,
+			Topics:  []common.Hash{crypto.Keccak256Hash([]byte("StatusUpdated(uint8,uint256)")), common.BigToHash(big.NewInt(2))},
+			Data:    common.LeftPadBytes(big.NewInt(200).Bytes(), 32),
+			Index:   1,
+		},
+		{
+			Address: minipoolAddress,
+			Topics:  []common.Hash{crypto.Keccak256Hash([]byte("Unknown()"))},
+			Index:   2,
+		},
+		{
+			Address: minipoolAddress,
+			Topics:  []common.Hash{crypto.Keccak256Hash([]byte("NodeRegistered(address,uint256)")), common.BytesToHash(nodeAddress.Bytes())},
+			Data:    common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+			Index:   3,
+		},
+	}}
+
+	// Decode the receipt
+	events, err := offlineGgp.DecodeReceipt(receipt)
+	if err != nil {
+		t.Fatalf("Could not decode receipt: %s", err)
+	}
+	if len(events) != 4 {
+		t.Fatalf("Incorrect decoded event count %d", len(events))
+	}
+	if events[0].Contract != "rocketNodeManager" || events[0].Event != "NodeRegistered" {
+		t.Errorf("Incorrect registered contract event %s.%s", events[0].Contract, events[0].Event)
+	} else if events[0].Args["node"] != nodeAddress || events[0].Args["time"].(*big.Int).Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("Incorrect registered contract event args %v", events[0].Args)
+	}
+	if events[1].Contract != "rocketMinipool" || events[1].Event != "StatusUpdated" || events[1].Address != minipoolAddress {
+		t.Errorf("Incorrect minipool event %s.%s", events[1].Contract, events[1].Event)
+	} else if events[1].Args["status"] != uint8(2) || events[1].Args["time"].(*big.Int).Cmp(big.NewInt(200)) != 0 {
+		t.Errorf("Incorrect minipool event args %v", events[1].Args)
+	}
+	if events[2].Contract != "" || events[2].Event != "" || events[2].LogIndex != 2 {
+		t.Errorf("Unknown log was decoded as %s.%s", events[2].Contract, events[2].Event)
+	}
+	if events[3].Contract != "" || events[3].Event != "" {
+		t.Errorf("Log from another address was decoded against a registered contract as %s.%s", events[3].Contract, events[3].Event)
+	}
+
+}
+
+func TestDecodeReceiptHistory(t *testing.T) {
+
+	// Create a contract manager on a fake chain
+	chain := newFakeChain(t, 2500)
+	oldUpgradeAddress := common.HexToAddress("0x00000000000000000000000000000000000000ba")
+	upgradeAddress := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	spoofAddress := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	firstNodeManagerAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	secondNodeManagerAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	nodeManagerAddress := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	spoofedNodeManagerAddress := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	chain.setContract(t, "rocketDAONodeTrustedUpgrade", upgradeAddress, upgradeContractAbi)
+	chain.setContract(t, "rocketNodeManager", nodeManagerAddress, `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"node","type":"address"},{"indexed":false,"internalType":"uint256","name":"time","type":"uint256"}],"name":"NodeRegistered","type":"event"}]`)
+	chainGgp, err := gogopool.NewGoGoPool(uc.NewEth1ClientProxy(0, chain.server.URL), chain.storageAddress)
+	if err != nil {
+		t.Fatalf("Could not create contract manager: %s", err)
+	}
+	if err := chainGgp.SyncCache(); err != nil {
+		t.Fatalf("Could not sync cache: %s", err)
+	}
+
+	// Upgrade the node manager with the old upgrade contract, upgrade the upgrade contract, then upgrade the node manager again
+	// A contract claiming to be a past upgrade contract without being replaced by a known one is ignored
+	upgradeLog := func(emitter common.Address, contractName string, oldAddress common.Address, newAddress common.Address, block uint64) types.Log {
+		return types.Log{
+			Address: emitter,
+			Topics: []common.Hash{
+				crypto.Keccak256Hash([]byte("ContractUpgraded(bytes32,address,address,uint256)")),
+				crypto.Keccak256Hash([]byte(contractName)),
+				common.BytesToHash(oldAddress.Bytes()),
+				common.BytesToHash(newAddress.Bytes()),
+			},
+			Data:        common.LeftPadBytes(big.NewInt(int64(block)).Bytes(), 32),
+			BlockNumber: block,
+		}
+	}
+	chain.addLog(upgradeLog(oldUpgradeAddress, "rocketNodeManager", firstNodeManagerAddress, secondNodeManagerAddress, 500), 2500)
+	chain.addLog(upgradeLog(oldUpgradeAddress, "rocketDAONodeTrustedUpgrade", oldUpgradeAddress, upgradeAddress, 1000), 2500)
+	chain.addLog(upgradeLog(upgradeAddress, "rocketNodeManager", secondNodeManagerAddress, nodeManagerAddress, 1500), 2500)
+	chain.addLog(upgradeLog(spoofAddress, "rocketDAONodeTrustedUpgrade", spoofAddress, common.HexToAddress("0x00000000000000000000000000000000000000dd"), 2000), 2500)
+	chain.addLog(upgradeLog(spoofAddress, "rocketNodeManager", spoofedNodeManagerAddress, nodeManagerAddress, 2000), 2500)
+
+	// Decode a receipt with logs from every node manager version twice
+	receipt := &types.Receipt{Logs: []*types.Log{}}
+	for _, address := range []common.Address{firstNodeManagerAddress, secondNodeManagerAddress, nodeManagerAddress, spoofedNodeManagerAddress} {
+		receipt.Logs = append(receipt.Logs, &types.Log{
+			Address: address,
+			Topics:  []common.Hash{crypto.Keccak256Hash([]byte("NodeRegistered(address,uint256)")), {}},
+			Data:    common.LeftPadBytes(big.NewInt(100).Bytes(), 32),
+		})
+	}
+	for i := 0; i < 2; i++ {
+		events, err := chainGgp.DecodeReceipt(receipt)
+		if err != nil {
+			t.Fatalf("Could not decode receipt: %s", err)
+		}
+		for _, event := range events[:3] {
+			if event.Contract != "rocketNodeManager" || event.Event != "NodeRegistered" {
+				t.Errorf("Incorrect decoded event at %s: %s.%s", event.Address.Hex(), event.Contract, event.Event)
+			}
+		}
+		if events[3].Contract != "" {
+			t.Errorf("Log from a spoofed upgrade was decoded as %s.%s", events[3].Contract, events[3].Event)
+		}
+	}
+
+	// Check the upgrade history was scanned once, in log intervals: for past upgrade contracts until none are found, then for their upgrade logs
+	ranges := chain.getLogRanges()
+	if len(ranges) != 9 {
+		t.Fatalf("Incorrect log query count %d", len(ranges))
+	}
+	for _, r := range ranges {
+		if r[1]-r[0]+1 > gogopool.DefaultLogIntervalSize {
+			t.Errorf("Log query range %d-%d is larger than the log interval", r[0], r[1])
+		}
+	}
+
+	// Upgrade the node manager again and sync the cache, which discards the decoder
+	newNodeManagerAddress := common.HexToAddress("0x5555555555555555555555555555555555555555")
+	chain.setContract(t, "rocketNodeManager", newNodeManagerAddress, `[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"node","type":"address"},{"indexed":false,"internalType":"uint256","name":"time","type":"uint256"}],"name":"NodeRegistered","type":"event"}]`)
+	chain.addLog(upgradeLog(upgradeAddress, "rocketNodeManager", nodeManagerAddress, newNodeManagerAddress, 2600), 2700)
+	if err := chainGgp.SyncCache(); err != nil {
+		t.Fatalf("Could not sync cache: %s", err)
+	}
+	syncRanges := len(chain.getLogRanges())
+
+	// Decode the receipt again, with the node manager now a past version
+	events, err := chainGgp.DecodeReceipt(receipt)
+	if err != nil {
+		t.Fatalf("Could not decode receipt: %s", err)
+	}
+	for _, event := range events[:3] {
+		if event.Contract != "rocketNodeManager" || event.Event != "NodeRegistered" {
+			t.Errorf("Incorrect decoded event at %s after upgrade: %s.%s", event.Address.Hex(), event.Contract, event.Event)
+		}
+	}
+
+	// Check the upgrade history was only extended over the new blocks
+	ranges = chain.getLogRanges()[syncRanges:]
+	if len(ranges) != 1 || ranges[0] != [2]uint64{2501, 2700} {
+		t.Errorf("Incorrect log query ranges extending the upgrade history %v", ranges)
+	}
+
+}
+
+func TestGetTransactionEventArgs(t *testing.T) {
+
+	// Create an offline contract manager
+	auctionManagerAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	offlineGgp, err := gogopool.NewGoGoPoolFromManifest(nil, &gogopool.Manifest{
+		StorageAddress: common.HexToAddress(tests.GoGoStorageAddress),
+		Contracts: map[string]gogopool.ManifestContract{
+			"rocketAuctionManager": {
+				Address: auctionManagerAddress,
+				ABI:     json.RawMessage(`[{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint256","name":"lotIndex","type":"uint256"},{"indexed":true,"internalType":"address","name":"by","type":"address"},{"indexed":false,"internalType":"uint256","name":"ggpAmount","type":"uint256"},{"indexed":false,"internalType":"uint256","name":"time","type":"uint256"}],"name":"LotCreated","type":"event"}]`),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Could not create contract manager from manifest: %s", err)
+	}
+	contract, err := offlineGgp.GetContract("rocketAuctionManager")
+	if err != nil {
+		t.Fatalf("Could not get manifest contract: %s", err)
+	}
+
+	// Build a receipt with a matching log and a log from another address
+	lotCreatedId := crypto.Keccak256Hash([]byte("LotCreated(uint256,address,uint256,uint256)"))
+	data := append(common.LeftPadBytes(big.NewInt(1000).Bytes(), 32), common.LeftPadBytes(big.NewInt(100).Bytes(), 32)...)
+	receipt := &types.Receipt{Logs: []*types.Log{
+		{
+			Address: common.HexToAddress("0x2222222222222222222222222222222222222222"),
+			Topics:  []common.Hash{lotCreatedId, common.BigToHash(big.NewInt(9)), {}},
+			Data:    data,
+		},
+		{
+			Address: auctionManagerAddress,
+			Topics:  []common.Hash{lotCreatedId, common.BigToHash(big.NewInt(3)), {}},
+			Data:    data,
+		},
+	}}
+
+	// Get event args
+	events, err := contract.GetTransactionEventArgs(receipt, "LotCreated")
+	if err != nil {
+		t.Fatalf("Could not get transaction event args: %s", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Incorrect event count %d", len(events))
+	}
+	if lotIndex, ok := events[0]["lotIndex"].(*big.Int); !ok || lotIndex.Uint64() != 3 {
+		t.Errorf("Incorrect lot index %v", events[0]["lotIndex"])
+	}
+
+	// Check missing events
+	if _, err := contract.GetTransactionEventArgs(&types.Receipt{}, "LotCreated"); !errors.Is(err, gogopool.ErrEventNotFound) {
+		t.Errorf("Incorrect missing event error: %v", err)
+	}
+
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/multisig-labs/gogopool-go/minipool"
 	"github.com/multisig-labs/gogopool-go/network"
 	"github.com/multisig-labs/gogopool-go/node"
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	minipoolutils "github.com/multisig-labs/gogopool-go/tests/testutils/minipool"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
@@ -25,6 +26,7 @@ import (
 )
 
 func TestDetails(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -148,6 +150,7 @@ func TestDetails(t *testing.T) {
 }
 
 func TestRefund(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -199,6 +202,7 @@ func TestRefund(t *testing.T) {
 }
 
 func TestStake(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -271,6 +275,7 @@ func TestStake(t *testing.T) {
 }
 
 func TestDissolve(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -315,6 +320,7 @@ func TestDissolve(t *testing.T) {
 }
 
 func TestClose(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -379,6 +385,7 @@ func TestFinalise(t *testing.T) {
 }
 
 func TestWithdrawValidatorBalance(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -497,6 +504,7 @@ func TestWithdrawValidatorBalance(t *testing.T) {
 }
 
 func TestWithdrawValidatorBalanceAndFinalise(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -610,6 +618,7 @@ func TestWithdrawValidatorBalanceAndFinalise(t *testing.T) {
 }
 
 func TestDelegateUpgradeAndRollback(t *testing.T) {
+	tests.RequireNode(t)
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
 		t.Fatal(err)
@@ -700,6 +709,7 @@ func TestDelegateUpgradeAndRollback(t *testing.T) {
 }
 
 func TestUseLatestDelegate(t *testing.T) {
+	tests.RequireNode(t)
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
 		t.Fatal(err)
@@ -12,6 +12,7 @@ import (
 	"github.com/multisig-labs/gogopool-go/node"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	minipoolutils "github.com/multisig-labs/gogopool-go/tests/testutils/minipool"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
@@ -19,6 +20,7 @@ import (
 )
 
 func TestMinipoolDetails(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -9,12 +9,14 @@ import (
 	"github.com/multisig-labs/gogopool-go/node"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	minipoolutils "github.com/multisig-labs/gogopool-go/tests/testutils/minipool"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
 )
 
 func TestQueueLengths(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -127,6 +129,7 @@ func TestQueueLengths(t *testing.T) {
 }
 
 func TestQueueCapacity(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
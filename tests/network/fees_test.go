@@ -8,10 +8,12 @@ import (
 	"github.com/multisig-labs/gogopool-go/network"
 	"github.com/multisig-labs/gogopool-go/settings/protocol"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 )
 
 func TestNodeFee(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -7,12 +7,14 @@ import (
 	"github.com/multisig-labs/gogopool-go/node"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	minipoolutils "github.com/multisig-labs/gogopool-go/tests/testutils/minipool"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
 )
 
 func TestDeposit(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -45,7 +47,7 @@ func TestDeposit(t *testing.T) {
 	}
 
 	// Deposit
-	if _, _, err := nodeutils.Deposit(t, ggp, nodeAccount, avax.EthToWei(16), 1); err != nil {
+	if _, err := nodeutils.Deposit(t, ggp, nodeAccount, avax.EthToWei(16), 1); err != nil {
 		t.Fatal(err)
 	}
 
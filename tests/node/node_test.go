@@ -9,10 +9,12 @@ import (
 	"github.com/multisig-labs/gogopool-go/node"
 	"github.com/multisig-labs/gogopool-go/storage"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 )
 
 func TestRegisterNode(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -40,8 +42,10 @@ func TestRegisterNode(t *testing.T) {
 
 	// Register node
 	timezoneLocation := "Australia/Brisbane"
-	if _, err := node.RegisterNode(ggp, timezoneLocation, nodeAccount.GetTransactor()); err != nil {
+	if result, err := node.RegisterNodeAndWait(ggp, timezoneLocation, nodeAccount.GetTransactor()); err != nil {
 		t.Fatal(err)
+	} else if result.RegistrationTime.Unix() <= 0 || result.TxHash == (common.Hash{}) || result.GasUsed == 0 {
+		t.Errorf("Incorrect node registration result %+v", result)
 	}
 
 	// Get & check updated node details
@@ -68,6 +72,7 @@ func TestRegisterNode(t *testing.T) {
 }
 
 func TestSetWithdrawalAddress(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -100,6 +105,7 @@ func TestSetWithdrawalAddress(t *testing.T) {
 }
 
 func TestSetWithdrawalAddressConfirmation(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -137,6 +143,7 @@ func TestSetWithdrawalAddressConfirmation(t *testing.T) {
 }
 
 func TestSetTimezoneLocation(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
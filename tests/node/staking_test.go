@@ -13,6 +13,7 @@ import (
 	"github.com/multisig-labs/gogopool-go/tokens"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	minipoolutils "github.com/multisig-labs/gogopool-go/tests/testutils/minipool"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
@@ -20,6 +21,7 @@ import (
 )
 
 func TestStakeGGP(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -138,11 +140,11 @@ func TestStakeGGP(t *testing.T) {
 	}
 
 	// Make node deposit to create minipool
-	minipoolAddress, _, err := nodeutils.Deposit(t, ggp, nodeAccount, avax.EthToWei(16), 1)
+	depositResult, err := nodeutils.Deposit(t, ggp, nodeAccount, avax.EthToWei(16), 1)
 	if err != nil {
 		t.Fatal(err)
 	}
-	mp, err := minipool.NewMinipool(ggp, minipoolAddress)
+	mp, err := minipool.NewMinipool(ggp, depositResult.MinipoolAddress)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -189,6 +191,7 @@ func TestStakeGGP(t *testing.T) {
 }
 
 func TestWithdrawGGP(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/multisig-labs/gogopool-go/rewards"
 	"github.com/multisig-labs/gogopool-go/settings/protocol"
 	"github.com/multisig-labs/gogopool-go/settings/trustednode"
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	minipoolutils "github.com/multisig-labs/gogopool-go/tests/testutils/minipool"
 	"github.com/multisig-labs/gogopool-go/tokens"
@@ -18,6 +19,7 @@ import (
 )
 
 func TestNodeRewards(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// Test RPC settings
+const (
+	RPCModeEnv        = "GOGOPOOL_TEST_RPC"     // "record" to record calls to a fixture, "replay" to answer them from it, or unset to use the node directly
+	RPCFixtureEnv     = "GOGOPOOL_TEST_FIXTURE" // The fixture file path, overriding DefaultRPCFixture
+	DefaultRPCFixture = "testdata/rpc.json"     // Relative to the test package directory
+)
+
+// Test RPC modes
+const (
+	RPCModeLive   = "live"
+	RPCModeRecord = "record"
+	RPCModeReplay = "replay"
+)
+
+// The RPC session shared by a test package
+var (
+	rpcSession     *RPCSession
+	rpcSessionErr  error
+	rpcSessionOnce sync.Once
+)
+
+// Routes test RPC calls to a node, recording them to a fixture or replaying them from it
+// Replay sessions for packages without a fixture are skipped: they fail every call, and tests which need the node skip themselves with RequireNode
+type RPCSession struct {
+	Mode      string
+	Fixture   string
+	Skipped   bool
+	Transport http.RoundTripper
+	recorder  *uc.RPCRecorder
+	replayer  *uc.RPCReplayer
+}
+
+// Get the RPC mode set by the environment
+func GetRPCMode() string {
+	mode := strings.ToLower(os.Getenv(RPCModeEnv))
+	if mode == "" {
+		return RPCModeLive
+	}
+	return mode
+}
+
+// Get the RPC session shared by a test package, in the mode & for the fixture set by the environment
+func GetRPCSession() (*RPCSession, error) {
+	rpcSessionOnce.Do(func() {
+		fixture := os.Getenv(RPCFixtureEnv)
+		if fixture == "" {
+			fixture = DefaultRPCFixture
+		}
+		rpcSession, rpcSessionErr = NewRPCSession(GetRPCMode(), fixture)
+	})
+	return rpcSession, rpcSessionErr
+}
+
+// Start an RPC session
+// Live sessions use the node directly, record sessions use the node and save its calls to the fixture on Close, and replay sessions answer calls from the fixture without a network
+func NewRPCSession(mode string, fixture string) (*RPCSession, error) {
+	session := &RPCSession{Mode: mode, Fixture: fixture}
+	switch mode {
+	case RPCModeLive:
+	case RPCModeRecord:
+		session.recorder = uc.NewRPCRecorder(nil)
+		session.Transport = session.recorder
+	case RPCModeReplay:
+		if _, err := os.Stat(fixture); os.IsNotExist(err) {
+			session.Skipped = true
+			session.Transport = missingFixtureTransport{fixture: fixture}
+			break
+		}
+		replayer, err := uc.LoadRPCReplayer(fixture)
+		if err != nil {
+			return nil, err
+		}
+		session.replayer = replayer
+		session.Transport = replayer
+	default:
+		return nil, fmt.Errorf("Unknown test RPC mode '%s'", mode)
+	}
+	return session, nil
+}
+
+// Get the message logged when tests which need the node are skipped
+func (s *RPCSession) SkipMessage() string {
+	return fmt.Sprintf("Skipping tests which need the node: no RPC fixture to replay at %s", s.Fixture)
+}
+
+// Skip a test which needs the node if the shared RPC session has no fixture to replay
+func RequireNode(t testing.TB) {
+	t.Helper()
+	session, err := GetRPCSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Skipped {
+		t.Skip(session.SkipMessage())
+	}
+}
+
+// Create a client proxy whose calls go through the session
+func (s *RPCSession) NewClient(urls ...string) *uc.EthClientProxy {
+	if s.Transport == nil {
+		return uc.NewEth1ClientProxy(0, urls...)
+	}
+	return uc.NewEth1ClientProxyWithTransport(0, s.Transport, urls...)
+}
+
+// Dial an RPC client whose calls go through the session, e.g. for node-specific calls such as evm_mine
+func (s *RPCSession) Dial(url string) (*rpc.Client, error) {
+	if s.Transport == nil {
+		return rpc.Dial(url)
+	}
+	return rpc.DialHTTPWithClient(url, &http.Client{Transport: s.Transport})
+}
+
+// Finish the session, saving the recorded calls or checking that every replayed call had a recording
+func (s *RPCSession) Close() error {
+	if s.recorder != nil {
+		if err := os.MkdirAll(filepath.Dir(s.Fixture), 0755); err != nil {
+			return fmt.Errorf("Could not create RPC fixture directory: %w", err)
+		}
+		return s.recorder.Save(s.Fixture)
+	}
+	if s.replayer != nil {
+		if misses := s.replayer.Misses(); len(misses) > 0 {
+			return fmt.Errorf("%d calls had no recording in RPC fixture %s, starting with %s", len(misses), s.Fixture, misses[0])
+		}
+	}
+	return nil
+}
+
+// Fails every call, for replay sessions without a fixture
+type missingFixtureTransport struct {
+	fixture string
+}
+
+func (t missingFixtureTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("No RPC fixture to replay at %s", t.fixture)
+}
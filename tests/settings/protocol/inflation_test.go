@@ -6,10 +6,12 @@ import (
 
 	"github.com/multisig-labs/gogopool-go/settings/protocol"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 )
 
 func TestInflationSettings(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -0,0 +1,66 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/multisig-labs/gogopool-go/settings"
+	"github.com/multisig-labs/gogopool-go/settings/protocol"
+
+	"github.com/multisig-labs/gogopool-go/tests"
+	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
+)
+
+func TestLoadAllSettings(t *testing.T) {
+	tests.RequireNode(t)
+
+	// State snapshotting
+	if err := evm.TakeSnapshot(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := evm.RevertSnapshot(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// Load settings before the change
+	before, err := settings.LoadAllSettings(ggp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Change a setting
+	lotDuration := before.Protocol.Auction.LotDuration + 1
+	if _, err := protocol.BootstrapLotDuration(ggp, lotDuration, ownerAccount.GetTransactor()); err != nil {
+		t.Fatal(err)
+	}
+	currentBlock, err := client.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Load settings after the change
+	after, err := settings.LoadAllSettings(ggp, nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if after.Block != currentBlock {
+		t.Errorf("Incorrect settings block %d", after.Block)
+	} else if after.Protocol.Auction.LotDuration != lotDuration {
+		t.Errorf("Incorrect lot duration %d", after.Protocol.Auction.LotDuration)
+	}
+
+	// Check the changes between blocks
+	changes, err := settings.CompareBlocks(ggp, before.Block, after.Block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("Incorrect change count %d: %v", len(changes), changes)
+	}
+	if changes[0].Group != "protocol.auction" || changes[0].Setting != "lotDuration" || fmt.Sprint(changes[0].New) != fmt.Sprint(lotDuration) {
+		t.Errorf("Incorrect change %v", changes[0])
+	}
+
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/multisig-labs/gogopool-go/settings/trustednode"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/accounts"
 	daoutils "github.com/multisig-labs/gogopool-go/tests/testutils/dao"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
@@ -13,6 +14,7 @@ import (
 )
 
 func TestBootstrapMembersSettings(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -57,6 +59,7 @@ func TestBootstrapMembersSettings(t *testing.T) {
 }
 
 func TestProposeMembersSettings(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/multisig-labs/gogopool-go/settings/trustednode"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/accounts"
 	daoutils "github.com/multisig-labs/gogopool-go/tests/testutils/dao"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
@@ -12,6 +13,7 @@ import (
 )
 
 func TestBootstrapProposalsSettings(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -76,6 +78,7 @@ func TestBootstrapProposalsSettings(t *testing.T) {
 }
 
 func TestProposeProposalsSettings(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -0,0 +1,89 @@
+package snapshot
+
+import (
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/multisig-labs/gogopool-go/auction"
+	"github.com/multisig-labs/gogopool-go/node"
+	"github.com/multisig-labs/gogopool-go/snapshot"
+)
+
+func TestCompare(t *testing.T) {
+
+	// Build snapshots
+	nodeAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bond, _ := new(big.Int).SetString("123456789012345678901", 10)
+	from := &snapshot.Snapshot{
+		Version: snapshot.Version,
+		Block:   100,
+		Nodes: []snapshot.NodeState{{
+			NodeDetails: node.NodeDetails{Address: nodeAddress, Exists: true, TimezoneLocation: "Etc/UTC"},
+			GGPStake:    bond,
+		}},
+		Lots: []auction.LotDetails{{Index: 0, Exists: true}},
+	}
+
+	from.Settings.Protocol.Auction.LotDuration = 40320
+
+	// Save & load the first snapshot
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	if err := from.Save(path); err != nil {
+		t.Fatalf("Could not save snapshot: %s", err)
+	}
+	loaded, err := snapshot.Load(path)
+	if err != nil {
+		t.Fatalf("Could not load snapshot: %s", err)
+	}
+	if loaded.Nodes[0].GGPStake.Cmp(bond) != 0 {
+		t.Errorf("Incorrect loaded node GGP stake %s", loaded.Nodes[0].GGPStake.String())
+	}
+
+	// A loaded snapshot has no changes from the original
+	if diff, err := snapshot.Compare(from, loaded); err != nil {
+		t.Fatalf("Could not compare snapshots: %s", err)
+	} else if len(diff.Changes) != 0 {
+		t.Errorf("Loaded snapshot has changes: %v", diff.Changes)
+	}
+
+	// Change a setting & a node, remove a lot and add a lot
+	to := &snapshot.Snapshot{
+		Version: snapshot.Version,
+		Block:   200,
+		Nodes: []snapshot.NodeState{{
+			NodeDetails: node.NodeDetails{Address: nodeAddress, Exists: true, TimezoneLocation: "Australia/Brisbane"},
+			GGPStake:    bond,
+		}},
+		Lots: []auction.LotDetails{{Index: 1, Exists: true}},
+	}
+	to.Settings.Protocol.Auction.LotDuration = 50000
+	diff, err := snapshot.Compare(loaded, to)
+	if err != nil {
+		t.Fatalf("Could not compare snapshots: %s", err)
+	}
+	if diff.FromBlock != 100 || diff.ToBlock != 200 {
+		t.Errorf("Incorrect diff blocks %d -> %d", diff.FromBlock, diff.ToBlock)
+	}
+	expected := []snapshot.Change{
+		{Entity: "lot 0", Type: snapshot.ChangeRemoved},
+		{Entity: "lot 1", Type: snapshot.ChangeAdded},
+		{Entity: "node " + nodeAddress.Hex(), Field: "timezoneLocation", Type: snapshot.ChangeModified},
+		{Entity: "settings protocol.auction", Field: "lotDuration", Type: snapshot.ChangeModified},
+	}
+	if len(diff.Changes) != len(expected) {
+		t.Fatalf("Incorrect change count %d: %v", len(diff.Changes), diff.Changes)
+	}
+	for ci, change := range diff.Changes {
+		if change.Entity != expected[ci].Entity || change.Field != expected[ci].Field || change.Type != expected[ci].Type {
+			t.Errorf("Incorrect change %d: %s", ci, change.String())
+		}
+	}
+	if change := diff.Changes[3]; fmt.Sprint(change.Old) != "40320" || fmt.Sprint(change.New) != "50000" {
+		t.Errorf("Incorrect setting change %s", change.String())
+	}
+
+}
@@ -0,0 +1,16 @@
+package evm
+
+import (
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/multisig-labs/gogopool-go/tests"
+)
+
+// Dial the node through the shared test RPC session, so node calls are recorded & replayed with the rest
+func dial() (*rpc.Client, error) {
+	rpcSession, err := tests.GetRPCSession()
+	if err != nil {
+		return nil, err
+	}
+	return rpcSession.Dial(tests.Eth1ProviderAddress)
+}
@@ -1,16 +1,10 @@
 package evm
 
-import (
-	"github.com/ethereum/go-ethereum/rpc"
-
-	"github.com/multisig-labs/gogopool-go/tests"
-)
-
 // Mine a number of blocks
 func MineBlocks(numBlocks int) error {
 
 	// Initialize RPC client
-	client, err := rpc.Dial(tests.Eth1ProviderAddress)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -31,7 +25,7 @@ func MineBlocks(numBlocks int) error {
 func IncreaseTime(time int) error {
 
 	// Initialize RPC client
-	client, err := rpc.Dial(tests.Eth1ProviderAddress)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
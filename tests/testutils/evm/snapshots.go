@@ -1,11 +1,5 @@
 package evm
 
-import (
-	"github.com/ethereum/go-ethereum/rpc"
-
-	"github.com/multisig-labs/gogopool-go/tests"
-)
-
 // The ID of the current snapshot of the EVM state
 var snapshotId string
 
@@ -13,7 +7,7 @@ var snapshotId string
 func TakeSnapshot() error {
 
 	// Initialize RPC client
-	client, err := rpc.Dial(tests.Eth1ProviderAddress)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -34,7 +28,7 @@ func TakeSnapshot() error {
 func RevertSnapshot() error {
 
 	// Initialize RPC client
-	client, err := rpc.Dial(tests.Eth1ProviderAddress)
+	client, err := dial()
 	if err != nil {
 		return err
 	}
@@ -1,13 +1,10 @@
 package minipool
 
 import (
-	"errors"
 	"fmt"
 	"math/big"
 	"testing"
 
-	"github.com/ethereum/go-ethereum/common"
-
 	"github.com/multisig-labs/gogopool-go/gogopool"
 	"github.com/multisig-labs/gogopool-go/minipool"
 	"github.com/multisig-labs/gogopool-go/network"
@@ -19,13 +16,6 @@ import (
 	"github.com/multisig-labs/gogopool-go/tests/testutils/validator"
 )
 
-// Minipool created event
-type minipoolCreated struct {
-	Minipool common.Address
-	Node     common.Address
-	Time     *big.Int
-}
-
 // Create a minipool
 func CreateMinipool(t *testing.T, ggp *gogopool.GoGoPool, ownerAccount, nodeAccount *accounts.Account, depositAmount *big.Int, pubkey int) (*minipool.Minipool, error) {
 
@@ -39,31 +29,13 @@ func CreateMinipool(t *testing.T, ggp *gogopool.GoGoPool, ownerAccount, nodeAcco
 	}
 
 	// Do the node deposit to generate the minipool
-	expectedMinipoolAddress, txReceipt, err := nodeutils.Deposit(t, ggp, nodeAccount, depositAmount, pubkey)
+	result, err := nodeutils.Deposit(t, ggp, nodeAccount, depositAmount, pubkey)
 	if err != nil {
 		return nil, fmt.Errorf("Could not do node deposit: %w", err)
 	}
 
-	// Get minipool manager contract
-	gogoMinipoolManager, err := ggp.GetContract("rocketMinipoolManager")
-	if err != nil {
-		return nil, err
-	}
-
-	// Get created minipool address
-	minipoolCreatedEvents, err := gogoMinipoolManager.GetTransactionEvents(txReceipt, "MinipoolCreated", minipoolCreated{})
-	if err != nil || len(minipoolCreatedEvents) == 0 {
-		return nil, errors.New("Could not get minipool created event")
-	}
-	minipoolAddress := minipoolCreatedEvents[0].(minipoolCreated).Minipool
-
-	// Sanity check to verify the created minipool is at the expected address
-	if expectedMinipoolAddress != minipoolAddress {
-		return nil, errors.New(fmt.Sprintf("Expected minipool address %s but got %s", expectedMinipoolAddress.Hex(), minipoolAddress.Hex()))
-	}
-
 	// Return minipool instance
-	return minipool.NewMinipool(ggp, minipoolAddress)
+	return minipool.NewMinipool(ggp, result.MinipoolAddress)
 
 }
 
@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/multisig-labs/gogopool-go/gogopool"
 	"github.com/multisig-labs/gogopool-go/minipool"
@@ -25,8 +24,9 @@ func GetSalt() *big.Int {
 	return big.NewInt(salt)
 }
 
-// Call deposit on the node using the validator test values
-func Deposit(t *testing.T, ggp *gogopool.GoGoPool, nodeAccount *accounts.Account, depositAmount *big.Int, pubkey int) (common.Address, *types.Receipt, error) {
+// Call deposit on the node using the validator test values and wait for it to be mined
+// Checks that the new minipool from the deposit result is at the expected address
+func Deposit(t *testing.T, ggp *gogopool.GoGoPool, nodeAccount *accounts.Account, depositAmount *big.Int, pubkey int) (node.DepositResult, error) {
 
 	// Get the next salt
 	salt := GetSalt()
@@ -34,27 +34,27 @@ func Deposit(t *testing.T, ggp *gogopool.GoGoPool, nodeAccount *accounts.Account
 	// Get validator & deposit data
 	depositType, err := node.GetDepositType(ggp, depositAmount, nil)
 	if err != nil {
-		return common.Address{}, nil, fmt.Errorf("Error getting deposit type: %w", err)
+		return node.DepositResult{}, fmt.Errorf("Error getting deposit type: %w", err)
 	}
 	validatorPubkey, err := validator.GetValidatorPubkey(pubkey)
 	if err != nil {
-		return common.Address{}, nil, fmt.Errorf("Error getting validator pubkey: %w", err)
+		return node.DepositResult{}, fmt.Errorf("Error getting validator pubkey: %w", err)
 	}
 	expectedMinipoolAddress, err := utils.GenerateAddress(ggp, nodeAccount.Address, depositType, salt, nil)
 	if err != nil {
-		return common.Address{}, nil, fmt.Errorf("Error generating minipool address: %w", err)
+		return node.DepositResult{}, fmt.Errorf("Error generating minipool address: %w", err)
 	}
 	withdrawalCredentials, err := minipool.GetMinipoolWithdrawalCredentials(ggp, expectedMinipoolAddress, nil)
 	if err != nil {
-		return common.Address{}, nil, fmt.Errorf("Error getting minipool withdrawal credentials: %w", err)
+		return node.DepositResult{}, fmt.Errorf("Error getting minipool withdrawal credentials: %w", err)
 	}
 	validatorSignature, err := validator.GetValidatorSignature(pubkey)
 	if err != nil {
-		return common.Address{}, nil, fmt.Errorf("Error getting validator signature: %w", err)
+		return node.DepositResult{}, fmt.Errorf("Error getting validator signature: %w", err)
 	}
 	depositDataRoot, err := validator.GetDepositDataRoot(validatorPubkey, withdrawalCredentials, validatorSignature)
 	if err != nil {
-		return common.Address{}, nil, fmt.Errorf("Error getting deposit data root: %w", err)
+		return node.DepositResult{}, fmt.Errorf("Error getting deposit data root: %w", err)
 	}
 
 	// Make node deposit
@@ -64,14 +64,16 @@ func Deposit(t *testing.T, ggp *gogopool.GoGoPool, nodeAccount *accounts.Account
 	minNodeFee := 0.0
 	//t.Logf("Deposit:\n\tMin Node Fee: %f\n\tValidator Pubkey: %s\n\tValidator Signature: %s\n\tDeposit Data Root: %s\n\tNode Address: %s\n\tSalt: %s\n\tExpected Minipool: %s\n",
 	//    minNodeFee, validatorPubkey.Hex(), validatorSignature.Hex(), depositDataRoot.Hex(), nodeAccount.Address.Hex(), GetDefaultSalt().String(), expectedMinipoolAddress.Hex())
-	hash, err := node.Deposit(ggp, minNodeFee, validatorPubkey, validatorSignature, depositDataRoot, salt, expectedMinipoolAddress, opts)
+	result, err := node.DepositAndWait(ggp, minNodeFee, validatorPubkey, validatorSignature, depositDataRoot, salt, expectedMinipoolAddress, opts)
 	if err != nil {
-		return common.Address{}, nil, fmt.Errorf("Error executing deposit: %w", err)
+		return node.DepositResult{}, fmt.Errorf("Error executing deposit: %w", err)
 	}
-	txReceipt, err := utils.WaitForTransaction(ggp.Client, hash)
-	if err != nil {
-		return common.Address{}, nil, fmt.Errorf("Error waiting for deposit transaction: %w", err)
+	if result.TxHash == (common.Hash{}) || result.GasUsed == 0 {
+		return node.DepositResult{}, fmt.Errorf("Incorrect deposit transaction result %+v", result.TransactionResult)
+	}
+	if result.MinipoolAddress != expectedMinipoolAddress {
+		return node.DepositResult{}, fmt.Errorf("Expected minipool address %s but got %s", expectedMinipoolAddress.Hex(), result.MinipoolAddress.Hex())
 	}
 
-	return expectedMinipoolAddress, txReceipt, nil
+	return result, nil
 }
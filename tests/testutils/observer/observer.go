@@ -0,0 +1,44 @@
+package observer
+
+import (
+	"context"
+	"sync"
+
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// An observer which records finished calls
+type Recorder struct {
+	calls []uc.CallInfo
+	lock  sync.Mutex
+}
+
+func (r *Recorder) StartCall(ctx context.Context, call uc.CallInfo) context.Context {
+	return ctx
+}
+
+func (r *Recorder) EndCall(ctx context.Context, call uc.CallInfo) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.calls = append(r.calls, call)
+}
+
+// Get the recorded calls
+func (r *Recorder) Calls() []uc.CallInfo {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	calls := make([]uc.CallInfo, len(r.calls))
+	copy(calls, r.calls)
+	return calls
+}
+
+// Get the recorded calls of a kind
+func (r *Recorder) CallsOfKind(kind uc.CallKind) []uc.CallInfo {
+	calls := []uc.CallInfo{}
+	for _, call := range r.Calls() {
+		if call.Kind == kind {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
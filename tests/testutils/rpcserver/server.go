@@ -0,0 +1,150 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// JSON-RPC error codes
+const (
+	ErrorCodeServer         = -32000
+	ErrorCodeMethodNotFound = -32601
+)
+
+// Answers a JSON-RPC method call with its result or an error
+type Handler func(params []json.RawMessage) (interface{}, error)
+
+// A JSON-RPC error with a code; other handler errors are returned as server errors
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// A fake JSON-RPC server which answers each method with its registered handler
+// Calls to methods without a handler fail with a method not found error
+type Server struct {
+	URL      string
+	server   *httptest.Server
+	handlers map[string]Handler
+	latency  time.Duration
+	status   int
+	calls    map[string]int
+	params   map[string]json.RawMessage
+	lock     sync.Mutex
+}
+
+// Start a fake JSON-RPC server, which is closed when the test finishes
+func New(t *testing.T) *Server {
+	s := &Server{
+		handlers: map[string]Handler{},
+		calls:    map[string]int{},
+		params:   map[string]json.RawMessage{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// Set the handler for a method
+func (s *Server) Handle(method string, handler Handler) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handlers[method] = handler
+}
+
+// Set the delay before each call is answered
+func (s *Server) SetLatency(latency time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.latency = latency
+}
+
+// Set an HTTP error status to answer every call with, or 0 to answer calls normally
+func (s *Server) SetStatus(status int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.status = status
+}
+
+// Get the number of calls made to a method
+func (s *Server) Count(method string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.calls[method]
+}
+
+// Get the encoded params of the last call made to a method
+func (s *Server) LastParams(method string) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return string(s.params[method])
+}
+
+// Stop the server; calls made after it is closed fail
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+
+	// Decode request
+	var request struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var params []json.RawMessage
+	if len(request.Params) > 0 {
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Record the call
+	s.lock.Lock()
+	s.calls[request.Method]++
+	s.params[request.Method] = request.Params
+	handler := s.handlers[request.Method]
+	latency := s.latency
+	status := s.status
+	s.lock.Unlock()
+	time.Sleep(latency)
+	if status != 0 {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	// Answer the call
+	var result interface{}
+	var err error
+	if handler == nil {
+		err = &Error{Code: ErrorCodeMethodNotFound, Message: fmt.Sprintf("the method %s does not exist/is not available", request.Method)}
+	} else {
+		result, err = handler(params)
+	}
+	response := map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "result": result}
+	if err != nil {
+		rpcErr := &Error{Code: ErrorCodeServer, Message: err.Error()}
+		errors.As(err, &rpcErr)
+		response = map[string]interface{}{"jsonrpc": "2.0", "id": request.ID, "error": map[string]interface{}{"code": rpcErr.Code, "message": rpcErr.Message}}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+
+}
@@ -8,11 +8,13 @@ import (
 	"github.com/multisig-labs/gogopool-go/tokens"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	ggputils "github.com/multisig-labs/gogopool-go/tests/testutils/tokens/ggp"
 )
 
 func TestFixedSupplyGGPBalances(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -47,6 +49,7 @@ func TestFixedSupplyGGPBalances(t *testing.T) {
 }
 
 func TestTransferFixedSupplyGGP(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -81,6 +84,7 @@ func TestTransferFixedSupplyGGP(t *testing.T) {
 }
 
 func TestTransferFromFixedSupplyGGP(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -10,11 +10,13 @@ import (
 	"github.com/multisig-labs/gogopool-go/tokens"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	ggputils "github.com/multisig-labs/gogopool-go/tests/testutils/tokens/ggp"
 )
 
 func TestGGPBalances(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -50,6 +52,7 @@ func TestGGPBalances(t *testing.T) {
 }
 
 func TestTransferGGP(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -84,6 +87,7 @@ func TestTransferGGP(t *testing.T) {
 }
 
 func TestTransferFromGGP(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -130,6 +134,7 @@ func TestTransferFromGGP(t *testing.T) {
 }
 
 func TestMintInflationGGP(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -177,6 +182,7 @@ func TestMintInflationGGP(t *testing.T) {
 }
 
 func TestSwapFixedSupplyGGPForGGP(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
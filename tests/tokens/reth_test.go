@@ -9,6 +9,7 @@ import (
 	"github.com/multisig-labs/gogopool-go/tokens"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	nodeutils "github.com/multisig-labs/gogopool-go/tests/testutils/node"
 	rethutils "github.com/multisig-labs/gogopool-go/tests/testutils/tokens/reth"
@@ -19,6 +20,7 @@ import (
 // GetRETHCollateralRate test under minipool.TestWithdrawValidatorBalance
 
 func TestRETHBalances(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -53,6 +55,7 @@ func TestRETHBalances(t *testing.T) {
 }
 
 func TestTransferRETH(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -92,6 +95,7 @@ func TestTransferRETH(t *testing.T) {
 }
 
 func TestTransferFromRETH(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -143,6 +147,7 @@ func TestTransferFromRETH(t *testing.T) {
 }
 
 func TestRETHExchangeRate(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -190,6 +195,7 @@ func TestRETHExchangeRate(t *testing.T) {
 }
 
 func TestBurnRETH(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
@@ -7,12 +7,14 @@ import (
 	"github.com/multisig-labs/gogopool-go/tokens"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
+	"github.com/multisig-labs/gogopool-go/tests"
 	"github.com/multisig-labs/gogopool-go/tests/testutils/evm"
 	ggputils "github.com/multisig-labs/gogopool-go/tests/testutils/tokens/ggp"
 	rethutils "github.com/multisig-labs/gogopool-go/tests/testutils/tokens/reth"
 )
 
 func TestTokenBalances(t *testing.T) {
+	tests.RequireNode(t)
 
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
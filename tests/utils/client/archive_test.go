@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+func TestArchiveRouting(t *testing.T) {
+
+	// Initialize a pruned full node & an archive node
+	full := newEndpoint(t, 1, 1000, 0)
+	archive := newEndpoint(t, 2, 1000, 0)
+	full.setPruned(900)
+	client := uc.NewEth1ClientProxy(0, full.server.URL, archive.server.URL)
+	if err := client.SetEndpointKind(archive.server.URL, uc.EndpointArchive); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetEndpointKind("http://unknown", uc.EndpointArchive); err == nil {
+		t.Error("Unknown endpoint was tagged")
+	}
+	client.SetArchiveDepth(50)
+
+	// Recent reads go to the first endpoint
+	if _, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(990)); err != nil {
+		t.Fatal(err)
+	}
+	if full.server.Count("eth_getBalance") != 1 || archive.server.Count("eth_getBalance") != 0 {
+		t.Errorf("Recent read was not sent to the full node")
+	}
+
+	// Reads older than the archive depth only go to the archive node
+	if _, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(920)); err != nil {
+		t.Fatal(err)
+	}
+	if full.server.Count("eth_getBalance") != 1 || archive.server.Count("eth_getBalance") != 1 {
+		t.Errorf("Old read was not sent to the archive node")
+	}
+
+	// Reads failing with missing state are retried on the archive node
+	client.SetArchiveDepth(200)
+	if _, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(850)); err != nil {
+		t.Fatal(err)
+	}
+	if full.server.Count("eth_getBalance") != 2 || archive.server.Count("eth_getBalance") != 2 {
+		t.Errorf("Missing state read was not retried on the archive node")
+	}
+
+	// Missing state is returned if no endpoint has it
+	archive.setPruned(900)
+	_, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(850))
+	if !uc.IsMissingStateError(err) {
+		t.Fatalf("Incorrect error %v", err)
+	}
+	var proxyErr *uc.ProxyError
+	if !errors.As(err, &proxyErr) || len(proxyErr.ClientErrors) != 1 {
+		t.Errorf("Original client error was not reported: %v", err)
+	}
+
+}
+
+func TestLaggingEndpointRead(t *testing.T) {
+
+	// Initialize an endpoint which is a block behind & an endpoint at the head
+	lagging := newEndpoint(t, 1, 999, 0)
+	current := newEndpoint(t, 2, 1000, 0)
+	lagging.setStrict(true)
+	client := uc.NewEth1ClientProxy(0, lagging.server.URL, current.server.URL)
+
+	// Reads of a block the endpoint hasn't reached are retried on the other endpoint
+	if _, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	if lagging.server.Count("eth_getBalance") != 1 || current.server.Count("eth_getBalance") != 1 {
+		t.Errorf("Missing block read was not retried on the other endpoint")
+	}
+	if status := client.GetBreakerStatus(); len(status) != 2 || status[0].Failures != 0 {
+		t.Errorf("Missing block counted against the lagging endpoint: %+v", status)
+	}
+
+	// Once probed, reads skip endpoints whose head is behind the block
+	client.ProbeEndpoints(context.Background())
+	for i := 0; i < 4; i++ {
+		if _, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(1000)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if lagging.server.Count("eth_getBalance") != 1 || current.server.Count("eth_getBalance") != 5 {
+		t.Errorf("Read was sent to the lagging endpoint")
+	}
+
+	// Reads of earlier blocks still use both endpoints
+	for i := 0; i < 4; i++ {
+		if _, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(999)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if lagging.server.Count("eth_getBalance") == 1 {
+		t.Errorf("Read of an earlier block was not balanced")
+	}
+
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/multisig-labs/gogopool-go/tests/testutils/rpcserver"
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// A fake RPC endpoint with a fixed head & latency
+// Strict endpoints fail reads of blocks past their head
+type endpoint struct {
+	server  *rpcserver.Server
+	id      int64
+	head    uint64
+	balance int64
+	pruned  uint64
+	strict  bool
+	lock    sync.Mutex
+}
+
+func newEndpoint(t *testing.T, id int64, head uint64, latency time.Duration) *endpoint {
+	e := &endpoint{id: id, head: head}
+	e.server = rpcserver.New(t)
+	e.server.SetLatency(latency)
+	e.server.Handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+		return hexutil.Uint64(e.head), nil
+	})
+	e.server.Handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return (*hexutil.Big)(big.NewInt(e.id)), nil
+	})
+	e.server.Handle("eth_getBalance", e.getBalance)
+	e.server.Handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		return common.Hash{}, nil
+	})
+	return e
+}
+
+func (e *endpoint) getBalance(params []json.RawMessage) (interface{}, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	var blockTag string
+	if err := json.Unmarshal(params[1], &blockTag); err != nil {
+		return nil, err
+	}
+	if block, err := hexutil.DecodeUint64(blockTag); err == nil {
+		if e.strict && block > e.head {
+			return nil, errors.New("header not found")
+		}
+		if block < e.pruned {
+			return nil, errors.New("missing trie node 0000000000000000000000000000000000000000000000000000000000000000 (path )")
+		}
+	}
+	return (*hexutil.Big)(big.NewInt(e.balance)), nil
+}
+
+func (e *endpoint) setHead(head uint64) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.head = head
+}
+
+func (e *endpoint) setPruned(pruned uint64) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.pruned = pruned
+}
+
+func (e *endpoint) setStrict(strict bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.strict = strict
+}
+
+func (e *endpoint) setBalance(balance int64) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.balance = balance
+}
+
+func TestEndpointSelection(t *testing.T) {
+
+	// Initialize endpoints; the first is slow and the third is behind
+	slow := newEndpoint(t, 1, 100, 20*time.Millisecond)
+	fast := newEndpoint(t, 2, 100, time.Millisecond)
+	behind := newEndpoint(t, 3, 90, time.Millisecond)
+	client := uc.NewEth1ClientProxy(0, slow.server.URL, fast.server.URL, behind.server.URL)
+
+	// Clients are used in list order before probing
+	if chainId, err := client.ChainID(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if chainId.Int64() != 1 {
+		t.Errorf("Incorrect unprobed endpoint %d", chainId.Int64())
+	}
+
+	// Probe endpoints
+	client.ProbeEndpoints(context.Background())
+	health := client.GetEndpointHealth()
+	if !health[0].Healthy || health[0].Lagging || health[0].Head != 100 {
+		t.Errorf("Incorrect slow endpoint health %+v", health[0])
+	}
+	if !health[2].Healthy || !health[2].Lagging {
+		t.Errorf("Incorrect lagging endpoint health %+v", health[2])
+	}
+
+	// Reads are weighted towards the fast endpoint, and the lagging endpoint is not used
+	counts := map[int64]int{}
+	for i := 0; i < 20; i++ {
+		chainId, err := client.ChainID(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[chainId.Int64()]++
+	}
+	if counts[2] <= counts[1] {
+		t.Errorf("Fast endpoint was not preferred: %v", counts)
+	}
+	if counts[3] != 0 {
+		t.Errorf("Lagging endpoint was used: %v", counts)
+	}
+
+}
+
+func TestStickyEndpoint(t *testing.T) {
+
+	// Initialize endpoints with equal latency
+	first := newEndpoint(t, 1, 100, time.Millisecond)
+	second := newEndpoint(t, 2, 100, time.Millisecond)
+	client := uc.NewEth1ClientProxy(0, first.server.URL, second.server.URL)
+	client.ProbeEndpoints(context.Background())
+
+	// A sticky context keeps using the endpoint of its first call
+	ctx := uc.WithStickyEndpoint(context.Background())
+	chainId, err := client.ChainID(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		next, err := client.ChainID(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next.Cmp(chainId) != 0 {
+			t.Fatalf("Sticky context moved from endpoint %s to %s", chainId, next)
+		}
+	}
+
+	// Polling for a sent transaction goes to the endpoint it was sent through
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(1), nil)
+	if err := client.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+	sentTo, other := first, second
+	if second.server.Count("eth_sendRawTransaction") == 1 {
+		sentTo, other = second, first
+	}
+	for i := 0; i < 4; i++ {
+		client.TransactionReceipt(context.Background(), tx.Hash())
+	}
+	if sentTo.server.Count("eth_getTransactionReceipt") != 4 || other.server.Count("eth_getTransactionReceipt") != 0 {
+		t.Errorf("Receipt polling was not sticky: %d / %d", sentTo.server.Count("eth_getTransactionReceipt"), other.server.Count("eth_getTransactionReceipt"))
+	}
+
+}
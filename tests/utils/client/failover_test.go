@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// A JSON-RPC error returned by a working endpoint
+type rpcError struct {
+	code    int
+	message string
+}
+
+func (e rpcError) Error() string  { return e.message }
+func (e rpcError) ErrorCode() int { return e.code }
+
+func TestClassifyError(t *testing.T) {
+	for _, test := range []struct {
+		err   error
+		class uc.ErrorClass
+	}{
+		{rpc.HTTPError{StatusCode: http.StatusTooManyRequests}, uc.ErrorClassRetryable},
+		{rpc.HTTPError{StatusCode: http.StatusBadGateway}, uc.ErrorClassRetryable},
+		{rpc.HTTPError{StatusCode: http.StatusServiceUnavailable}, uc.ErrorClassRetryable},
+		{rpc.HTTPError{StatusCode: http.StatusUnauthorized}, uc.ErrorClassRetryable},
+		{rpc.HTTPError{StatusCode: http.StatusForbidden}, uc.ErrorClassRetryable},
+		{rpc.HTTPError{StatusCode: http.StatusNotFound}, uc.ErrorClassRetryable},
+		{fmt.Errorf("Post: %w", io.EOF), uc.ErrorClassRetryable},
+		{context.DeadlineExceeded, uc.ErrorClassRetryable},
+		{context.Canceled, uc.ErrorClassTerminal},
+		{errors.New("dial tcp 127.0.0.1:8545: connect: connection refused"), uc.ErrorClassRetryable},
+		{errors.New("remote error: tls: bad record MAC"), uc.ErrorClassRetryable},
+		{rpcError{-32005, "limit exceeded"}, uc.ErrorClassRetryable},
+		{rpcError{-32000, "execution reverted"}, uc.ErrorClassTerminal},
+		{rpcError{-32000, "nonce too low"}, uc.ErrorClassTerminal},
+	} {
+		if class := uc.ClassifyError(test.err); class != test.class {
+			t.Errorf("Incorrect class for %q: expected %s, got %s", test.err, test.class, class)
+		}
+	}
+}
+
+func TestFailover(t *testing.T) {
+
+	// Initialize endpoints; the first is rate limiting
+	limited := newEndpoint(t, 1, 100, 0)
+	backup := newEndpoint(t, 2, 100, 0)
+	limited.server.SetStatus(http.StatusTooManyRequests)
+	client := uc.NewEth1ClientProxy(time.Hour, limited.server.URL, backup.server.URL)
+	client.SetFailoverConfig(uc.FailoverConfig{
+		FailureThreshold: 2,
+		BaseBackoff:      50 * time.Millisecond,
+		MaxBackoff:       time.Second,
+		RetryAttempts:    1,
+	})
+
+	// Calls fail over to the backup until the breaker opens, then skip the limited endpoint
+	for i := 0; i < 4; i++ {
+		chainId, err := client.ChainID(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if chainId.Int64() != 2 {
+			t.Errorf("Incorrect endpoint %d", chainId.Int64())
+		}
+	}
+	if limited.server.Count("eth_chainId") != 2 {
+		t.Errorf("Incorrect limited endpoint call count %d", limited.server.Count("eth_chainId"))
+	}
+	status := client.GetBreakerStatus()
+	if status[0].State != uc.BreakerOpen || status[1].State != uc.BreakerClosed {
+		t.Errorf("Incorrect breaker states %s / %s", status[0].State, status[1].State)
+	}
+
+	// A failed trial request reopens the breaker with a longer backoff
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.ChainID(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if status := client.GetBreakerStatus(); status[0].State != uc.BreakerOpen || status[0].Backoff != 100*time.Millisecond {
+		t.Errorf("Incorrect reopened breaker %+v", status[0])
+	}
+
+	// A successful trial request closes the breaker
+	limited.server.SetStatus(0)
+	time.Sleep(110 * time.Millisecond)
+	if chainId, err := client.ChainID(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if chainId.Int64() != 1 {
+		t.Errorf("Recovered endpoint was not used")
+	}
+	if status := client.GetBreakerStatus(); status[0].State != uc.BreakerClosed {
+		t.Errorf("Incorrect recovered breaker state %s", status[0].State)
+	}
+
+	// Endpoints rejecting requests, e.g. with a bad API key, fail over to the backup
+	limited.server.SetStatus(http.StatusUnauthorized)
+	if chainId, err := client.ChainID(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if chainId.Int64() != 2 {
+		t.Errorf("Rejected request did not fail over to the backup")
+	}
+	if status := client.GetBreakerStatus(); status[0].Failures != 1 {
+		t.Errorf("Rejected request was not counted against the endpoint: %+v", status[0])
+	}
+
+}
+
+func TestBreakerBackoff(t *testing.T) {
+
+	// Initialize endpoints without a reconnect delay; the first is unavailable
+	unavailable := newEndpoint(t, 1, 100, 0)
+	backup := newEndpoint(t, 2, 100, 0)
+	unavailable.server.SetStatus(http.StatusServiceUnavailable)
+	client := uc.NewEth1ClientProxy(0, unavailable.server.URL, backup.server.URL)
+
+	// The breaker opens with the default backoff, and calls skip the endpoint while it is open
+	for i := 0; i < uc.DefaultFailureThreshold+2; i++ {
+		if _, err := client.ChainID(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if status := client.GetBreakerStatus(); status[0].State != uc.BreakerOpen || status[0].Backoff != uc.DefaultBaseBackoff {
+		t.Errorf("Incorrect opened breaker %+v", status[0])
+	}
+	if count := unavailable.server.Count("eth_chainId"); count != uc.DefaultFailureThreshold {
+		t.Errorf("Incorrect unavailable endpoint call count %d", count)
+	}
+
+	// Failed trial requests reopen the breaker with a doubled backoff, up to the maximum
+	client = uc.NewEth1ClientProxy(0, unavailable.server.URL, backup.server.URL)
+	client.SetFailoverConfig(uc.FailoverConfig{
+		FailureThreshold: 1,
+		BaseBackoff:      20 * time.Millisecond,
+		MaxBackoff:       70 * time.Millisecond,
+		RetryAttempts:    1,
+	})
+	for _, backoff := range []time.Duration{20 * time.Millisecond, 40 * time.Millisecond, 70 * time.Millisecond, 70 * time.Millisecond} {
+		if _, err := client.ChainID(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		status := client.GetBreakerStatus()
+		if status[0].State != uc.BreakerOpen || status[0].Backoff != backoff {
+			t.Errorf("Incorrect breaker after a failed request: expected an open breaker with a %s backoff, got %+v", backoff, status[0])
+		}
+		if _, err := client.ChainID(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if status := client.GetBreakerStatus(); status[0].Backoff != backoff {
+			t.Errorf("Open breaker was tried before its backoff passed: %+v", status[0])
+		}
+		time.Sleep(backoff + 5*time.Millisecond)
+	}
+
+}
+
+func TestRetries(t *testing.T) {
+
+	// Initialize an endpoint which is briefly unavailable
+	unavailable := newEndpoint(t, 1, 100, 0)
+	unavailable.server.SetStatus(http.StatusServiceUnavailable)
+	client := uc.NewEth1ClientProxy(0, unavailable.server.URL)
+	client.SetFailoverConfig(uc.FailoverConfig{
+		FailureThreshold: 5,
+		RetryAttempts:    3,
+		RetryDelay:       20 * time.Millisecond,
+		MaxRetryDelay:    time.Second,
+	})
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		unavailable.server.SetStatus(0)
+	}()
+
+	// The call succeeds on a retry round
+	if _, err := client.ChainID(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if count := unavailable.server.Count("eth_chainId"); count < 2 {
+		t.Errorf("Incorrect call count %d", count)
+	}
+
+	// Calls fail once out of retries
+	unavailable.server.SetStatus(http.StatusServiceUnavailable)
+	if _, err := client.ChainID(context.Background()); !errors.Is(err, uc.ErrNoClientsAvailable) {
+		t.Errorf("Incorrect error %v", err)
+	}
+
+}
+
+func TestConcurrentCalls(t *testing.T) {
+
+	// Initialize endpoints; the first fails intermittently
+	flaky := newEndpoint(t, 1, 100, 0)
+	stable := newEndpoint(t, 2, 100, 0)
+	client := uc.NewEth1ClientProxy(0, flaky.server.URL, stable.server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client.StartHealthChecks(ctx, 5*time.Millisecond)
+
+	// Make concurrent calls while the endpoint flaps
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if i == 0 {
+					flaky.server.SetStatus([]int{0, http.StatusBadGateway}[j%2])
+				}
+				if _, err := client.ChainID(context.Background()); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+}
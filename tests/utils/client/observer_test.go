@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/multisig-labs/gogopool-go/tests/testutils/observer"
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+func TestObserver(t *testing.T) {
+
+	// Initialize endpoints & observers
+	failing := newEndpoint(t, 1, 100, 0)
+	working := newEndpoint(t, 2, 100, 0)
+	failing.server.SetStatus(http.StatusServiceUnavailable)
+	client := uc.NewEth1ClientProxy(0, failing.server.URL, working.server.URL)
+	recorder := &observer.Recorder{}
+	metrics := uc.NewPrometheusObserver("gogopool")
+	client.SetObserver(uc.MultiObserver(recorder, metrics))
+
+	// Make a contract call with a read at a block
+	ctx, done := client.ObserveContractCall(context.Background(), uc.CallKindCall, "rocketTokenRPL", "balanceOf", big.NewInt(90))
+	if _, err := client.BalanceAt(ctx, common.Address{}, big.NewInt(90)); err != nil {
+		t.Fatal(err)
+	}
+	done(nil)
+
+	// Make a failing call
+	working.server.SetStatus(http.StatusServiceUnavailable)
+	if _, err := client.ChainID(context.Background()); err == nil {
+		t.Fatal("Call did not fail")
+	}
+
+	// Check recorded calls
+	calls := recorder.Calls()
+	if len(calls) != 3 {
+		t.Fatalf("Incorrect call count %d", len(calls))
+	}
+	rpcCall := calls[0]
+	if rpcCall.Kind != uc.CallKindRPC || rpcCall.Method != "BalanceAt" || rpcCall.Contract != "rocketTokenRPL" || rpcCall.ContractMethod != "balanceOf" || rpcCall.Endpoint != 1 || rpcCall.Block != "90" || rpcCall.Err != nil {
+		t.Errorf("Incorrect RPC call %+v", rpcCall)
+	}
+	contractCall := calls[1]
+	if contractCall.Kind != uc.CallKindCall || contractCall.Method != "balanceOf" || contractCall.Endpoint != 1 || contractCall.Block != "90" {
+		t.Errorf("Incorrect contract call %+v", contractCall)
+	}
+	failedCall := calls[2]
+	if failedCall.Method != "ChainID" || failedCall.Endpoint != -1 || failedCall.Err == nil || failedCall.ErrorClass != uc.ErrorClassRetryable {
+		t.Errorf("Incorrect failed call %+v", failedCall)
+	}
+
+	// Check metrics
+	var out strings.Builder
+	if err := metrics.WriteMetrics(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"# TYPE gogopool_calls_total counter",
+		`gogopool_calls_total{kind="rpc",contract="rocketTokenRPL",method="BalanceAt",endpoint="1",block="historical",error_class="none"} 1`,
+		`gogopool_calls_total{kind="call",contract="rocketTokenRPL",method="balanceOf",endpoint="1",block="historical",error_class="none"} 1`,
+		`gogopool_calls_total{kind="rpc",contract="",method="ChainID",endpoint="",block="",error_class="retryable"} 1`,
+		"# TYPE gogopool_call_duration_seconds histogram",
+		`gogopool_call_duration_seconds_bucket{kind="rpc",contract="",method="ChainID",endpoint="",le="+Inf"} 1`,
+		`gogopool_call_duration_seconds_count{kind="call",contract="rocketTokenRPL",method="balanceOf",endpoint="1"} 1`,
+	} {
+		if !strings.Contains(out.String(), line+"\n") {
+			t.Errorf("Missing metric line %s in:\n%s", line, out.String())
+		}
+	}
+
+}
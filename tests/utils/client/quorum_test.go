@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+func TestQuorum(t *testing.T) {
+
+	// Initialize endpoints at different heads
+	first := newEndpoint(t, 1, 100, 0)
+	second := newEndpoint(t, 2, 102, 0)
+	third := newEndpoint(t, 3, 101, 0)
+	for _, e := range []*endpoint{first, second, third} {
+		e.setBalance(5)
+	}
+	client := uc.NewEth1ClientProxy(0, first.server.URL, second.server.URL, third.server.URL)
+	ctx := uc.WithQuorum(context.Background(), uc.QuorumConfig{Endpoints: 3, Agree: 2})
+
+	// Reads are pinned to the highest block reached by enough endpoints
+	balance, err := client.BalanceAt(ctx, common.Address{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Int64() != 5 {
+		t.Errorf("Incorrect balance %s", balance)
+	}
+	for _, e := range []*endpoint{first, second, third} {
+		if params := e.server.LastParams("eth_getBalance"); !strings.Contains(params, `"0x65"`) {
+			t.Errorf("Read was not pinned to block 101: %s", params)
+		}
+	}
+
+	// Reads at a block are not re-pinned
+	if _, err := client.BalanceAt(ctx, common.Address{}, big.NewInt(90)); err != nil {
+		t.Fatal(err)
+	}
+	if params := first.server.LastParams("eth_getBalance"); !strings.Contains(params, `"0x5a"`) {
+		t.Errorf("Read was not made at block 90: %s", params)
+	}
+
+	// A single outlier is tolerated
+	third.setBalance(6)
+	if balance, err := client.BalanceAt(ctx, common.Address{}, nil); err != nil {
+		t.Fatal(err)
+	} else if balance.Int64() != 5 {
+		t.Errorf("Incorrect balance %s", balance)
+	}
+
+	// Disagreement is reported with the outliers
+	second.setBalance(7)
+	_, err = client.BalanceAt(ctx, common.Address{}, nil)
+	if !errors.Is(err, uc.ErrQuorumNotReached) {
+		t.Fatalf("Incorrect error %v", err)
+	}
+	var quorumErr *uc.QuorumError
+	if !errors.As(err, &quorumErr) {
+		t.Fatalf("Incorrect error type %T", err)
+	}
+	if len(quorumErr.Responses) != 3 || len(quorumErr.Outliers) != 2 {
+		t.Errorf("Incorrect responses %+v", quorumErr)
+	}
+
+	// Reads without the quorum context go to a single endpoint
+	if _, err := client.BalanceAt(context.Background(), common.Address{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if first.server.Count("eth_getBalance") != 5 || second.server.Count("eth_getBalance") != 4 {
+		t.Errorf("Incorrect call counts %d / %d", first.server.Count("eth_getBalance"), second.server.Count("eth_getBalance"))
+	}
+
+	// Invalid settings are rejected
+	if _, err := client.BalanceAt(uc.WithQuorum(context.Background(), uc.QuorumConfig{Endpoints: 4, Agree: 2}), common.Address{}, nil); err == nil {
+		t.Error("Quorum over too many endpoints was not rejected")
+	}
+
+	// Conflicting values which each reach the agreement threshold are rejected
+	fourth := newEndpoint(t, 4, 101, 0)
+	for _, e := range []*endpoint{first, second, third, fourth} {
+		e.setBalance(5)
+	}
+	third.setBalance(6)
+	fourth.setBalance(6)
+	split := uc.NewEth1ClientProxy(0, first.server.URL, second.server.URL, third.server.URL, fourth.server.URL)
+	_, err = split.BalanceAt(uc.WithQuorum(context.Background(), uc.QuorumConfig{Endpoints: 4, Agree: 2}), common.Address{}, big.NewInt(100))
+	if !errors.As(err, &quorumErr) {
+		t.Fatalf("Incorrect error %v", err)
+	}
+	if len(quorumErr.Outliers) != 4 {
+		t.Errorf("Incorrect outliers %+v", quorumErr.Outliers)
+	}
+
+}
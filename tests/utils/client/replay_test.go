@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	uc "github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+func TestRecordReplay(t *testing.T) {
+
+	// Record calls against a live endpoint
+	live := newEndpoint(t, 7, 100, 0)
+	live.setBalance(42)
+	recorder := uc.NewRPCRecorder(nil)
+	client := uc.NewEth1ClientProxyWithTransport(0, recorder, live.server.URL)
+	if _, err := client.ChainID(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(90)); err != nil {
+		t.Fatal(err)
+	}
+	for _, head := range []uint64{100, 101} {
+		live.setHead(head)
+		if _, err := client.BlockNumber(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fixturePath := filepath.Join(t.TempDir(), "fixture.json")
+	if err := recorder.Save(fixturePath); err != nil {
+		t.Fatal(err)
+	}
+	if interactions := len(recorder.Fixture().Interactions); interactions != 4 {
+		t.Errorf("Incorrect interaction count %d", interactions)
+	}
+	live.server.Close()
+
+	// Replay the calls without the endpoint
+	replayer, err := uc.LoadRPCReplayer(fixturePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = uc.NewEth1ClientProxyWithTransport(0, replayer, live.server.URL)
+	if chainId, err := client.ChainID(context.Background()); err != nil {
+		t.Fatal(err)
+	} else if chainId.Int64() != 7 {
+		t.Errorf("Incorrect chain ID %s", chainId)
+	}
+	if balance, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(90)); err != nil {
+		t.Fatal(err)
+	} else if balance.Int64() != 42 {
+		t.Errorf("Incorrect balance %s", balance)
+	}
+
+	// Repeated calls are replayed in order, repeating the last response
+	for _, expected := range []uint64{100, 101, 101} {
+		if head, err := client.BlockNumber(context.Background()); err != nil {
+			t.Fatal(err)
+		} else if head != expected {
+			t.Errorf("Incorrect head: expected %d, got %d", expected, head)
+		}
+	}
+
+	// Unrecorded calls fail without retrying
+	if _, err := client.BalanceAt(context.Background(), common.Address{}, big.NewInt(91)); err == nil {
+		t.Error("Unrecorded call did not fail")
+	}
+	if misses := replayer.Misses(); len(misses) != 1 {
+		t.Errorf("Incorrect misses %v", misses)
+	}
+
+}
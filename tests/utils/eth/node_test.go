@@ -0,0 +1,178 @@
+package eth
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/multisig-labs/gogopool-go/tests/testutils/rpcserver"
+)
+
+// The chain ID of the fake node
+var fakeChainID = big.NewInt(1337)
+
+// A fake node with a transaction pool for a single chain, which never mines transactions on its own
+type fakeNode struct {
+	server  *rpcserver.Server
+	mined   map[common.Address]uint64
+	pending map[common.Address]map[uint64]*types.Transaction
+	senders map[common.Hash]common.Address
+	sent    []*types.Transaction
+	lock    sync.Mutex
+}
+
+func newFakeNode(t *testing.T) *fakeNode {
+	n := &fakeNode{
+		mined:   map[common.Address]uint64{},
+		pending: map[common.Address]map[uint64]*types.Transaction{},
+		senders: map[common.Hash]common.Address{},
+	}
+	n.server = rpcserver.New(t)
+	n.server.Handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return (*hexutil.Big)(fakeChainID), nil
+	})
+	n.server.Handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(1), nil
+	})
+	gasPrice := func(params []json.RawMessage) (interface{}, error) {
+		return (*hexutil.Big)(big.NewInt(1e9)), nil
+	}
+	n.server.Handle("eth_gasPrice", gasPrice)
+	n.server.Handle("eth_maxPriorityFeePerGas", gasPrice)
+	n.server.Handle("eth_estimateGas", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(21000), nil
+	})
+	n.server.Handle("eth_getTransactionCount", n.getTransactionCount)
+	n.server.Handle("eth_sendRawTransaction", n.sendRawTransaction)
+	n.server.Handle("eth_getTransactionByHash", n.getTransactionByHash)
+	return n
+}
+
+// Get the pending transaction of an account at a nonce
+func (n *fakeNode) getPendingTransaction(account common.Address, nonce uint64) *types.Transaction {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.pending[account][nonce]
+}
+
+// Drop the pending transaction of an account at a nonce, as if it was evicted from the pool
+func (n *fakeNode) drop(account common.Address, nonce uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	delete(n.pending[account], nonce)
+}
+
+// Mine the pending transactions of an account up to the first gap
+func (n *fakeNode) mine(account common.Address) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for n.pending[account][n.mined[account]] != nil {
+		delete(n.pending[account], n.mined[account])
+		n.mined[account]++
+	}
+}
+
+// Get the number of transactions accepted by the node
+func (n *fakeNode) sentCount() int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return len(n.sent)
+}
+
+// Get the mined or pending transaction count of an account
+func (n *fakeNode) getTransactionCount(params []json.RawMessage) (interface{}, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	var account common.Address
+	var block string
+	if err := json.Unmarshal(params[0], &account); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(params[1], &block); err != nil {
+		return nil, err
+	}
+	nonce := n.mined[account]
+	if block == "pending" {
+		for n.pending[account][nonce] != nil {
+			nonce++
+		}
+	}
+	return hexutil.Uint64(nonce), nil
+}
+
+// Add a transaction to the pool, replacing a pending transaction at its nonce if its fees are high enough
+func (n *fakeNode) sendRawTransaction(params []json.RawMessage) (interface{}, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	var data hexutil.Bytes
+	if err := json.Unmarshal(params[0], &data); err != nil {
+		return nil, err
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(fakeChainID), tx)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Nonce() < n.mined[sender] {
+		return nil, fmt.Errorf("nonce too low")
+	}
+	if n.pending[sender] == nil {
+		n.pending[sender] = map[uint64]*types.Transaction{}
+	}
+	if existing := n.pending[sender][tx.Nonce()]; existing != nil {
+		if !feeBumped(existing.GasFeeCap(), tx.GasFeeCap()) || !feeBumped(existing.GasTipCap(), tx.GasTipCap()) {
+			return nil, fmt.Errorf("replacement transaction underpriced")
+		}
+	}
+	n.pending[sender][tx.Nonce()] = tx
+	n.senders[tx.Hash()] = sender
+	n.sent = append(n.sent, tx)
+	return tx.Hash(), nil
+}
+
+// Get a pending transaction
+func (n *fakeNode) getTransactionByHash(params []json.RawMessage) (interface{}, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	var hash common.Hash
+	if err := json.Unmarshal(params[0], &hash); err != nil {
+		return nil, err
+	}
+	sender := n.senders[hash]
+	var tx *types.Transaction
+	for _, pendingTx := range n.pending[sender] {
+		if pendingTx.Hash() == hash {
+			tx = pendingTx
+		}
+	}
+	if tx == nil {
+		return nil, nil
+	}
+	encoded, err := tx.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+	fields["blockNumber"] = nil
+	fields["blockHash"] = nil
+	fields["from"] = sender
+	return fields, nil
+}
+
+// Check that a replacement fee is at least 10% higher than the original fee, as geth requires
+func feeBumped(original *big.Int, replacement *big.Int) bool {
+	minimum := new(big.Int).Mul(original, big.NewInt(110))
+	return new(big.Int).Mul(replacement, big.NewInt(100)).Cmp(minimum) >= 0
+}
@@ -1,13 +1,22 @@
 package eth
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"math/big"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	uc "github.com/multisig-labs/gogopool-go/utils/client"
+	"golang.org/x/sync/errgroup"
 
+	"github.com/multisig-labs/gogopool-go/gogopool"
 	"github.com/multisig-labs/gogopool-go/utils/avax"
 
 	"github.com/multisig-labs/gogopool-go/tests"
@@ -18,6 +27,9 @@ import (
 
 func TestSendTransaction(t *testing.T) {
 
+	// Initialize RPC session, skipping the test if there is no fixture to replay
+	rpcSession := getRPCSession(t)
+
 	// State snapshotting
 	if err := evm.TakeSnapshot(); err != nil {
 		t.Fatal(err)
@@ -29,7 +41,7 @@ func TestSendTransaction(t *testing.T) {
 	})
 
 	// Initialize eth client
-	client := uc.NewEth1ClientProxy(0, tests.Eth1ProviderAddress)
+	client := rpcSession.NewClient(tests.Eth1ProviderAddress)
 
 	// Initialize accounts
 	userAccount, err := accounts.GetAccount(9)
@@ -60,3 +72,376 @@ func TestSendTransaction(t *testing.T) {
 	}
 
 }
+
+func TestSendTransactionStickyEndpoint(t *testing.T) {
+
+	// Initialize two fake nodes behind one client
+	nodes := []*fakeNode{newFakeNode(t), newFakeNode(t)}
+	client := uc.NewEth1ClientProxy(0, nodes[0].server.URL, nodes[1].server.URL)
+	client.ProbeEndpoints(context.Background())
+	userAccount, err := accounts.GetAccount(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(userAccount.PrivateKey, fakeChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.GasTipCap = big.NewInt(1e9)
+	opts.GasFeeCap = big.NewInt(2e9)
+
+	// Send transactions
+	for i := 0; i < 4; i++ {
+		if _, err := avax.SendTransaction(client, common.HexToAddress("0x1111111111111111111111111111111111111111"), fakeChainID, opts); err != nil {
+			t.Fatalf("Could not send transaction %d: %s", i, err)
+		}
+	}
+
+	// Each transaction's nonce lookup, gas estimate & send went to the same node
+	sent := 0
+	for ni, node := range nodes {
+		count := node.sentCount()
+		if nonces := node.server.Count("eth_getTransactionCount"); nonces != count {
+			t.Errorf("Node %d answered %d nonce lookups for %d transactions", ni, nonces, count)
+		}
+		if estimates := node.server.Count("eth_estimateGas"); estimates != count {
+			t.Errorf("Node %d answered %d gas estimates for %d transactions", ni, estimates, count)
+		}
+		sent += count
+	}
+	if sent != 4 {
+		t.Errorf("Incorrect sent transaction count %d", sent)
+	}
+
+}
+
+func TestEstimateSendTransactionGasWithoutFees(t *testing.T) {
+
+	// Initialize a fake node without a fee history or block headers
+	node := newFakeNode(t)
+	client := uc.NewEth1ClientProxy(0, node.server.URL)
+	userAccount, err := accounts.GetAccount(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Gas limits are returned without costs
+	gasInfo, err := avax.EstimateSendTransactionGas(client, common.HexToAddress("0x1111111111111111111111111111111111111111"), userAccount.GetTransactor())
+	if err != nil {
+		t.Fatalf("Could not estimate gas: %s", err)
+	}
+	if gasInfo.EstGasLimit != 21000 || gasInfo.SafeGasLimit != 21000 {
+		t.Errorf("Incorrect gas limits %d / %d", gasInfo.EstGasLimit, gasInfo.SafeGasLimit)
+	}
+	if gasInfo.Costs != nil {
+		t.Errorf("Costs were set without fee estimates: %v", gasInfo.Costs)
+	}
+
+}
+
+func TestEstimateSendTransactionGasCancelled(t *testing.T) {
+
+	// Initialize a fake node which cancels the context while fees are estimated
+	node := newFakeNode(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node.server.Handle("eth_maxPriorityFeePerGas", func(params []json.RawMessage) (interface{}, error) {
+		cancel()
+		return (*hexutil.Big)(big.NewInt(1e9)), nil
+	})
+	client := uc.NewEth1ClientProxy(0, node.server.URL)
+	userAccount, err := accounts.GetAccount(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The cancellation is returned instead of being dropped with the fee estimates
+	if _, err := avax.EstimateSendTransactionGasContext(ctx, client, common.HexToAddress("0x1111111111111111111111111111111111111111"), userAccount.GetTransactor()); !errors.Is(err, context.Canceled) {
+		t.Errorf("Incorrect cancelled estimate error: %v", err)
+	}
+
+}
+
+func TestTransactionManagerWaitForReceipt(t *testing.T) {
+
+	// Initialize RPC session, skipping the test if there is no fixture to replay
+	rpcSession := getRPCSession(t)
+
+	// State snapshotting
+	if err := evm.TakeSnapshot(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := evm.RevertSnapshot(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// Initialize eth client & transaction manager
+	client := rpcSession.NewClient(tests.Eth1ProviderAddress)
+	txManager := gogopool.NewTransactionManager(client, 1)
+
+	// Initialize accounts
+	userAccount, err := accounts.GetAccount(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Send transaction
+	opts := userAccount.GetTransactor()
+	opts.Value = avax.EthToWei(1)
+	hash, err := avax.SendTransaction(client, common.HexToAddress("0x1111111111111111111111111111111111111111"), big.NewInt(1337), opts) // Ganache's default chain ID is 1337
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Wait for receipt
+	receipt, err := txManager.WaitForReceipt(hash)
+	if err != nil {
+		t.Fatal(err)
+	} else if receipt.TxHash != hash {
+		t.Errorf("Incorrect receipt transaction hash %s", receipt.TxHash.Hex())
+	}
+
+	// Check that a mined transaction can't be sped up
+	if _, err := txManager.SpeedUp(hash, opts); err == nil {
+		t.Error("Mined transaction was sped up")
+	}
+
+}
+
+func TestTransactionManagerReplace(t *testing.T) {
+
+	// Initialize a fake node & transaction manager
+	node := newFakeNode(t)
+	client := uc.NewEth1ClientProxy(0, node.server.URL)
+	txManager := gogopool.NewTransactionManager(client, 1)
+
+	// Initialize accounts
+	userAccount, err := accounts.GetAccount(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherAccount, err := accounts.GetAccount(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(userAccount.PrivateKey, fakeChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherOpts, err := bind.NewKeyedTransactorWithChainID(otherAccount.PrivateKey, fakeChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Send a transaction which stays pending
+	toAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	tx, err := opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   fakeChainID,
+		Nonce:     0,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(2e9),
+		Gas:       50000,
+		To:        &toAddress,
+		Value:     big.NewInt(1),
+		Data:      []byte{0x01},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Speed the transaction up twice; each replacement must outbid the last one
+	previous := tx
+	for i := 0; i < 2; i++ {
+		if _, err := txManager.SpeedUp(tx.Hash(), opts); err != nil {
+			t.Fatalf("Could not speed up transaction (attempt %d): %s", i+1, err)
+		}
+		replacement := node.getPendingTransaction(opts.From, 0)
+		if replacement.Hash() == previous.Hash() {
+			t.Fatalf("Transaction was not replaced (attempt %d)", i+1)
+		}
+		if replacement.GasFeeCap().Cmp(previous.GasFeeCap()) <= 0 || replacement.GasTipCap().Cmp(previous.GasTipCap()) <= 0 {
+			t.Errorf("Replacement fees %s / %s were not bumped from %s / %s", replacement.GasFeeCap(), replacement.GasTipCap(), previous.GasFeeCap(), previous.GasTipCap())
+		}
+		if *replacement.To() != toAddress || replacement.Value().Cmp(big.NewInt(1)) != 0 || !bytes.Equal(replacement.Data(), []byte{0x01}) {
+			t.Errorf("Replacement payload was changed (attempt %d)", i+1)
+		}
+		previous = replacement
+	}
+
+	// Check that another account can't cancel the transaction
+	if _, err := txManager.Cancel(tx.Hash(), otherOpts); err == nil {
+		t.Error("Transaction was cancelled by another account")
+	}
+
+	// Cancel the transaction
+	if _, err := txManager.Cancel(tx.Hash(), opts); err != nil {
+		t.Fatalf("Could not cancel transaction: %s", err)
+	}
+	cancellation := node.getPendingTransaction(opts.From, 0)
+	if *cancellation.To() != opts.From || cancellation.Value().Sign() != 0 || len(cancellation.Data()) != 0 {
+		t.Errorf("Incorrect cancellation transaction to %s with value %s", cancellation.To().Hex(), cancellation.Value())
+	}
+	if cancellation.GasFeeCap().Cmp(previous.GasFeeCap()) <= 0 {
+		t.Errorf("Cancellation fee %s was not bumped from %s", cancellation.GasFeeCap(), previous.GasFeeCap())
+	}
+	if node.sentCount() != 4 {
+		t.Errorf("Incorrect sent transaction count %d", node.sentCount())
+	}
+
+}
+
+func TestNonceManager(t *testing.T) {
+
+	// Initialize RPC session, skipping the test if there is no fixture to replay
+	rpcSession := getRPCSession(t)
+
+	// Initialize eth client
+	client := rpcSession.NewClient(tests.Eth1ProviderAddress)
+
+	// Initialize accounts & nonce manager
+	userAccount, err := accounts.GetAccount(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonceManager := gogopool.NewNonceManager(client, userAccount.Address)
+
+	// Get pending nonce
+	pendingNonce, err := client.PendingNonceAt(context.Background(), userAccount.Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reserve nonces concurrently
+	nonceCount := 10
+	nonces := make([]uint64, nonceCount)
+	var wg errgroup.Group
+	for ni := 0; ni < nonceCount; ni++ {
+		ni := ni
+		wg.Go(func() error {
+			nonce, err := nonceManager.NextNonce()
+			nonces[ni] = nonce
+			return err
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Check nonces are unique & sequential
+	seen := make(map[uint64]bool, nonceCount)
+	for _, nonce := range nonces {
+		if seen[nonce] || nonce < pendingNonce || nonce >= pendingNonce+uint64(nonceCount) {
+			t.Errorf("Incorrect reserved nonce %d", nonce)
+		}
+		seen[nonce] = true
+	}
+
+	// Release a nonce and check that it is reused
+	nonceManager.ReleaseNonce(pendingNonce + 2)
+	if nonce, err := nonceManager.NextNonce(); err != nil {
+		t.Error(err)
+	} else if nonce != pendingNonce+2 {
+		t.Errorf("Incorrect reused nonce %d", nonce)
+	}
+
+}
+
+func TestNonceManagerGap(t *testing.T) {
+
+	// Initialize a fake node & nonce manager
+	node := newFakeNode(t)
+	client := uc.NewEth1ClientProxy(0, node.server.URL)
+	userAccount, err := accounts.GetAccount(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(userAccount.PrivateKey, fakeChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonceManager := gogopool.NewNonceManager(client, userAccount.Address)
+	nonceManager.SyncInterval = 50 * time.Millisecond
+
+	// Send transactions through the nonce manager
+	toAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	send := func(txOpts *bind.TransactOpts) (common.Hash, error) {
+		tx, err := txOpts.Signer(txOpts.From, types.NewTx(&types.DynamicFeeTx{
+			ChainID:   fakeChainID,
+			Nonce:     txOpts.Nonce.Uint64(),
+			GasTipCap: big.NewInt(1e9),
+			GasFeeCap: big.NewInt(2e9),
+			Gas:       21000,
+			To:        &toAddress,
+		}))
+		if err != nil {
+			return common.Hash{}, err
+		}
+		return tx.Hash(), client.SendTransaction(txOpts.Context, tx)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := nonceManager.Send(opts, send); err != nil {
+			t.Fatalf("Could not send transaction %d: %s", i, err)
+		}
+	}
+
+	// Drop a transaction, leaving a gap in the pending pool
+	node.drop(userAccount.Address, 1)
+
+	// Recently sent nonces are kept on resync
+	if err := nonceManager.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if nonce, err := nonceManager.NextNonce(); err != nil {
+		t.Fatal(err)
+	} else if nonce != 3 {
+		t.Errorf("Incorrect nonce %d after resyncing with recently sent nonces", nonce)
+	}
+	nonceManager.ReleaseNonce(3)
+
+	// Once the sync interval has passed, the dropped nonce is released and refilled
+	time.Sleep(2 * nonceManager.SyncInterval)
+	if err := nonceManager.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := nonceManager.Send(opts, send); err != nil {
+		t.Fatalf("Could not refill dropped nonce: %s", err)
+	}
+	if node.getPendingTransaction(userAccount.Address, 1) == nil {
+		t.Fatal("Dropped nonce was not refilled")
+	}
+
+	// The next send recovers from the nonce still in the pending pool
+	if _, err := nonceManager.Send(opts, send); err != nil {
+		t.Fatalf("Could not send transaction after refilling the gap: %s", err)
+	}
+	if node.getPendingTransaction(userAccount.Address, 3) == nil {
+		t.Error("Transaction was not sent at the next free nonce")
+	}
+
+	// Sent nonces are dropped once mined
+	node.mine(userAccount.Address)
+	if err := nonceManager.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if nonce, err := nonceManager.NextNonce(); err != nil {
+		t.Fatal(err)
+	} else if nonce != 4 {
+		t.Errorf("Incorrect nonce %d after mining", nonce)
+	}
+
+}
+
+// Get the test RPC session, skipping tests which need the node if it has no fixture to replay
+func getRPCSession(t *testing.T) *tests.RPCSession {
+	tests.RequireNode(t)
+	rpcSession, err := tests.GetRPCSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rpcSession
+}
@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"context"
 	"math/big"
 	"sync"
 
@@ -16,83 +17,110 @@ import (
 
 // Get fixed-supply GGP total supply
 func GetFixedSupplyGGPTotalSupply(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return GetFixedSupplyGGPTotalSupplyContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetFixedSupplyGGPTotalSupplyContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return totalSupply(gogoTokenFixedSupplyGGP, "fixed-supply GGP", opts)
+	return totalSupply(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", opts)
 }
 
 // Get fixed-supply GGP balance
 func GetFixedSupplyGGPBalance(ggp *gogopool.GoGoPool, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return GetFixedSupplyGGPBalanceContext(gogopool.CallOptsContext(opts), ggp, address, opts)
+}
+func GetFixedSupplyGGPBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return balanceOf(gogoTokenFixedSupplyGGP, "fixed-supply GGP", address, opts)
+	return balanceOf(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", address, opts)
 }
 
 // Get fixed-supply GGP allowance
 func GetFixedSupplyGGPAllowance(ggp *gogopool.GoGoPool, owner, spender common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return GetFixedSupplyGGPAllowanceContext(gogopool.CallOptsContext(opts), ggp, owner, spender, opts)
+}
+func GetFixedSupplyGGPAllowanceContext(ctx context.Context, ggp *gogopool.GoGoPool, owner, spender common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return allowance(gogoTokenFixedSupplyGGP, "fixed-supply GGP", owner, spender, opts)
+	return allowance(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", owner, spender, opts)
 }
 
 // Estimate the gas of TransferFixedSupplyGGP
 func EstimateTransferFixedSupplyGGPGas(ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return EstimateTransferFixedSupplyGGPGasContext(gogopool.TransactOptsContext(opts), ggp, to, amount, opts)
+}
+func EstimateTransferFixedSupplyGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateTransferGas(gogoTokenFixedSupplyGGP, "fixed-supply GGP", to, amount, opts)
+	return estimateTransferGas(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", to, amount, opts)
 }
 
 // Transfer fixed-supply GGP
 func TransferFixedSupplyGGP(ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return TransferFixedSupplyGGPContext(gogopool.TransactOptsContext(opts), ggp, to, amount, opts)
+}
+func TransferFixedSupplyGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return transfer(gogoTokenFixedSupplyGGP, "fixed-supply GGP", to, amount, opts)
+	return transfer(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", to, amount, opts)
 }
 
 // Estimate the gas of ApproveFixedSupplyGGP
 func EstimateApproveFixedSupplyGGPGas(ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return EstimateApproveFixedSupplyGGPGasContext(gogopool.TransactOptsContext(opts), ggp, spender, amount, opts)
+}
+func EstimateApproveFixedSupplyGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateApproveGas(gogoTokenFixedSupplyGGP, "fixed-supply GGP", spender, amount, opts)
+	return estimateApproveGas(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", spender, amount, opts)
 }
 
 // Approve an fixed-supply GGP spender
 func ApproveFixedSupplyGGP(ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return ApproveFixedSupplyGGPContext(gogopool.TransactOptsContext(opts), ggp, spender, amount, opts)
+}
+func ApproveFixedSupplyGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return approve(gogoTokenFixedSupplyGGP, "fixed-supply GGP", spender, amount, opts)
+	return approve(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", spender, amount, opts)
 }
 
 // Estimate the gas of TransferFromFixedSupplyGGP
 func EstimateTransferFromFixedSupplyGGPGas(ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return EstimateTransferFromFixedSupplyGGPGasContext(gogopool.TransactOptsContext(opts), ggp, from, to, amount, opts)
+}
+func EstimateTransferFromFixedSupplyGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateTransferFromGas(gogoTokenFixedSupplyGGP, "fixed-supply GGP", from, to, amount, opts)
+	return estimateTransferFromGas(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", from, to, amount, opts)
 }
 
 // Transfer fixed-supply GGP from a sender
 func TransferFromFixedSupplyGGP(ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ggp)
+	return TransferFromFixedSupplyGGPContext(gogopool.TransactOptsContext(opts), ggp, from, to, amount, opts)
+}
+func TransferFromFixedSupplyGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenFixedSupplyGGP, err := getGoGoTokenGGPFixedSupply(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return transferFrom(gogoTokenFixedSupplyGGP, "fixed-supply GGP", from, to, amount, opts)
+	return transferFrom(ctx, gogoTokenFixedSupplyGGP, "fixed-supply GGP", from, to, amount, opts)
 }
 
 //
@@ -102,8 +130,8 @@ func TransferFromFixedSupplyGGP(ggp *gogopool.GoGoPool, from, to common.Address,
 // Get contracts
 var gogoTokenFixedSupplyGGPLock sync.Mutex
 
-func getGoGoTokenGGPFixedSupply(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoTokenGGPFixedSupply(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoTokenFixedSupplyGGPLock.Lock()
 	defer gogoTokenFixedSupplyGGPLock.Unlock()
-	return ggp.GetContract("rocketTokenGGPFixedSupply")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractTokenGGPFixedSupply))
 }
@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -17,83 +18,110 @@ import (
 
 // Get GGP total supply
 func GetGGPTotalSupply(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return GetGGPTotalSupplyContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetGGPTotalSupplyContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return totalSupply(gogoTokenGGP, "GGP", opts)
+	return totalSupply(ctx, gogoTokenGGP, "GGP", opts)
 }
 
 // Get GGP balance
 func GetGGPBalance(ggp *gogopool.GoGoPool, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return GetGGPBalanceContext(gogopool.CallOptsContext(opts), ggp, address, opts)
+}
+func GetGGPBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return balanceOf(gogoTokenGGP, "GGP", address, opts)
+	return balanceOf(ctx, gogoTokenGGP, "GGP", address, opts)
 }
 
 // Get GGP allowance
 func GetGGPAllowance(ggp *gogopool.GoGoPool, owner, spender common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return GetGGPAllowanceContext(gogopool.CallOptsContext(opts), ggp, owner, spender, opts)
+}
+func GetGGPAllowanceContext(ctx context.Context, ggp *gogopool.GoGoPool, owner, spender common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return allowance(gogoTokenGGP, "GGP", owner, spender, opts)
+	return allowance(ctx, gogoTokenGGP, "GGP", owner, spender, opts)
 }
 
 // Estimate the gas of TransferGGP
 func EstimateTransferGGPGas(ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return EstimateTransferGGPGasContext(gogopool.TransactOptsContext(opts), ggp, to, amount, opts)
+}
+func EstimateTransferGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateTransferGas(gogoTokenGGP, "GGP", to, amount, opts)
+	return estimateTransferGas(ctx, gogoTokenGGP, "GGP", to, amount, opts)
 }
 
 // Transfer GGP
 func TransferGGP(ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return TransferGGPContext(gogopool.TransactOptsContext(opts), ggp, to, amount, opts)
+}
+func TransferGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return transfer(gogoTokenGGP, "GGP", to, amount, opts)
+	return transfer(ctx, gogoTokenGGP, "GGP", to, amount, opts)
 }
 
 // Estimate the gas of ApproveGGP
 func EstimateApproveGGPGas(ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return EstimateApproveGGPGasContext(gogopool.TransactOptsContext(opts), ggp, spender, amount, opts)
+}
+func EstimateApproveGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateApproveGas(gogoTokenGGP, "GGP", spender, amount, opts)
+	return estimateApproveGas(ctx, gogoTokenGGP, "GGP", spender, amount, opts)
 }
 
 // Approve an GGP spender
 func ApproveGGP(ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return ApproveGGPContext(gogopool.TransactOptsContext(opts), ggp, spender, amount, opts)
+}
+func ApproveGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return approve(gogoTokenGGP, "GGP", spender, amount, opts)
+	return approve(ctx, gogoTokenGGP, "GGP", spender, amount, opts)
 }
 
 // Estimate the gas of TransferFromGGP
 func EstimateTransferFromGGPGas(ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return EstimateTransferFromGGPGasContext(gogopool.TransactOptsContext(opts), ggp, from, to, amount, opts)
+}
+func EstimateTransferFromGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateTransferFromGas(gogoTokenGGP, "GGP", from, to, amount, opts)
+	return estimateTransferFromGas(ctx, gogoTokenGGP, "GGP", from, to, amount, opts)
 }
 
 // Transfer GGP from a sender
 func TransferFromGGP(ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return TransferFromGGPContext(gogopool.TransactOptsContext(opts), ggp, from, to, amount, opts)
+}
+func TransferFromGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return transferFrom(gogoTokenGGP, "GGP", from, to, amount, opts)
+	return transferFrom(ctx, gogoTokenGGP, "GGP", from, to, amount, opts)
 }
 
 //
@@ -102,20 +130,26 @@ func TransferFromGGP(ggp *gogopool.GoGoPool, from, to common.Address, amount *bi
 
 // Estimate the gas of MintInflationGGP
 func EstimateMintInflationGGPGas(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return EstimateMintInflationGGPGasContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func EstimateMintInflationGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoTokenGGP.GetTransactionGasInfo(opts, "inflationMintTokens")
+	return gogoTokenGGP.GetTransactionGasInfoContext(ctx, opts, "inflationMintTokens")
 }
 
 // Mint new GGP tokens from inflation
 func MintInflationGGP(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return MintInflationGGPContext(gogopool.TransactOptsContext(opts), ggp, opts)
+}
+func MintInflationGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoTokenGGP.Transact(opts, "inflationMintTokens")
+	hash, err := gogoTokenGGP.TransactContext(ctx, opts, "inflationMintTokens")
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not mint GGP tokens from inflation: %w", err)
 	}
@@ -124,20 +158,26 @@ func MintInflationGGP(ggp *gogopool.GoGoPool, opts *bind.TransactOpts) (common.H
 
 // Estimate the gas of SwapFixedSupplyGGPForGGP
 func EstimateSwapFixedSupplyGGPForGGPGas(ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return EstimateSwapFixedSupplyGGPForGGPGasContext(gogopool.TransactOptsContext(opts), ggp, amount, opts)
+}
+func EstimateSwapFixedSupplyGGPForGGPGasContext(ctx context.Context, ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoTokenGGP.GetTransactionGasInfo(opts, "swapTokens", amount)
+	return gogoTokenGGP.GetTransactionGasInfoContext(ctx, opts, "swapTokens", amount)
 }
 
 // Swap fixed-supply GGP for new GGP tokens
 func SwapFixedSupplyGGPForGGP(ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return SwapFixedSupplyGGPForGGPContext(gogopool.TransactOptsContext(opts), ggp, amount, opts)
+}
+func SwapFixedSupplyGGPForGGPContext(ctx context.Context, ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoTokenGGP.Transact(opts, "swapTokens", amount)
+	hash, err := gogoTokenGGP.TransactContext(ctx, opts, "swapTokens", amount)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not swap fixed-supply GGP for new GGP: %w", err)
 	}
@@ -146,12 +186,15 @@ func SwapFixedSupplyGGPForGGP(ggp *gogopool.GoGoPool, amount *big.Int, opts *bin
 
 // Get the GGP inflation interval rate
 func GetGGPInflationIntervalRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenGGP, err := getGoGoTokenGGP(ggp)
+	return GetGGPInflationIntervalRateContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetGGPInflationIntervalRateContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenGGP, err := getGoGoTokenGGP(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	rate := new(*big.Int)
-	if err := gogoTokenGGP.Call(opts, rate, "getInflationIntervalRate"); err != nil {
+	if err := gogoTokenGGP.CallContext(ctx, opts, rate, "getInflationIntervalRate"); err != nil {
 		return nil, fmt.Errorf("Could not get GGP inflation interval rate: %w", err)
 	}
 	return *rate, nil
@@ -164,8 +207,8 @@ func GetGGPInflationIntervalRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*
 // Get contracts
 var gogoTokenGGPLock sync.Mutex
 
-func getGoGoTokenGGP(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoTokenGGP(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoTokenGGPLock.Lock()
 	defer gogoTokenGGPLock.Unlock()
-	return ggp.GetContract("rocketTokenRPL")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractTokenGGP))
 }
@@ -1,6 +1,7 @@
 package tokens
 
 import (
+	"context"
 	"fmt"
 	"github.com/multisig-labs/gogopool-go/gogopool"
 	"math/big"
@@ -18,83 +19,110 @@ import (
 
 // Get rETH total supply
 func GetRETHTotalSupply(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetRETHTotalSupplyContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetRETHTotalSupplyContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return totalSupply(gogoTokenRETH, "rETH", opts)
+	return totalSupply(ctx, gogoTokenRETH, "rETH", opts)
 }
 
 // Get rETH balance
 func GetRETHBalance(ggp *gogopool.GoGoPool, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetRETHBalanceContext(gogopool.CallOptsContext(opts), ggp, address, opts)
+}
+func GetRETHBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return balanceOf(gogoTokenRETH, "rETH", address, opts)
+	return balanceOf(ctx, gogoTokenRETH, "rETH", address, opts)
 }
 
 // Get rETH allowance
 func GetRETHAllowance(ggp *gogopool.GoGoPool, owner, spender common.Address, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetRETHAllowanceContext(gogopool.CallOptsContext(opts), ggp, owner, spender, opts)
+}
+func GetRETHAllowanceContext(ctx context.Context, ggp *gogopool.GoGoPool, owner, spender common.Address, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return allowance(gogoTokenRETH, "rETH", owner, spender, opts)
+	return allowance(ctx, gogoTokenRETH, "rETH", owner, spender, opts)
 }
 
 // Estimate the gas of TransferRETH
 func EstimateTransferRETHGas(ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return EstimateTransferRETHGasContext(gogopool.TransactOptsContext(opts), ggp, to, amount, opts)
+}
+func EstimateTransferRETHGasContext(ctx context.Context, ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateTransferGas(gogoTokenRETH, "rETH", to, amount, opts)
+	return estimateTransferGas(ctx, gogoTokenRETH, "rETH", to, amount, opts)
 }
 
 // Transfer rETH
 func TransferRETH(ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return TransferRETHContext(gogopool.TransactOptsContext(opts), ggp, to, amount, opts)
+}
+func TransferRETHContext(ctx context.Context, ggp *gogopool.GoGoPool, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return transfer(gogoTokenRETH, "rETH", to, amount, opts)
+	return transfer(ctx, gogoTokenRETH, "rETH", to, amount, opts)
 }
 
 // Estimate the gas of ApproveRETH
 func EstimateApproveRETHGas(ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return EstimateApproveRETHGasContext(gogopool.TransactOptsContext(opts), ggp, spender, amount, opts)
+}
+func EstimateApproveRETHGasContext(ctx context.Context, ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateApproveGas(gogoTokenRETH, "rETH", spender, amount, opts)
+	return estimateApproveGas(ctx, gogoTokenRETH, "rETH", spender, amount, opts)
 }
 
 // Approve a rETH spender
 func ApproveRETH(ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return ApproveRETHContext(gogopool.TransactOptsContext(opts), ggp, spender, amount, opts)
+}
+func ApproveRETHContext(ctx context.Context, ggp *gogopool.GoGoPool, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return approve(gogoTokenRETH, "rETH", spender, amount, opts)
+	return approve(ctx, gogoTokenRETH, "rETH", spender, amount, opts)
 }
 
 // Estimate the gas of TransferFromRETH
 func EstimateTransferFromRETHGas(ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return EstimateTransferFromRETHGasContext(gogopool.TransactOptsContext(opts), ggp, from, to, amount, opts)
+}
+func EstimateTransferFromRETHGasContext(ctx context.Context, ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return estimateTransferFromGas(gogoTokenRETH, "rETH", from, to, amount, opts)
+	return estimateTransferFromGas(ctx, gogoTokenRETH, "rETH", from, to, amount, opts)
 }
 
 // Transfer rETH from a sender
 func TransferFromRETH(ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return TransferFromRETHContext(gogopool.TransactOptsContext(opts), ggp, from, to, amount, opts)
+}
+func TransferFromRETHContext(ctx context.Context, ggp *gogopool.GoGoPool, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	return transferFrom(gogoTokenRETH, "rETH", from, to, amount, opts)
+	return transferFrom(ctx, gogoTokenRETH, "rETH", from, to, amount, opts)
 }
 
 //
@@ -103,21 +131,27 @@ func TransferFromRETH(ggp *gogopool.GoGoPool, from, to common.Address, amount *b
 
 // Get the rETH contract ETH balance
 func GetRETHContractETHBalance(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetRETHContractETHBalanceContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetRETHContractETHBalanceContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
-	return contractETHBalance(ggp, gogoTokenRETH, opts)
+	return contractETHBalance(ctx, ggp, gogoTokenRETH, opts)
 }
 
 // Get the ETH value of an amount of rETH
 func GetETHValueOfRETH(ggp *gogopool.GoGoPool, rethAmount *big.Int, opts *bind.CallOpts) (*big.Int, error) {
-	GoGoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetETHValueOfRETHContext(gogopool.CallOptsContext(opts), ggp, rethAmount, opts)
+}
+func GetETHValueOfRETHContext(ctx context.Context, ggp *gogopool.GoGoPool, rethAmount *big.Int, opts *bind.CallOpts) (*big.Int, error) {
+	GoGoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	ethValue := new(*big.Int)
-	if err := GoGoTokenRETH.Call(opts, ethValue, "getEthValue", rethAmount); err != nil {
+	if err := GoGoTokenRETH.CallContext(ctx, opts, ethValue, "getEthValue", rethAmount); err != nil {
 		return nil, fmt.Errorf("Could not get ETH value of rETH amount: %w", err)
 	}
 	return *ethValue, nil
@@ -125,12 +159,15 @@ func GetETHValueOfRETH(ggp *gogopool.GoGoPool, rethAmount *big.Int, opts *bind.C
 
 // Get the rETH value of an amount of ETH
 func GetRETHValueOfETH(ggp *gogopool.GoGoPool, ethAmount *big.Int, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetRETHValueOfETHContext(gogopool.CallOptsContext(opts), ggp, ethAmount, opts)
+}
+func GetRETHValueOfETHContext(ctx context.Context, ggp *gogopool.GoGoPool, ethAmount *big.Int, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	rethValue := new(*big.Int)
-	if err := gogoTokenRETH.Call(opts, rethValue, "getRethValue", ethAmount); err != nil {
+	if err := gogoTokenRETH.CallContext(ctx, opts, rethValue, "getRethValue", ethAmount); err != nil {
 		return nil, fmt.Errorf("Could not get rETH value of ETH amount: %w", err)
 	}
 	return *rethValue, nil
@@ -138,12 +175,15 @@ func GetRETHValueOfETH(ggp *gogopool.GoGoPool, ethAmount *big.Int, opts *bind.Ca
 
 // Get the current ETH : rETH exchange rate
 func GetRETHExchangeRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetRETHExchangeRateContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetRETHExchangeRateContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	exchangeRate := new(*big.Int)
-	if err := gogoTokenRETH.Call(opts, exchangeRate, "getExchangeRate"); err != nil {
+	if err := gogoTokenRETH.CallContext(ctx, opts, exchangeRate, "getExchangeRate"); err != nil {
 		return 0, fmt.Errorf("Could not get rETH exchange rate: %w", err)
 	}
 	return avax.WeiToEth(*exchangeRate), nil
@@ -151,12 +191,15 @@ func GetRETHExchangeRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64,
 
 // Get the total amount of ETH collateral available for rETH trades
 func GetRETHTotalCollateral(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetRETHTotalCollateralContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetRETHTotalCollateralContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.Int, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
 	totalCollateral := new(*big.Int)
-	if err := gogoTokenRETH.Call(opts, totalCollateral, "getTotalCollateral"); err != nil {
+	if err := gogoTokenRETH.CallContext(ctx, opts, totalCollateral, "getTotalCollateral"); err != nil {
 		return nil, fmt.Errorf("Could not get rETH total collateral: %w", err)
 	}
 	return *totalCollateral, nil
@@ -164,12 +207,15 @@ func GetRETHTotalCollateral(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (*big.I
 
 // Get the rETH collateralization rate
 func GetRETHCollateralRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return GetRETHCollateralRateContext(gogopool.CallOptsContext(opts), ggp, opts)
+}
+func GetRETHCollateralRateContext(ctx context.Context, ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return 0, err
 	}
 	collateralRate := new(*big.Int)
-	if err := gogoTokenRETH.Call(opts, collateralRate, "getCollateralRate"); err != nil {
+	if err := gogoTokenRETH.CallContext(ctx, opts, collateralRate, "getCollateralRate"); err != nil {
 		return 0, fmt.Errorf("Could not get rETH collateral rate: %w", err)
 	}
 	return avax.WeiToEth(*collateralRate), nil
@@ -177,20 +223,26 @@ func GetRETHCollateralRate(ggp *gogopool.GoGoPool, opts *bind.CallOpts) (float64
 
 // Estimate the gas of BurnRETH
 func EstimateBurnRETHGas(ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return EstimateBurnRETHGasContext(gogopool.TransactOptsContext(opts), ggp, amount, opts)
+}
+func EstimateBurnRETHGasContext(ctx context.Context, ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return gogopool.GasInfo{}, err
 	}
-	return gogoTokenRETH.GetTransactionGasInfo(opts, "burn", amount)
+	return gogoTokenRETH.GetTransactionGasInfoContext(ctx, opts, "burn", amount)
 }
 
 // Burn rETH for ETH
 func BurnRETH(ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	gogoTokenRETH, err := getGoGoTokenRETH(ggp)
+	return BurnRETHContext(gogopool.TransactOptsContext(opts), ggp, amount, opts)
+}
+func BurnRETHContext(ctx context.Context, ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	gogoTokenRETH, err := getGoGoTokenRETH(ctx, ggp)
 	if err != nil {
 		return common.Hash{}, err
 	}
-	hash, err := gogoTokenRETH.Transact(opts, "burn", amount)
+	hash, err := gogoTokenRETH.TransactContext(ctx, opts, "burn", amount)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not burn rETH: %w", err)
 	}
@@ -204,8 +256,8 @@ func BurnRETH(ggp *gogopool.GoGoPool, amount *big.Int, opts *bind.TransactOpts)
 // Get contracts
 var gogoTokenRETHLock sync.Mutex
 
-func getGoGoTokenRETH(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoTokenRETH(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoTokenRETHLock.Lock()
 	defer gogoTokenRETHLock.Unlock()
-	return ggp.GetContract("rocketTokenRETH")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractTokenRETH))
 }
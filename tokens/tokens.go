@@ -22,6 +22,9 @@ type Balances struct {
 
 // Get token balances of an address
 func GetBalances(ggp *gogopool.GoGoPool, address common.Address, opts *bind.CallOpts) (Balances, error) {
+	return GetBalancesContext(gogopool.CallOptsContext(opts), ggp, address, opts)
+}
+func GetBalancesContext(ctx context.Context, ggp *gogopool.GoGoPool, address common.Address, opts *bind.CallOpts) (Balances, error) {
 
 	// Get call options block number
 	var blockNumber *big.Int
@@ -39,22 +42,22 @@ func GetBalances(ggp *gogopool.GoGoPool, address common.Address, opts *bind.Call
 	// Load data
 	wg.Go(func() error {
 		var err error
-		avaxBalance, err = ggp.Client.BalanceAt(context.Background(), address, blockNumber)
+		avaxBalance, err = ggp.Client.BalanceAt(ctx, address, blockNumber)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		gavaxBalance, err = GetRETHBalance(ggp, address, opts)
+		gavaxBalance, err = GetRETHBalanceContext(ctx, ggp, address, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		ggpBalance, err = GetGGPBalance(ggp, address, opts)
+		ggpBalance, err = GetGGPBalanceContext(ctx, ggp, address, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		fixedSupplyGgpBalance, err = GetFixedSupplyGGPBalance(ggp, address, opts)
+		fixedSupplyGgpBalance, err = GetFixedSupplyGGPBalanceContext(ctx, ggp, address, opts)
 		return err
 	})
 
@@ -74,49 +77,49 @@ func GetBalances(ggp *gogopool.GoGoPool, address common.Address, opts *bind.Call
 }
 
 // Get a token contract's ETH balance
-func contractETHBalance(ggp *gogopool.GoGoPool, tokenContract *gogopool.Contract, opts *bind.CallOpts) (*big.Int, error) {
+func contractETHBalance(ctx context.Context, ggp *gogopool.GoGoPool, tokenContract *gogopool.Contract, opts *bind.CallOpts) (*big.Int, error) {
 	var blockNumber *big.Int
 	if opts != nil {
 		blockNumber = opts.BlockNumber
 	}
-	return ggp.Client.BalanceAt(context.Background(), *(tokenContract.Address), blockNumber)
+	return ggp.Client.BalanceAt(ctx, *(tokenContract.Address), blockNumber)
 }
 
 // Get a token's total supply
-func totalSupply(tokenContract *gogopool.Contract, tokenName string, opts *bind.CallOpts) (*big.Int, error) {
+func totalSupply(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, opts *bind.CallOpts) (*big.Int, error) {
 	totalSupply := new(*big.Int)
-	if err := tokenContract.Call(opts, totalSupply, "totalSupply"); err != nil {
+	if err := tokenContract.CallContext(ctx, opts, totalSupply, "totalSupply"); err != nil {
 		return nil, fmt.Errorf("Could not get %s total supply: %w", tokenName, err)
 	}
 	return *totalSupply, nil
 }
 
 // Get a token balance
-func balanceOf(tokenContract *gogopool.Contract, tokenName string, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
+func balanceOf(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, address common.Address, opts *bind.CallOpts) (*big.Int, error) {
 	balance := new(*big.Int)
-	if err := tokenContract.Call(opts, balance, "balanceOf", address); err != nil {
+	if err := tokenContract.CallContext(ctx, opts, balance, "balanceOf", address); err != nil {
 		return nil, fmt.Errorf("Could not get %s balance of %s: %w", tokenName, address.Hex(), err)
 	}
 	return *balance, nil
 }
 
 // Get a spender's allowance for an address
-func allowance(tokenContract *gogopool.Contract, tokenName string, owner, spender common.Address, opts *bind.CallOpts) (*big.Int, error) {
+func allowance(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, owner, spender common.Address, opts *bind.CallOpts) (*big.Int, error) {
 	allowance := new(*big.Int)
-	if err := tokenContract.Call(opts, allowance, "allowance", owner, spender); err != nil {
+	if err := tokenContract.CallContext(ctx, opts, allowance, "allowance", owner, spender); err != nil {
 		return nil, fmt.Errorf("Could not get %s allowance of %s for %s: %w", tokenName, spender.Hex(), owner.Hex(), err)
 	}
 	return *allowance, nil
 }
 
 // Estimate the gas of transfer
-func estimateTransferGas(tokenContract *gogopool.Contract, tokenName string, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return tokenContract.GetTransactionGasInfo(opts, "transfer", to, amount)
+func estimateTransferGas(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return tokenContract.GetTransactionGasInfoContext(ctx, opts, "transfer", to, amount)
 }
 
 // Transfer tokens to an address
-func transfer(tokenContract *gogopool.Contract, tokenName string, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	hash, err := tokenContract.Transact(opts, "transfer", to, amount)
+func transfer(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	hash, err := tokenContract.TransactContext(ctx, opts, "transfer", to, amount)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not transfer %s to %s: %w", tokenName, to.Hex(), err)
 	}
@@ -124,13 +127,13 @@ func transfer(tokenContract *gogopool.Contract, tokenName string, to common.Addr
 }
 
 // Estimate the gas of approve
-func estimateApproveGas(tokenContract *gogopool.Contract, tokenName string, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return tokenContract.GetTransactionGasInfo(opts, "approve", spender, amount)
+func estimateApproveGas(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return tokenContract.GetTransactionGasInfoContext(ctx, opts, "approve", spender, amount)
 }
 
 // Approve a token allowance for a spender
-func approve(tokenContract *gogopool.Contract, tokenName string, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	hash, err := tokenContract.Transact(opts, "approve", spender, amount)
+func approve(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, spender common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	hash, err := tokenContract.TransactContext(ctx, opts, "approve", spender, amount)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not approve %s allowance for %s: %w", tokenName, spender.Hex(), err)
 	}
@@ -138,13 +141,13 @@ func approve(tokenContract *gogopool.Contract, tokenName string, spender common.
 }
 
 // Estimate the gas of transferFrom
-func estimateTransferFromGas(tokenContract *gogopool.Contract, tokenName string, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
-	return tokenContract.GetTransactionGasInfo(opts, "transferFrom", from, to, amount)
+func estimateTransferFromGas(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return tokenContract.GetTransactionGasInfoContext(ctx, opts, "transferFrom", from, to, amount)
 }
 
 // Transfer tokens from a sender to an address
-func transferFrom(tokenContract *gogopool.Contract, tokenName string, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
-	hash, err := tokenContract.Transact(opts, "transferFrom", from, to, amount)
+func transferFrom(ctx context.Context, tokenContract *gogopool.Contract, tokenName string, from, to common.Address, amount *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	hash, err := tokenContract.TransactContext(ctx, opts, "transferFrom", from, to, amount)
 	if err != nil {
 		return common.Hash{}, fmt.Errorf("Could not transfer %s from %s to %s: %w", tokenName, from.Hex(), to.Hex(), err)
 	}
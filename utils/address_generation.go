@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -24,19 +25,22 @@ func GetNodeSalt(nodeAddress common.Address, salt *big.Int) common.Hash {
 // Precompute the address of a minipool based on the node wallet, deposit type, and unique salt
 // If you set minipoolBytecode to nil, this will retrieve it from the contracts using minipool.GetMinipoolBytecode().
 func GenerateAddress(ggp *gogopool.GoGoPool, nodeAddress common.Address, depositType ggptypes.MinipoolDeposit, salt *big.Int, minipoolBytecode []byte) (common.Address, error) {
+	return GenerateAddressContext(context.Background(), ggp, nodeAddress, depositType, salt, minipoolBytecode)
+}
+func GenerateAddressContext(ctx context.Context, ggp *gogopool.GoGoPool, nodeAddress common.Address, depositType ggptypes.MinipoolDeposit, salt *big.Int, minipoolBytecode []byte) (common.Address, error) {
 
 	// Get dependencies
-	gogoMinipoolManager, err := getGoGoMinipoolManager(ggp)
+	gogoMinipoolManager, err := getGoGoMinipoolManager(ctx, ggp)
 	if err != nil {
 		return common.Address{}, err
 	}
-	minipoolAbi, err := ggp.GetABI("rocketMinipool")
+	minipoolAbi, err := ggp.GetABIContext(ctx, ggp.ContractName(gogopool.ContractMinipool))
 	if err != nil {
 		return common.Address{}, err
 	}
 
 	if len(minipoolBytecode) == 0 {
-		minipoolBytecode, err = minipool.GetMinipoolBytecode(ggp, nil)
+		minipoolBytecode, err = minipool.GetMinipoolBytecodeContext(ctx, ggp, nil)
 		if err != nil {
 			return common.Address{}, fmt.Errorf("Error getting minipool bytecode: %w", err)
 		}
@@ -63,8 +67,8 @@ func GenerateAddress(ggp *gogopool.GoGoPool, nodeAddress common.Address, deposit
 // Get contracts
 var gogoMinipoolManagerLock sync.Mutex
 
-func getGoGoMinipoolManager(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getGoGoMinipoolManager(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	gogoMinipoolManagerLock.Lock()
 	defer gogoMinipoolManagerLock.Unlock()
-	return ggp.GetContract("rocketMinipoolManager")
+	return ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractMinipoolManager))
 }
@@ -19,7 +19,10 @@ type FilterQuery struct {
 }
 
 func FilterContractLogs(ggp *gogopool.GoGoPool, contractName string, q FilterQuery, intervalSize *big.Int) ([]types.Log, error) {
-	gogoDaoNodeTrustedUpgrade, err := ggp.GetContract("rocketDAONodeTrustedUpgrade")
+	return FilterContractLogsContext(context.Background(), ggp, contractName, q, intervalSize)
+}
+func FilterContractLogsContext(ctx context.Context, ggp *gogopool.GoGoPool, contractName string, q FilterQuery, intervalSize *big.Int) ([]types.Log, error) {
+	gogoDaoNodeTrustedUpgrade, err := ggp.GetContractContext(ctx, ggp.ContractName(gogopool.ContractDAONodeTrustedUpgrade))
 	if err != nil {
 		return nil, err
 	}
@@ -28,7 +31,7 @@ func FilterContractLogs(ggp *gogopool.GoGoPool, contractName string, q FilterQue
 	// Construct a filter to query ContractUpgraded event
 	addressFilter := []common.Address{*gogoDaoNodeTrustedUpgrade.Address}
 	topicFilter := [][]common.Hash{{gogoDaoNodeTrustedUpgrade.ABI.Events["ContractUpgraded"].ID}, {crypto.Keccak256Hash([]byte(contractName))}}
-	logs, err := GetLogs(ggp, addressFilter, topicFilter, intervalSize, nil, nil, nil)
+	logs, err := GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -37,87 +40,25 @@ func FilterContractLogs(ggp *gogopool.GoGoPool, contractName string, q FilterQue
 		addresses = append(addresses, common.HexToAddress(log.Topics[2].Hex()))
 	}
 	// Append current address
-	currentAddress, err := ggp.GetAddress(contractName)
+	currentAddress, err := ggp.GetAddressContext(ctx, contractName)
 	if err != nil {
 		return nil, err
 	}
 	addresses = append(addresses, *currentAddress)
 	// Perform the desired getLogs call and return results
-	return GetLogs(ggp, addresses, q.Topics, intervalSize, q.FromBlock, q.ToBlock, q.BlockHash)
+	return GetLogsContext(ctx, ggp, addresses, q.Topics, intervalSize, q.FromBlock, q.ToBlock, q.BlockHash)
 }
 
 // Gets the logs for a particular log request, breaking the calls into batches if necessary
 func GetLogs(ggp *gogopool.GoGoPool, addressFilter []common.Address, topicFilter [][]common.Hash, intervalSize, fromBlock, toBlock *big.Int, blockHash *common.Hash) ([]types.Log, error) {
-	var logs []types.Log
-
-	// Get the block that GoGo Pool was deployed on as the lower bound if one wasn't specified
-	if fromBlock == nil {
-		var err error
-		deployBlockHash := crypto.Keccak256Hash([]byte("deploy.block"))
-		fromBlock, err = ggp.GoGoStorage.GetUint(nil, deployBlockHash)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if intervalSize == nil {
-		// Handle unlimited intervals with a single call
-		logs, err := ggp.Client.FilterLogs(context.Background(), ethereum.FilterQuery{
-			Addresses: addressFilter,
-			Topics:    topicFilter,
-			FromBlock: fromBlock,
-			ToBlock:   toBlock,
-			BlockHash: blockHash,
-		})
-		if err != nil {
-			return nil, err
-		}
-		return logs, nil
-	} else {
-		// Get the latest block
-		if toBlock == nil {
-			latestBlock, err := ggp.Client.BlockNumber(context.Background())
-			if err != nil {
-				return nil, err
-			}
-			toBlock = big.NewInt(0)
-			toBlock.SetUint64(latestBlock)
-		}
-
-		// Set the start and end, clamping on the latest block
-		intervalSize.Sub(intervalSize, big.NewInt(1))
-		start := fromBlock
-		end := big.NewInt(0).Add(start, intervalSize)
-		if end.Cmp(toBlock) == 1 {
-			end = toBlock
-		}
-		for {
-			// Get the logs using the current interval
-			newLogs, err := ggp.Client.FilterLogs(context.Background(), ethereum.FilterQuery{
-				Addresses: addressFilter,
-				Topics:    topicFilter,
-				FromBlock: start,
-				ToBlock:   end,
-				BlockHash: blockHash,
-			})
-			if err != nil {
-				return nil, err
-			}
-
-			// Append the logs to the total list
-			logs = append(logs, newLogs...)
-
-			// Return once we've finished iterating
-			if end.Cmp(toBlock) == 0 {
-				return logs, nil
-			}
-
-			// Update to the next interval (end+1 : that + interval - 1)
-			start.Add(end, big.NewInt(1))
-			end.Add(start, intervalSize)
-			if end.Cmp(toBlock) == 1 {
-				end = toBlock
-			}
-		}
-	}
+	return GetLogsContext(context.Background(), ggp, addressFilter, topicFilter, intervalSize, fromBlock, toBlock, blockHash)
+}
+func GetLogsContext(ctx context.Context, ggp *gogopool.GoGoPool, addressFilter []common.Address, topicFilter [][]common.Hash, intervalSize, fromBlock, toBlock *big.Int, blockHash *common.Hash) ([]types.Log, error) {
+	return ggp.GetLogsContext(ctx, ethereum.FilterQuery{
+		Addresses: addressFilter,
+		Topics:    topicFilter,
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		BlockHash: blockHash,
+	}, intervalSize)
 }
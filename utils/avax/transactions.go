@@ -14,6 +14,9 @@ import (
 
 // Estimate the gas of SendTransaction
 func EstimateSendTransactionGas(client *client.EthClientProxy, toAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
+	return EstimateSendTransactionGasContext(gogopool.TransactOptsContext(opts), client, toAddress, opts)
+}
+func EstimateSendTransactionGasContext(ctx context.Context, client *client.EthClientProxy, toAddress common.Address, opts *bind.TransactOpts) (gogopool.GasInfo, error) {
 
 	// User-defined settings
 	response := gogopool.GasInfo{}
@@ -25,7 +28,7 @@ func EstimateSendTransactionGas(client *client.EthClientProxy, toAddress common.
 	}
 
 	// Estimate gas limit
-	gasLimit, err := client.EstimateGas(context.Background(), ethereum.CallMsg{
+	gasLimit, err := client.EstimateGas(ctx, ethereum.CallMsg{
 		From:     opts.From,
 		To:       &toAddress,
 		GasPrice: big.NewInt(0), // set to 0 for simulation
@@ -37,17 +40,57 @@ func EstimateSendTransactionGas(client *client.EthClientProxy, toAddress common.
 	response.EstGasLimit = gasLimit
 	response.SafeGasLimit = gasLimit
 
-	return response, err
+	// Get costs; these are best-effort, and left unset if fees can't be estimated unless the context is done
+	if err := gogopool.NewFeeOracle(client).AddGasCostsContext(ctx, &response); err != nil && ctx.Err() != nil {
+		return gogopool.GasInfo{}, ctx.Err()
+	}
+
+	return response, nil
+}
+
+// Build an unsigned transaction sending AVAX to an address
+func BuildSendTransaction(client *client.EthClientProxy, toAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+	return BuildSendTransactionContext(gogopool.TransactOptsContext(opts), client, toAddress, opts)
+}
+func BuildSendTransactionContext(ctx context.Context, ec *client.EthClientProxy, toAddress common.Address, opts *bind.TransactOpts) (*gogopool.UnsignedTransaction, error) {
+
+	// Send the nonce lookup, gas estimate & transaction through the same endpoint
+	ctx = client.WithStickyEndpoint(ctx)
+
+	// Estimate gas limit
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		var err error
+		gasLimit, err = ec.EstimateGas(ctx, ethereum.CallMsg{
+			From:     opts.From,
+			To:       &toAddress,
+			GasPrice: big.NewInt(0), // use 0 gwei for simulation
+			Value:    opts.Value,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Build transaction
+	return gogopool.NewUnsignedTransactionContext(ctx, ec, opts, &toAddress, []byte{}, gasLimit)
+
 }
 
 // Send a transaction to an address
 func SendTransaction(client *client.EthClientProxy, toAddress common.Address, chainID *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
+	return SendTransactionContext(gogopool.TransactOptsContext(opts), client, toAddress, chainID, opts)
+}
+func SendTransactionContext(ctx context.Context, ec *client.EthClientProxy, toAddress common.Address, chainID *big.Int, opts *bind.TransactOpts) (common.Hash, error) {
 	var err error
 
+	// Send the nonce lookup, gas estimate & transaction through the same endpoint
+	ctx = client.WithStickyEndpoint(ctx)
+
 	// Get from address nonce
 	var nonce uint64
 	if opts.Nonce == nil {
-		nonce, err = client.PendingNonceAt(context.Background(), opts.From)
+		nonce, err = ec.PendingNonceAt(ctx, opts.From)
 		if err != nil {
 			return common.Hash{}, err
 		}
@@ -64,7 +107,7 @@ func SendTransaction(client *client.EthClientProxy, toAddress common.Address, ch
 	// Estimate gas limit
 	gasLimit := opts.GasLimit
 	if gasLimit == 0 {
-		gasLimit, err = client.EstimateGas(context.Background(), ethereum.CallMsg{
+		gasLimit, err = ec.EstimateGas(ctx, ethereum.CallMsg{
 			From:     opts.From,
 			To:       &toAddress,
 			GasPrice: big.NewInt(0), // use 0 gwei for simulation
@@ -75,12 +118,18 @@ func SendTransaction(client *client.EthClientProxy, toAddress common.Address, ch
 		}
 	}
 
+	// Get fees
+	gasTipCap, gasFeeCap, err := gogopool.NewFeeOracle(ec).GetTransactionFeesContext(ctx, opts)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
 	// Initialize transaction
 	tx := types.NewTx(&types.DynamicFeeTx{
 		ChainID:    chainID,
 		Nonce:      nonce,
-		GasTipCap:  opts.GasTipCap,
-		GasFeeCap:  opts.GasFeeCap,
+		GasTipCap:  gasTipCap,
+		GasFeeCap:  gasFeeCap,
 		Gas:        gasLimit,
 		To:         &toAddress,
 		Value:      value,
@@ -95,7 +144,7 @@ func SendTransaction(client *client.EthClientProxy, toAddress common.Address, ch
 	}
 
 	// Send transaction
-	if err = client.SendTransaction(context.Background(), signedTx); err != nil {
+	if err = ec.SendTransaction(ctx, signedTx); err != nil {
 		return common.Hash{}, err
 	}
 
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Archive routing settings
+const (
+	DefaultArchiveDepth = 128 // The number of recent states kept by a pruned geth node
+	headCacheTTL        = 5 * time.Second
+)
+
+// The kind of state an endpoint keeps
+type EndpointKind int
+
+const (
+	// A full node, which only keeps recent state
+	EndpointFull EndpointKind = iota
+	// An archive node, which keeps state for every block
+	EndpointArchive
+)
+
+func (k EndpointKind) String() string {
+	switch k {
+	case EndpointFull:
+		return "full"
+	case EndpointArchive:
+		return "archive"
+	}
+	return "unknown"
+}
+
+// Archive routing state
+type archiveRouter struct {
+	kinds    []EndpointKind
+	depth    uint64
+	head     uint64
+	headTime time.Time
+	lock     sync.Mutex
+}
+
+// Check if an error was caused by an endpoint missing historical state
+func IsMissingStateError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := err.Error()
+	return strings.Contains(message, "missing trie node") || strings.Contains(message, "historical state unavailable")
+}
+
+// Check if an error was caused by an endpoint not having reached a requested block yet
+func IsMissingBlockError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := err.Error()
+	return strings.Contains(message, "header not found") || strings.Contains(message, "unknown block")
+}
+
+// Tag the endpoint with the given URL as a full or archive node
+// All endpoints are full nodes until tagged
+func (p *EthClientProxy) SetEndpointKind(url string, kind EndpointKind) error {
+	for i, clientUrl := range p.clientUrls {
+		if clientUrl == url {
+			p.archive.lock.Lock()
+			defer p.archive.lock.Unlock()
+			p.archive.kinds[i] = kind
+			return nil
+		}
+	}
+	return fmt.Errorf("Client %s is not configured", url)
+}
+
+// Set the number of blocks behind the head beyond which reads are only sent to archive endpoints
+func (p *EthClientProxy) SetArchiveDepth(depth uint64) {
+	p.archive.lock.Lock()
+	defer p.archive.lock.Unlock()
+	p.archive.depth = depth
+}
+
+// Get the kind of each endpoint, in list order
+func (p *EthClientProxy) GetEndpointKinds() []EndpointKind {
+	p.archive.lock.Lock()
+	defer p.archive.lock.Unlock()
+	kinds := make([]EndpointKind, len(p.archive.kinds))
+	copy(kinds, p.archive.kinds)
+	return kinds
+}
+
+// Get the order to try clients in for a read at a block
+// Endpoints whose probed head is behind the block are tried last, and reads older than the archive depth only go to archive endpoints, if any are tagged
+func (p *EthClientProxy) getReadClientOrder(ctx context.Context, blockNumber *big.Int) []int {
+	order := p.getClientOrder(ctx)
+	if blockNumber == nil {
+		return order
+	}
+	order = p.laggingLastOrder(order, blockNumber)
+	if !p.hasArchiveEndpoints() {
+		return order
+	}
+	head, err := p.getHead(ctx)
+	if err != nil {
+		return order
+	}
+	p.archive.lock.Lock()
+	depth := p.archive.depth
+	p.archive.lock.Unlock()
+	if !blockNumber.IsUint64() || blockNumber.Uint64()+depth >= head {
+		return order
+	}
+	return p.archiveOrder(order)
+}
+
+// Move endpoints whose probed head is behind a block to the end of an order
+func (p *EthClientProxy) laggingLastOrder(order []int, blockNumber *big.Int) []int {
+	p.balancer.lock.Lock()
+	defer p.balancer.lock.Unlock()
+	if !p.balancer.probed {
+		return order
+	}
+	current := []int{}
+	lagging := []int{}
+	for _, i := range order {
+		if blockNumber.IsUint64() && p.balancer.health[i].Head < blockNumber.Uint64() {
+			lagging = append(lagging, i)
+		} else {
+			current = append(current, i)
+		}
+	}
+	return append(current, lagging...)
+}
+
+// Filter an order down to archive endpoints
+func (p *EthClientProxy) archiveOrder(order []int) []int {
+	p.archive.lock.Lock()
+	defer p.archive.lock.Unlock()
+	archiveOrder := []int{}
+	for _, i := range order {
+		if p.archive.kinds[i] == EndpointArchive {
+			archiveOrder = append(archiveOrder, i)
+		}
+	}
+	return archiveOrder
+}
+
+// Check if any endpoints are tagged as archive nodes
+func (p *EthClientProxy) hasArchiveEndpoints() bool {
+	p.archive.lock.Lock()
+	defer p.archive.lock.Unlock()
+	for _, kind := range p.archive.kinds {
+		if kind == EndpointArchive {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the latest block number, from health probes if they have run or otherwise from a briefly cached lookup
+func (p *EthClientProxy) getHead(ctx context.Context) (uint64, error) {
+
+	// Use probed heads
+	p.balancer.lock.Lock()
+	if p.balancer.probed {
+		var head uint64
+		for _, health := range p.balancer.health {
+			if health.Healthy && health.Head > head {
+				head = health.Head
+			}
+		}
+		p.balancer.lock.Unlock()
+		if head > 0 {
+			return head, nil
+		}
+	} else {
+		p.balancer.lock.Unlock()
+	}
+
+	// Use the cached head
+	p.archive.lock.Lock()
+	if time.Since(p.archive.headTime) < headCacheTTL {
+		head := p.archive.head
+		p.archive.lock.Unlock()
+		return head, nil
+	}
+	p.archive.lock.Unlock()
+
+	// Look up the head
+	result, _, err := p.runFunctionOn(ctx, p.getClientOrder(ctx), func(client *ethclient.Client) (interface{}, error) {
+		return client.BlockNumber(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	head := result.(uint64)
+	p.archive.lock.Lock()
+	defer p.archive.lock.Unlock()
+	p.archive.head = head
+	p.archive.headTime = time.Now()
+	return head, nil
+
+}
+
+// Retry a read which failed on one endpoint because of its state on the other endpoints
+// Reads failing with missing state are retried on the archive endpoints, or on the other endpoints if none are tagged as archive nodes
+// Reads at a block failing because the endpoint hasn't reached it yet are retried on the other endpoints for the block
+func (p *EthClientProxy) retryRead(ctx context.Context, err error, blockNumber *big.Int, function readFunction) (interface{}, int, error) {
+	var proxyErr *ProxyError
+	var clientErr *ClientError
+	if !errors.As(err, &proxyErr) || !errors.As(proxyErr.Err, &clientErr) {
+		return nil, -1, err
+	}
+	var candidates []int
+	if IsMissingStateError(err) {
+		candidates = p.getClientOrder(ctx)
+		if p.hasArchiveEndpoints() {
+			candidates = p.archiveOrder(candidates)
+		}
+	} else if blockNumber != nil && IsMissingBlockError(err) {
+		candidates = p.getReadClientOrder(ctx, blockNumber)
+	} else {
+		return nil, -1, err
+	}
+	order := []int{}
+	for _, i := range candidates {
+		if i != clientErr.Index {
+			order = append(order, i)
+		}
+	}
+	if len(order) == 0 {
+		return nil, -1, err
+	}
+	result, index, retryErr := p.runFunctionOn(ctx, order, func(client *ethclient.Client) (interface{}, error) {
+		return function(client, blockNumber)
+	})
+	if retryErr != nil {
+		if errors.As(retryErr, &proxyErr) {
+			proxyErr.ClientErrors = append([]*ClientError{clientErr}, proxyErr.ClientErrors...)
+		}
+		return nil, -1, retryErr
+	}
+	return result, index, nil
+}
@@ -0,0 +1,321 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Load balancing settings
+const (
+	DefaultProbeInterval = 15 * time.Second
+	DefaultProbeTimeout  = 5 * time.Second
+	DefaultMaxBlockLag   = 3
+	StickyTransactionTTL = 10 * time.Minute
+	latencyDecay         = 0.3
+)
+
+// The health of an endpoint as measured by the latest probes
+type EndpointHealth struct {
+	URL       string        `json:"url"`
+	Healthy   bool          `json:"healthy"`
+	Head      uint64        `json:"head"`
+	Latency   time.Duration `json:"latency"`
+	Lagging   bool          `json:"lagging"`
+	LastProbe time.Time     `json:"lastProbe"`
+	Err       error         `json:"-"`
+}
+
+// Endpoint selection state, used once health checks have run
+type balancer struct {
+	health      []EndpointHealth
+	weights     []int64
+	probed      bool
+	maxBlockLag uint64
+	sent        map[common.Hash]stickyEndpoint
+	lock        sync.Mutex
+}
+
+// An endpoint a transaction was sent through
+type stickyEndpoint struct {
+	index int
+	time  time.Time
+}
+
+// A sticky selection shared by the calls made with a context
+type stickySession struct {
+	index int
+	set   bool
+	lock  sync.Mutex
+}
+
+type stickySessionKey struct{}
+
+// Get a context whose calls all go to the same endpoint, e.g. for a transaction's nonce lookup, send and receipt polling
+// The endpoint is chosen by the first successful call, and is only left if it fails
+func WithStickyEndpoint(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(stickySessionKey{}).(*stickySession); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, stickySessionKey{}, &stickySession{})
+}
+
+// Set the number of blocks an endpoint may fall behind the highest head before it is removed from selection
+func (p *EthClientProxy) SetMaxBlockLag(maxBlockLag uint64) {
+	p.balancer.lock.Lock()
+	defer p.balancer.lock.Unlock()
+	p.balancer.maxBlockLag = maxBlockLag
+}
+
+// Probe endpoint health in the background until the context is done
+// Until the first probes complete, clients are tried in list order
+func (p *EthClientProxy) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultProbeInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			p.ProbeEndpoints(ctx)
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Measure the head height & latency of each endpoint
+func (p *EthClientProxy) ProbeEndpoints(ctx context.Context) {
+
+	// Probe endpoints concurrently
+	results := make([]EndpointHealth, len(p.clientUrls))
+	var wg sync.WaitGroup
+	for i := range p.clientUrls {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = p.probeEndpoint(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+
+	// Update health
+	b := &p.balancer
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.health == nil {
+		b.health = make([]EndpointHealth, len(p.clientUrls))
+		b.weights = make([]int64, len(p.clientUrls))
+	}
+	var maxHead uint64
+	for i, result := range results {
+		if result.Healthy {
+			if b.health[i].Latency > 0 {
+				result.Latency = time.Duration(latencyDecay*float64(result.Latency) + (1-latencyDecay)*float64(b.health[i].Latency))
+			}
+			if result.Head > maxHead {
+				maxHead = result.Head
+			}
+		} else {
+			result.Latency = b.health[i].Latency
+			result.Head = b.health[i].Head
+		}
+		b.health[i] = result
+	}
+	for i := range b.health {
+		b.health[i].Lagging = b.health[i].Healthy && b.health[i].Head+b.maxBlockLag < maxHead
+	}
+	b.probed = true
+
+}
+
+// Get the health of each endpoint, in list order
+func (p *EthClientProxy) GetEndpointHealth() []EndpointHealth {
+	b := &p.balancer
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	health := make([]EndpointHealth, len(p.clientUrls))
+	for i, url := range p.clientUrls {
+		health[i] = EndpointHealth{URL: url}
+		if b.health != nil {
+			health[i] = b.health[i]
+		}
+	}
+	return health
+}
+
+// Probe an endpoint's head height & latency
+func (p *EthClientProxy) probeEndpoint(ctx context.Context, index int) EndpointHealth {
+	health := EndpointHealth{
+		URL:       p.clientUrls[index],
+		LastProbe: time.Now(),
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, DefaultProbeTimeout)
+	defer cancel()
+	client, err := p.getClient(probeCtx, index)
+	if client == nil {
+		health.Err = err
+		return health
+	}
+	start := time.Now()
+	head, err := client.BlockNumber(probeCtx)
+	if err != nil {
+		health.Err = err
+		if ctx.Err() != nil {
+			p.releaseClient(index)
+		} else if ClassifyError(err) == ErrorClassRetryable {
+			p.recordFailure(index, client, p.getFailoverConfig())
+		} else {
+			p.recordSuccess(index)
+		}
+		return health
+	}
+	p.recordSuccess(index)
+	health.Healthy = true
+	health.Head = head
+	health.Latency = time.Since(start)
+	return health
+}
+
+// Get the order to try clients in
+// Once probed, healthy endpoints which are keeping up are chosen first by latency-weighted round-robin, followed by the rest by latency
+// A sticky session's endpoint is always tried first
+func (p *EthClientProxy) getClientOrder(ctx context.Context) []int {
+	order := p.getBalancedOrder()
+	if session, ok := ctx.Value(stickySessionKey{}).(*stickySession); ok {
+		session.lock.Lock()
+		defer session.lock.Unlock()
+		if session.set {
+			order = preferClient(order, session.index)
+		}
+	}
+	return order
+}
+
+// Get the order to try clients in for a transaction, preferring the endpoint it was sent through
+func (p *EthClientProxy) getTransactionClientOrder(ctx context.Context, hash common.Hash) []int {
+	order := p.getClientOrder(ctx)
+	b := &p.balancer
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if sent, ok := b.sent[hash]; ok {
+		order = preferClient(order, sent.index)
+	}
+	return order
+}
+
+// Record the client that successfully served a call
+func (p *EthClientProxy) setStickyClient(ctx context.Context, index int) {
+	if session, ok := ctx.Value(stickySessionKey{}).(*stickySession); ok {
+		session.lock.Lock()
+		defer session.lock.Unlock()
+		session.index = index
+		session.set = true
+	}
+}
+
+// Record the client a transaction was sent through, so polling for it goes to the same endpoint
+func (p *EthClientProxy) setTransactionClient(hash common.Hash, index int) {
+	b := &p.balancer
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.sent == nil {
+		b.sent = make(map[common.Hash]stickyEndpoint)
+	}
+	for sentHash, sent := range b.sent {
+		if time.Since(sent.time) > StickyTransactionTTL {
+			delete(b.sent, sentHash)
+		}
+	}
+	b.sent[hash] = stickyEndpoint{index: index, time: time.Now()}
+}
+
+// Get the balanced client order
+func (p *EthClientProxy) getBalancedOrder() []int {
+	b := &p.balancer
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	// Use list order until endpoints have been probed
+	order := make([]int, len(p.clientUrls))
+	for i := range order {
+		order[i] = i
+	}
+	if !b.probed {
+		return order
+	}
+
+	// Split endpoints into selectable & fallback endpoints
+	selectable := []int{}
+	fallback := []int{}
+	for _, i := range order {
+		if b.health[i].Healthy && !b.health[i].Lagging {
+			selectable = append(selectable, i)
+		} else {
+			fallback = append(fallback, i)
+		}
+	}
+
+	// Pick the first endpoint by smooth weighted round-robin, weighting by inverse latency
+	var total int64
+	best := -1
+	for _, i := range selectable {
+		weight := latencyWeight(b.health[i].Latency)
+		b.weights[i] += weight
+		total += weight
+		if best == -1 || b.weights[i] > b.weights[best] {
+			best = i
+		}
+	}
+	if best != -1 {
+		b.weights[best] -= total
+	}
+
+	// Order the rest by latency
+	sort.SliceStable(selectable, func(x, y int) bool {
+		return b.health[selectable[x]].Latency < b.health[selectable[y]].Latency
+	})
+	sort.SliceStable(fallback, func(x, y int) bool {
+		return fallbackRank(b.health[fallback[x]]) < fallbackRank(b.health[fallback[y]])
+	})
+	order = append(selectable, fallback...)
+	if best != -1 {
+		order = preferClient(order, best)
+	}
+	return order
+
+}
+
+// Get the round-robin weight of an endpoint from its latency
+func latencyWeight(latency time.Duration) int64 {
+	if latency < time.Millisecond {
+		latency = time.Millisecond
+	}
+	return int64(time.Second / latency)
+}
+
+// Rank fallback endpoints: lagging endpoints before unhealthy ones
+func fallbackRank(health EndpointHealth) int {
+	if health.Healthy {
+		return 0
+	}
+	return 1
+}
+
+// Move a client to the front of an order
+func preferClient(order []int, index int) []int {
+	preferred := make([]int, 0, len(order))
+	preferred = append(preferred, index)
+	for _, i := range order {
+		if i != index {
+			preferred = append(preferred, i)
+		}
+	}
+	return preferred
+}
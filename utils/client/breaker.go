@@ -0,0 +1,161 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Circuit breaker settings
+const (
+	DefaultFailureThreshold = 3
+	DefaultBaseBackoff      = time.Second
+	DefaultMaxBackoff       = 2 * time.Minute
+	DefaultRetryAttempts    = 3
+	DefaultRetryDelay       = 250 * time.Millisecond
+	DefaultMaxRetryDelay    = 2 * time.Second
+)
+
+// Returned for an endpoint whose circuit breaker is open
+var ErrCircuitOpen = errors.New("Circuit breaker is open")
+
+// The state of an endpoint's circuit breaker
+type BreakerState int
+
+const (
+	// Requests are sent to the endpoint
+	BreakerClosed BreakerState = iota
+	// The endpoint is failing, and requests are not sent to it until its backoff has passed
+	BreakerOpen
+	// The backoff has passed, and a single trial request is sent to the endpoint
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Failover settings for the proxy
+// BaseBackoff is the time an endpoint's breaker stays open after it first trips, or DefaultBaseBackoff if not positive; it doubles each time a trial request fails, up to MaxBackoff
+// When a call fails on every endpoint with retryable errors, it is retried up to RetryAttempts times in total, waiting RetryDelay (doubling up to MaxRetryDelay) between rounds
+type FailoverConfig struct {
+	FailureThreshold int
+	BaseBackoff      time.Duration
+	MaxBackoff       time.Duration
+	RetryAttempts    int
+	RetryDelay       time.Duration
+	MaxRetryDelay    time.Duration
+}
+
+// The circuit breaker state of an endpoint
+type BreakerStatus struct {
+	URL       string        `json:"url"`
+	State     BreakerState  `json:"state"`
+	Failures  int           `json:"failures"`
+	Backoff   time.Duration `json:"backoff"`
+	OpenUntil time.Time     `json:"openUntil"`
+}
+
+// A circuit breaker for a single endpoint
+// Not safe for concurrent use; callers hold the endpoint lock
+type circuitBreaker struct {
+	state    BreakerState
+	failures int
+	backoff  time.Duration
+	openedAt time.Time
+	trial    bool
+}
+
+// Get the default failover settings, with the given base backoff or DefaultBaseBackoff if it is not positive
+func DefaultFailoverConfig(baseBackoff time.Duration) FailoverConfig {
+	if baseBackoff <= 0 {
+		baseBackoff = DefaultBaseBackoff
+	}
+	return FailoverConfig{
+		FailureThreshold: DefaultFailureThreshold,
+		BaseBackoff:      baseBackoff,
+		MaxBackoff:       DefaultMaxBackoff,
+		RetryAttempts:    DefaultRetryAttempts,
+		RetryDelay:       DefaultRetryDelay,
+		MaxRetryDelay:    DefaultMaxRetryDelay,
+	}
+}
+
+// Check whether a request may be sent, moving an open breaker to half-open once its backoff has passed
+func (b *circuitBreaker) allow(now time.Time) error {
+	switch b.state {
+	case BreakerOpen:
+		if now.Sub(b.openedAt) < b.backoff {
+			return fmt.Errorf("%w, retrying in %s", ErrCircuitOpen, b.backoff-now.Sub(b.openedAt))
+		}
+		b.state = BreakerHalfOpen
+		b.trial = true
+		return nil
+	case BreakerHalfOpen:
+		if b.trial {
+			return fmt.Errorf("%w, waiting for a trial request", ErrCircuitOpen)
+		}
+		b.trial = true
+		return nil
+	}
+	return nil
+}
+
+// Record a successful request, closing the breaker
+func (b *circuitBreaker) success() {
+	b.state = BreakerClosed
+	b.failures = 0
+	b.backoff = 0
+	b.trial = false
+}
+
+// Record a failed request, opening the breaker once the failure threshold is reached or a trial request fails
+func (b *circuitBreaker) failure(now time.Time, config FailoverConfig) {
+	b.failures++
+	b.trial = false
+	switch b.state {
+	case BreakerHalfOpen:
+		if b.backoff <= 0 {
+			b.backoff = config.BaseBackoff
+		} else {
+			b.backoff *= 2
+		}
+	case BreakerClosed:
+		if b.failures < config.FailureThreshold {
+			return
+		}
+		b.backoff = config.BaseBackoff
+	default:
+		return
+	}
+	if b.backoff > config.MaxBackoff {
+		b.backoff = config.MaxBackoff
+	}
+	b.state = BreakerOpen
+	b.openedAt = now
+}
+
+// Release a trial request which ended without a verdict on the endpoint (e.g. a terminal error)
+func (b *circuitBreaker) release() {
+	b.trial = false
+}
+
+// Get the delay before the given retry round
+func retryDelay(config FailoverConfig, round int) time.Duration {
+	delay := config.RetryDelay
+	for i := 1; i < round && delay < config.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > config.MaxRetryDelay {
+		delay = config.MaxRetryDelay
+	}
+	return delay
+}
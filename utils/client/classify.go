@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// How a failed call should be handled by the proxy
+type ErrorClass int
+
+const (
+	// The error came from the request itself (e.g. a revert or bad argument), and would be the same on any endpoint
+	ErrorClassTerminal ErrorClass = iota
+	// The endpoint failed to serve the request, so it can be retried on another endpoint
+	ErrorClassRetryable
+)
+
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorClassTerminal:
+		return "terminal"
+	case ErrorClassRetryable:
+		return "retryable"
+	}
+	return "unknown"
+}
+
+// JSON-RPC error codes returned by providers which are rate limiting or overloaded
+var retryableRPCCodes = map[int]bool{
+	-32005: true, // Limit exceeded
+}
+
+// Messages of connection failures which are not exposed as typed errors
+var retryableMessages = []string{
+	"dial tcp",
+	"connection refused",
+	"connection reset",
+	"broken pipe",
+	"i/o timeout",
+	"tls:",
+	"tls handshake",
+	"unexpected eof",
+	"context deadline exceeded",
+	"client.timeout exceeded",
+	"too many requests",
+	"rate limit",
+	"service unavailable",
+	"bad gateway",
+}
+
+// Classify an error from an endpoint as retryable or terminal
+// Errors are terminal unless they are known to be caused by the endpoint or the connection to it
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassTerminal
+	}
+
+	// Cancellation by the caller is never retried
+	if errors.Is(err, context.Canceled) {
+		return ErrorClassTerminal
+	}
+
+	// HTTP status errors mean the endpoint didn't serve the request (e.g. rate limiting, a bad API key or a wrong path), so another endpoint may
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return ErrorClassRetryable
+	}
+
+	// JSON-RPC errors are returned by a working endpoint, so are only retried when rate limited
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		if retryableRPCCodes[rpcErr.ErrorCode()] || isRateLimitMessage(err.Error()) {
+			return ErrorClassRetryable
+		}
+		return ErrorClassTerminal
+	}
+
+	// Connection errors
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrorClassRetryable
+	}
+	if errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) {
+		return ErrorClassRetryable
+	}
+
+	// Fall back to the error message
+	message := strings.ToLower(err.Error())
+	for _, retryableMessage := range retryableMessages {
+		if strings.Contains(message, retryableMessage) {
+			return ErrorClassRetryable
+		}
+	}
+	return ErrorClassTerminal
+
+}
+
+// Check whether a JSON-RPC error message indicates rate limiting
+func isRateLimitMessage(message string) bool {
+	message = strings.ToLower(message)
+	return strings.Contains(message, "rate limit") || strings.Contains(message, "too many requests")
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Returned when none of the proxy's clients could be reached
+var ErrNoClientsAvailable = errors.New("None of the clients were available.")
+
+// An error returned by one of the proxy's clients
+type ClientError struct {
+	Index int
+	Err   error
+}
+
+func (e *ClientError) Error() string {
+	return fmt.Sprintf("Error with client %d: %s", e.Index, e.Err.Error())
+}
+
+func (e *ClientError) Unwrap() error {
+	return e.Err
+}
+
+// An error from a proxied call, along with the errors from each client that was tried before it
+// Err is the final cause: a *ClientError, the context error, or ErrNoClientsAvailable
+type ProxyError struct {
+	ClientErrors []*ClientError
+	Err          error
+}
+
+func (e *ProxyError) Error() string {
+	var message strings.Builder
+	for _, clientErr := range e.ClientErrors {
+		message.WriteString("\n")
+		message.WriteString(clientErr.Error())
+	}
+	message.WriteString("\n")
+	message.WriteString(e.Err.Error())
+	return message.String()
+}
+
+func (e *ProxyError) Unwrap() error {
+	return e.Err
+}
+
+// Match the errors from every client that was tried, as well as the final cause
+func (e *ProxyError) Is(target error) bool {
+	for _, clientErr := range e.ClientErrors {
+		if errors.Is(clientErr, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// The kind of an observed call
+type CallKind string
+
+const (
+	CallKindRPC      CallKind = "rpc"
+	CallKindCall     CallKind = "call"
+	CallKindTransact CallKind = "transact"
+)
+
+// An observed call
+// For RPC calls, Method is the proxy method (e.g. "CallContract"), and Contract & ContractMethod are those of the contract call it was made for, if any
+// For contract calls, Method is the contract method, and Endpoint is the endpoint which served its last RPC call
+// Endpoint is -1 if the call was not served by a single endpoint, and Block is empty for calls not made at a block
+type CallInfo struct {
+	Kind           CallKind
+	Contract       string
+	ContractMethod string
+	Method         string
+	Endpoint       int
+	Block          string
+	Start          time.Time
+	Latency        time.Duration
+	Err            error
+	ErrorClass     ErrorClass
+}
+
+// Receives every call made through a proxy, and every contract call & transaction made with it
+// StartCall may return a derived context (e.g. carrying a span), which is passed to EndCall
+type Observer interface {
+	StartCall(ctx context.Context, call CallInfo) context.Context
+	EndCall(ctx context.Context, call CallInfo)
+}
+
+// A contract call in progress, carried by the context of the RPC calls made for it
+type contractCall struct {
+	contract string
+	method   string
+	endpoint int
+	lock     sync.Mutex
+}
+
+type contractCallKey struct{}
+
+// Forwards calls to several observers
+type multiObserver []Observer
+
+// Get an observer which forwards calls to each of the given observers
+func MultiObserver(observers ...Observer) Observer {
+	return multiObserver(observers)
+}
+
+func (m multiObserver) StartCall(ctx context.Context, call CallInfo) context.Context {
+	for _, observer := range m {
+		ctx = observer.StartCall(ctx, call)
+	}
+	return ctx
+}
+
+func (m multiObserver) EndCall(ctx context.Context, call CallInfo) {
+	for i := len(m) - 1; i >= 0; i-- {
+		m[i].EndCall(ctx, call)
+	}
+}
+
+// Set the observer for calls made through the proxy, or nil to stop observing
+func (p *EthClientProxy) SetObserver(observer Observer) {
+	p.observerLock.Lock()
+	defer p.observerLock.Unlock()
+	p.observer = observer
+}
+
+// Get the observer for calls made through the proxy
+func (p *EthClientProxy) GetObserver() Observer {
+	p.observerLock.RLock()
+	defer p.observerLock.RUnlock()
+	return p.observer
+}
+
+// Observe a contract call or transaction
+// Returns a context to make the call's RPC calls with, so they are attributed to it, and a function to call with the call's result
+func (p *EthClientProxy) ObserveContractCall(ctx context.Context, kind CallKind, contract string, method string, blockNumber *big.Int) (context.Context, func(error)) {
+	observer := p.GetObserver()
+	if observer == nil {
+		return ctx, func(error) {}
+	}
+	state := &contractCall{contract: contract, method: method, endpoint: -1}
+	call := CallInfo{
+		Kind:           kind,
+		Contract:       contract,
+		ContractMethod: method,
+		Method:         method,
+		Endpoint:       -1,
+		Block:          BlockTag(blockNumber),
+		Start:          time.Now(),
+	}
+	if kind == CallKindTransact {
+		call.Block = ""
+	}
+	ctx = observer.StartCall(context.WithValue(ctx, contractCallKey{}, state), call)
+	return ctx, func(err error) {
+		state.lock.Lock()
+		call.Endpoint = state.endpoint
+		state.lock.Unlock()
+		call.Latency = time.Since(call.Start)
+		call.Err = err
+		if err != nil {
+			call.ErrorClass = ClassifyError(err)
+		}
+		observer.EndCall(ctx, call)
+	}
+}
+
+// Get the block tag of a block number: "latest" for nil, the tag name for a special block number, or the decimal block number
+func BlockTag(blockNumber *big.Int) string {
+	if blockNumber == nil {
+		return "latest"
+	}
+	if blockNumber.IsInt64() {
+		switch rpc.BlockNumber(blockNumber.Int64()) {
+		case rpc.PendingBlockNumber:
+			return "pending"
+		case rpc.LatestBlockNumber:
+			return "latest"
+		}
+	}
+	return blockNumber.String()
+}
+
+// Run a proxied call, reporting it to the observer
+func (p *EthClientProxy) observe(ctx context.Context, method string, block string, run func() (interface{}, int, error)) (interface{}, int, error) {
+	observer := p.GetObserver()
+	if observer == nil {
+		return run()
+	}
+
+	// Start the call
+	call := CallInfo{
+		Kind:     CallKindRPC,
+		Method:   method,
+		Endpoint: -1,
+		Block:    block,
+		Start:    time.Now(),
+	}
+	state, _ := ctx.Value(contractCallKey{}).(*contractCall)
+	if state != nil {
+		call.Contract = state.contract
+		call.ContractMethod = state.method
+	}
+	ctx = observer.StartCall(ctx, call)
+
+	// Run it
+	result, index, err := run()
+
+	// End the call
+	call.Endpoint = index
+	call.Latency = time.Since(call.Start)
+	call.Err = err
+	if err != nil {
+		call.ErrorClass = ClassifyError(err)
+	}
+	if state != nil && index >= 0 {
+		state.lock.Lock()
+		state.endpoint = index
+		state.lock.Unlock()
+	}
+	observer.EndCall(ctx, call)
+	return result, index, err
+
+}
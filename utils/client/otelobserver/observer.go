@@ -0,0 +1,75 @@
+// Package otelobserver reports GoGo Pool RPC and contract calls as OpenTelemetry spans.
+// It is a separate module, so the OpenTelemetry dependencies are only required by users of this package.
+package otelobserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/multisig-labs/gogopool-go/utils/client"
+)
+
+// Span attribute keys
+const (
+	KindKey           = attribute.Key("gogopool.call.kind")
+	ContractKey       = attribute.Key("gogopool.contract")
+	ContractMethodKey = attribute.Key("gogopool.contract.method")
+	MethodKey         = attribute.Key("gogopool.method")
+	EndpointKey       = attribute.Key("gogopool.endpoint")
+	BlockKey          = attribute.Key("gogopool.block")
+	ErrorClassKey     = attribute.Key("gogopool.error.class")
+)
+
+// An observer which records each call as a span
+type Observer struct {
+	tracer trace.Tracer
+}
+
+// Create a new span observer using the given tracer
+func NewObserver(tracer trace.Tracer) *Observer {
+	return &Observer{tracer: tracer}
+}
+
+func (o *Observer) StartCall(ctx context.Context, call client.CallInfo) context.Context {
+	attributes := []attribute.KeyValue{
+		KindKey.String(string(call.Kind)),
+		MethodKey.String(call.Method),
+	}
+	if call.Contract != "" {
+		attributes = append(attributes, ContractKey.String(call.Contract), ContractMethodKey.String(call.ContractMethod))
+	}
+	if call.Block != "" {
+		attributes = append(attributes, BlockKey.String(call.Block))
+	}
+	ctx, _ = o.tracer.Start(ctx, spanName(call),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(call.Start),
+		trace.WithAttributes(attributes...),
+	)
+	return ctx
+}
+
+func (o *Observer) EndCall(ctx context.Context, call client.CallInfo) {
+	span := trace.SpanFromContext(ctx)
+	if call.Endpoint >= 0 {
+		span.SetAttributes(EndpointKey.Int(call.Endpoint))
+	}
+	if call.Err != nil {
+		span.SetAttributes(ErrorClassKey.String(call.ErrorClass.String()))
+		span.RecordError(call.Err)
+		span.SetStatus(codes.Error, call.Err.Error())
+	}
+	span.End(trace.WithTimestamp(call.Start.Add(call.Latency)))
+}
+
+// Get the span name for a call
+func spanName(call client.CallInfo) string {
+	if call.Kind == client.CallKindRPC {
+		return fmt.Sprintf("rpc %s", call.Method)
+	}
+	return fmt.Sprintf("%s %s.%s", call.Kind, call.Contract, call.Method)
+}
@@ -0,0 +1,179 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Default latency histogram buckets, in seconds
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// An observer which aggregates calls into metrics in the Prometheus text exposition format
+// Metrics are exposed through WriteMetrics or by serving the observer over HTTP
+type PrometheusObserver struct {
+	namespace string
+	buckets   []float64
+	counts    map[callLabels]uint64
+	latencies map[latencyLabels]*histogram
+	lock      sync.Mutex
+}
+
+// The labels of the call counter
+type callLabels struct {
+	latencyLabels
+	block      string
+	errorClass string
+}
+
+// The labels of the latency histogram
+type latencyLabels struct {
+	kind     string
+	contract string
+	method   string
+	endpoint string
+}
+
+// A latency histogram
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// Create a new Prometheus observer, with metric names prefixed by the namespace
+func NewPrometheusObserver(namespace string, buckets ...float64) *PrometheusObserver {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	sorted := make([]float64, len(buckets))
+	copy(sorted, buckets)
+	sort.Float64s(sorted)
+	return &PrometheusObserver{
+		namespace: namespace,
+		buckets:   sorted,
+		counts:    make(map[callLabels]uint64),
+		latencies: make(map[latencyLabels]*histogram),
+	}
+}
+
+func (o *PrometheusObserver) StartCall(ctx context.Context, call CallInfo) context.Context {
+	return ctx
+}
+
+func (o *PrometheusObserver) EndCall(ctx context.Context, call CallInfo) {
+	labels := latencyLabels{
+		kind:     string(call.Kind),
+		contract: call.Contract,
+		method:   call.Method,
+		endpoint: "",
+	}
+	if call.Endpoint >= 0 {
+		labels.endpoint = strconv.Itoa(call.Endpoint)
+	}
+	errorClass := "none"
+	if call.Err != nil {
+		errorClass = call.ErrorClass.String()
+	}
+	seconds := call.Latency.Seconds()
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	o.counts[callLabels{latencyLabels: labels, block: blockLabel(call.Block), errorClass: errorClass}]++
+	h, ok := o.latencies[labels]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(o.buckets))}
+		o.latencies[labels] = h
+	}
+	for bi, bucket := range o.buckets {
+		if seconds <= bucket {
+			h.counts[bi]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// Write the metrics in the Prometheus text exposition format
+func (o *PrometheusObserver) WriteMetrics(w io.Writer) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	var out strings.Builder
+
+	// Call counts
+	callsName := o.metricName("calls_total")
+	fmt.Fprintf(&out, "# HELP %s Number of RPC and contract calls.\n", callsName)
+	fmt.Fprintf(&out, "# TYPE %s counter\n", callsName)
+	callKeys := make([]callLabels, 0, len(o.counts))
+	for labels := range o.counts {
+		callKeys = append(callKeys, labels)
+	}
+	sort.Slice(callKeys, func(i, j int) bool { return callKeys[i].String() < callKeys[j].String() })
+	for _, labels := range callKeys {
+		fmt.Fprintf(&out, "%s{%s} %d\n", callsName, labels.String(), o.counts[labels])
+	}
+
+	// Latencies
+	latencyName := o.metricName("call_duration_seconds")
+	fmt.Fprintf(&out, "# HELP %s Latency of RPC and contract calls.\n", latencyName)
+	fmt.Fprintf(&out, "# TYPE %s histogram\n", latencyName)
+	latencyKeys := make([]latencyLabels, 0, len(o.latencies))
+	for labels := range o.latencies {
+		latencyKeys = append(latencyKeys, labels)
+	}
+	sort.Slice(latencyKeys, func(i, j int) bool { return latencyKeys[i].String() < latencyKeys[j].String() })
+	for _, labels := range latencyKeys {
+		h := o.latencies[labels]
+		for bi, bucket := range o.buckets {
+			fmt.Fprintf(&out, "%s_bucket{%s,le=\"%s\"} %d\n", latencyName, labels.String(), strconv.FormatFloat(bucket, 'g', -1, 64), h.counts[bi])
+		}
+		fmt.Fprintf(&out, "%s_bucket{%s,le=\"+Inf\"} %d\n", latencyName, labels.String(), h.count)
+		fmt.Fprintf(&out, "%s_sum{%s} %s\n", latencyName, labels.String(), strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&out, "%s_count{%s} %d\n", latencyName, labels.String(), h.count)
+	}
+
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+// Serve the metrics over HTTP
+func (o *PrometheusObserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := o.WriteMetrics(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Get a metric name in the observer's namespace
+func (o *PrometheusObserver) metricName(name string) string {
+	if o.namespace == "" {
+		return name
+	}
+	return o.namespace + "_" + name
+}
+
+func (l latencyLabels) String() string {
+	return fmt.Sprintf("kind=\"%s\",contract=\"%s\",method=\"%s\",endpoint=\"%s\"", escapeLabel(l.kind), escapeLabel(l.contract), escapeLabel(l.method), escapeLabel(l.endpoint))
+}
+
+func (l callLabels) String() string {
+	return fmt.Sprintf("%s,block=\"%s\",error_class=\"%s\"", l.latencyLabels.String(), escapeLabel(l.block), escapeLabel(l.errorClass))
+}
+
+// Get the block label for a block tag; block numbers are grouped as "historical" to bound the label's cardinality
+func blockLabel(block string) string {
+	if _, err := strconv.ParseUint(block, 10, 64); err == nil {
+		return "historical"
+	}
+	return block
+}
+
+// Escape a Prometheus label value
+func escapeLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
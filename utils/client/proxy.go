@@ -2,23 +2,53 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // This type wraps multiple ETH clients, providing natural fallback support if one of them fails.
+// It is safe for concurrent use.
 type EthClientProxy struct {
     clientUrls []string
-    clients []*ethclient.Client
-    timeouts []time.Time
-    reconnectDelay time.Duration
+    endpoints []*endpoint
+    failover FailoverConfig
+    failoverLock sync.RWMutex
+    balancer balancer
+    archive archiveRouter
+    observer Observer
+    observerLock sync.RWMutex
+    transport http.RoundTripper
+}
+
+
+// A single ETH client and its circuit breaker
+type endpoint struct {
+    client *ethclient.Client
+    rpcClient *rpc.Client
+    breaker circuitBreaker
+    lock sync.Mutex
+}
+
+
+// The fee market history returned by eth_feeHistory
+// BaseFee includes the base fee of the block after the newest block in the range
+type FeeHistory struct {
+    OldestBlock *big.Int
+    Reward [][]*big.Int
+    BaseFee []*big.Int
+    GasUsedRatio []float64
 }
 
 
@@ -26,35 +56,94 @@ type EthClientProxy struct {
 type clientFunction func(*ethclient.Client) (interface{}, error)
 
 
+// This is a signature for a wrapped ethclient.Client function reading state at a block
+type readFunction func(*ethclient.Client, *big.Int) (interface{}, error)
+
+
 // Creates a new Eth1ClientProxy instance based on the main and backup client URLs
+// The reconnect delay is the base backoff of each client's circuit breaker, or DefaultBaseBackoff if it is not positive; see FailoverConfig.
 func NewEth1ClientProxy(reconnectDelay time.Duration, urls ...string) (*EthClientProxy) {
+    return NewEth1ClientProxyWithTransport(reconnectDelay, nil, urls...)
+}
 
-    clients := []*ethclient.Client{}
-    timeouts := []time.Time{}
 
-    // Clamp the delay
-    if reconnectDelay < 0 {
-        reconnectDelay = 0
+// Creates a new Eth1ClientProxy instance which makes its HTTP requests through the given transport,
+// e.g. an RPCRecorder or RPCReplayer. The URLs must be HTTP URLs if a transport is given.
+func NewEth1ClientProxyWithTransport(reconnectDelay time.Duration, transport http.RoundTripper, urls ...string) (*EthClientProxy) {
+
+    p := &EthClientProxy{
+        clientUrls: urls,
+        transport: transport,
     }
 
+    // Get failover settings; the reconnect delay defaults to DefaultBaseBackoff
+    p.failover = DefaultFailoverConfig(reconnectDelay)
+
     // Try connecting to each client, but ignore errors - they'll be handled at runtime
+    endpoints := []*endpoint{}
     for _, url := range urls {
-        client, err := ethclient.Dial(url)
+        e := &endpoint{}
+        rpcClient, err := p.dial(context.Background(), url)
         if err != nil {
-            timeouts = append(timeouts, time.Now())
+            e.breaker = circuitBreaker{
+                state: BreakerOpen,
+                backoff: p.failover.BaseBackoff,
+                openedAt: time.Now(),
+            }
         } else {
-            timeouts = append(timeouts, time.Time{})
+            e.client = ethclient.NewClient(rpcClient)
+            e.rpcClient = rpcClient
         }
-        clients = append(clients, client)
+        endpoints = append(endpoints, e)
     }
 
-    return &EthClientProxy{
-        clientUrls: urls,
-        clients: clients,
-        timeouts: timeouts,
-        reconnectDelay: reconnectDelay,
+    p.endpoints = endpoints
+    p.balancer = balancer{
+        maxBlockLag: DefaultMaxBlockLag,
     }
+    p.archive = archiveRouter{
+        kinds: make([]EndpointKind, len(urls)),
+        depth: DefaultArchiveDepth,
+    }
+    return p
+
+}
+
 
+// Set the failover settings
+func (p *EthClientProxy) SetFailoverConfig(config FailoverConfig) {
+    if config.FailureThreshold < 1 {
+        config.FailureThreshold = 1
+    }
+    if config.RetryAttempts < 1 {
+        config.RetryAttempts = 1
+    }
+    if config.BaseBackoff <= 0 {
+        config.BaseBackoff = DefaultBaseBackoff
+    }
+    p.failoverLock.Lock()
+    defer p.failoverLock.Unlock()
+    p.failover = config
+}
+
+
+// Get the circuit breaker state of each client, in list order
+func (p *EthClientProxy) GetBreakerStatus() []BreakerStatus {
+    status := make([]BreakerStatus, len(p.endpoints))
+    for i, e := range p.endpoints {
+        e.lock.Lock()
+        status[i] = BreakerStatus{
+            URL: p.clientUrls[i],
+            State: e.breaker.state,
+            Failures: e.breaker.failures,
+            Backoff: e.breaker.backoff,
+        }
+        if e.breaker.state == BreakerOpen {
+            status[i].OpenUntil = e.breaker.openedAt.Add(e.breaker.backoff)
+        }
+        e.lock.Unlock()
+    }
+    return status
 }
 
 
@@ -66,7 +155,7 @@ func NewEth1ClientProxy(reconnectDelay time.Duration, urls ...string) (*EthClien
 // CodeAt returns the code of the given account. This is needed to differentiate
 // between contract internal errors and the local chain being out of sync.
 func (p *EthClientProxy) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runReadFunction(ctx, "CodeAt", blockNumber, func(client *ethclient.Client, blockNumber *big.Int) (interface{}, error) {
         return client.CodeAt(ctx, contract, blockNumber)
     })
     if err != nil {
@@ -79,7 +168,7 @@ func (p *EthClientProxy) CodeAt(ctx context.Context, contract common.Address, bl
 // CallContract executes an Ethereum contract call with the specified data as the
 // input.
 func (p *EthClientProxy) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runReadFunction(ctx, "CallContract", blockNumber, func(client *ethclient.Client, blockNumber *big.Int) (interface{}, error) {
         return client.CallContract(ctx, call, blockNumber)
     })
     if err != nil {
@@ -97,8 +186,8 @@ func (p *EthClientProxy) CallContract(ctx context.Context, call ethereum.CallMsg
 // HeaderByNumber returns a block header from the current canonical chain. If number is
 // nil, the latest known header is returned.
 func (p *EthClientProxy) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
-        return client.HeaderByNumber(ctx, number)
+    result, err := p.runReadFunction(ctx, "HeaderByNumber", number, func(client *ethclient.Client, blockNumber *big.Int) (interface{}, error) {
+        return client.HeaderByNumber(ctx, blockNumber)
     })
     if err != nil {
         return nil, err
@@ -109,7 +198,7 @@ func (p *EthClientProxy) HeaderByNumber(ctx context.Context, number *big.Int) (*
 
 // PendingCodeAt returns the code of the given account in the pending state.
 func (p *EthClientProxy) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "PendingCodeAt", func(client *ethclient.Client) (interface{}, error) {
         return client.PendingCodeAt(ctx, account)
     })
     if err != nil {
@@ -121,7 +210,7 @@ func (p *EthClientProxy) PendingCodeAt(ctx context.Context, account common.Addre
 
 // PendingNonceAt retrieves the current pending nonce associated with an account.
 func (p *EthClientProxy) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "PendingNonceAt", func(client *ethclient.Client) (interface{}, error) {
         return client.PendingNonceAt(ctx, account)
     })
     if err != nil {
@@ -134,7 +223,7 @@ func (p *EthClientProxy) PendingNonceAt(ctx context.Context, account common.Addr
 // SuggestGasPrice retrieves the currently suggested gas price to allow a timely
 // execution of a transaction.
 func (p *EthClientProxy) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "SuggestGasPrice", func(client *ethclient.Client) (interface{}, error) {
         return client.SuggestGasPrice(ctx)
     })
     if err != nil {
@@ -147,7 +236,7 @@ func (p *EthClientProxy) SuggestGasPrice(ctx context.Context) (*big.Int, error)
 // SuggestGasTipCap retrieves the currently suggested 1559 priority fee to allow
 // a timely execution of a transaction.
 func (p *EthClientProxy) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "SuggestGasTipCap", func(client *ethclient.Client) (interface{}, error) {
         return client.SuggestGasTipCap(ctx)
     })
     if err != nil {
@@ -157,13 +246,51 @@ func (p *EthClientProxy) SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 }
 
 
+// FeeHistory retrieves the fee market history for the given number of blocks up to lastBlock,
+// with the priority fees paid at each of the given reward percentiles.
+// The last block can be nil, in which case the history ends at the latest block.
+func (p *EthClientProxy) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*FeeHistory, error) {
+    var result struct {
+        OldestBlock *hexutil.Big `json:"oldestBlock"`
+        Reward [][]*hexutil.Big `json:"reward"`
+        BaseFee []*hexutil.Big `json:"baseFeePerGas"`
+        GasUsedRatio []float64 `json:"gasUsedRatio"`
+    }
+    blockArg := "latest"
+    if lastBlock != nil {
+        blockArg = hexutil.EncodeBig(lastBlock)
+    }
+    if err := p.CallContext(ctx, &result, "eth_feeHistory", hexutil.Uint64(blockCount), blockArg, rewardPercentiles); err != nil {
+        return nil, err
+    }
+    history := &FeeHistory{
+        Reward: make([][]*big.Int, len(result.Reward)),
+        BaseFee: make([]*big.Int, len(result.BaseFee)),
+        GasUsedRatio: result.GasUsedRatio,
+    }
+    if result.OldestBlock != nil {
+        history.OldestBlock = result.OldestBlock.ToInt()
+    }
+    for i, rewards := range result.Reward {
+        history.Reward[i] = make([]*big.Int, len(rewards))
+        for j, reward := range rewards {
+            history.Reward[i][j] = reward.ToInt()
+        }
+    }
+    for i, baseFee := range result.BaseFee {
+        history.BaseFee[i] = baseFee.ToInt()
+    }
+    return history, nil
+}
+
+
 // EstimateGas tries to estimate the gas needed to execute a specific
 // transaction based on the current pending state of the backend blockchain.
 // There is no guarantee that this is the true gas limit requirement as other
 // transactions may be added or removed by miners, but it should provide a basis
 // for setting a reasonable default.
 func (p *EthClientProxy) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "EstimateGas", func(client *ethclient.Client) (interface{}, error) {
         return client.EstimateGas(ctx, call)
     })
     if err != nil {
@@ -174,11 +301,24 @@ func (p *EthClientProxy) EstimateGas(ctx context.Context, call ethereum.CallMsg)
 
 
 // SendTransaction injects the transaction into the pending pool for execution.
+// The client it was sent through is preferred when polling for the transaction afterwards.
+// If a send fails over after an earlier client may have accepted the transaction, an "already known" response is treated as success.
 func (p *EthClientProxy) SendTransaction(ctx context.Context, tx *types.Transaction) error {
-    _, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
-        return nil, client.SendTransaction(ctx, tx)
+    _, index, err := p.observe(ctx, "SendTransaction", "", func() (interface{}, int, error) {
+        return p.runFunctionOn(ctx, p.getClientOrder(ctx), func(client *ethclient.Client) (interface{}, error) {
+            return nil, client.SendTransaction(ctx, tx)
+        })
     })
-    return err
+    if err != nil {
+        var proxyErr *ProxyError
+        var clientErr *ClientError
+        if !errors.As(err, &proxyErr) || len(proxyErr.ClientErrors) == 0 || !errors.As(proxyErr.Err, &clientErr) || !strings.Contains(clientErr.Err.Error(), "already known") {
+            return err
+        }
+        index = clientErr.Index
+    }
+    p.setTransactionClient(tx.Hash(), index)
+    return nil
 }
 
 
@@ -192,7 +332,7 @@ func (p *EthClientProxy) SendTransaction(ctx context.Context, tx *types.Transact
 //
 // TODO(karalabe): Deprecate when the subscription one can return past data too.
 func (p *EthClientProxy) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "FilterLogs", func(client *ethclient.Client) (interface{}, error) {
         return client.FilterLogs(ctx, query)
     })
     if err != nil {
@@ -205,7 +345,7 @@ func (p *EthClientProxy) FilterLogs(ctx context.Context, query ethereum.FilterQu
 // SubscribeFilterLogs creates a background log filtering operation, returning
 // a subscription immediately, which can be used to stream the found events.
 func (p *EthClientProxy) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "SubscribeFilterLogs", func(client *ethclient.Client) (interface{}, error) {
         return client.SubscribeFilterLogs(ctx, query, ch)
     })
     if err != nil {
@@ -223,8 +363,10 @@ func (p *EthClientProxy) SubscribeFilterLogs(ctx context.Context, query ethereum
 // TransactionReceipt returns the receipt of a transaction by transaction hash.
 // Note that the receipt is not available for pending transactions.
 func (p *EthClientProxy) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
-        return client.TransactionReceipt(ctx, txHash)
+    result, _, err := p.observe(ctx, "TransactionReceipt", "", func() (interface{}, int, error) {
+        return p.runFunctionOn(ctx, p.getTransactionClientOrder(ctx, txHash), func(client *ethclient.Client) (interface{}, error) {
+            return client.TransactionReceipt(ctx, txHash)
+        })
     })
     if err != nil {
         return nil, err
@@ -240,7 +382,7 @@ func (p *EthClientProxy) TransactionReceipt(ctx context.Context, txHash common.H
 
 // BlockNumber returns the most recent block number
 func (p *EthClientProxy) BlockNumber(ctx context.Context) (uint64, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "BlockNumber", func(client *ethclient.Client) (interface{}, error) {
         return client.BlockNumber(ctx)
     })
     if err != nil {
@@ -250,10 +392,22 @@ func (p *EthClientProxy) BlockNumber(ctx context.Context) (uint64, error) {
 }
 
 
+// ChainID retrieves the current chain ID for transaction replay protection.
+func (p *EthClientProxy) ChainID(ctx context.Context) (*big.Int, error) {
+    result, err := p.runFunction(ctx, "ChainID", func(client *ethclient.Client) (interface{}, error) {
+        return client.ChainID(ctx)
+    })
+    if err != nil {
+        return nil, err
+    }
+    return result.(*big.Int), err
+}
+
+
 // BalanceAt returns the wei balance of the given account.
 // The block number can be nil, in which case the balance is taken from the latest known block.
 func (p *EthClientProxy) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runReadFunction(ctx, "BalanceAt", blockNumber, func(client *ethclient.Client, blockNumber *big.Int) (interface{}, error) {
         return client.BalanceAt(ctx, account, blockNumber)
     })
     if err != nil {
@@ -265,10 +419,12 @@ func (p *EthClientProxy) BalanceAt(ctx context.Context, account common.Address,
 
 // TransactionByHash returns the transaction with the given hash.
 func (p *EthClientProxy) TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
-        tx, isPending, err := client.TransactionByHash(ctx, hash)
-        result := []interface{} { tx, isPending }
-        return result, err
+    result, _, err := p.observe(ctx, "TransactionByHash", "", func() (interface{}, int, error) {
+        return p.runFunctionOn(ctx, p.getTransactionClientOrder(ctx, hash), func(client *ethclient.Client) (interface{}, error) {
+            tx, isPending, err := client.TransactionByHash(ctx, hash)
+            result := []interface{} { tx, isPending }
+            return result, err
+        })
     })
     if err != nil {
         return nil, false, err
@@ -285,7 +441,7 @@ func (p *EthClientProxy) TransactionByHash(ctx context.Context, hash common.Hash
 // NonceAt returns the account nonce of the given account.
 // The block number can be nil, in which case the nonce is taken from the latest known block.
 func (p *EthClientProxy) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runReadFunction(ctx, "NonceAt", blockNumber, func(client *ethclient.Client, blockNumber *big.Int) (interface{}, error) {
         return client.NonceAt(ctx, account, blockNumber)
     })
     if err != nil {
@@ -298,7 +454,7 @@ func (p *EthClientProxy) NonceAt(ctx context.Context, account common.Address, bl
 // SyncProgress retrieves the current progress of the sync algorithm. If there's
 // no sync currently running, it returns nil.
 func (p *EthClientProxy) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
-    result, err := p.runFunction(func(client *ethclient.Client) (interface{}, error) {
+    result, err := p.runFunction(ctx, "SyncProgress", func(client *ethclient.Client) (interface{}, error) {
         return client.SyncProgress(ctx)
     })
     if err != nil {
@@ -308,81 +464,234 @@ func (p *EthClientProxy) SyncProgress(ctx context.Context) (*ethereum.SyncProgre
 }
 
 
+/// =============
+/// RPC Functions
+/// =============
+
+
+// CallContext performs a raw JSON-RPC call with the given arguments, unmarshalling the result into result.
+// This is used for methods not covered by ethclient, such as debug_traceCall.
+func (p *EthClientProxy) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+    _, err := p.runFunction(ctx, method, func(client *ethclient.Client) (interface{}, error) {
+        rpcClient := p.getRPCClient(client)
+        if rpcClient == nil {
+            return nil, fmt.Errorf("No RPC client is connected")
+        }
+        return nil, rpcClient.CallContext(ctx, result, method, args...)
+    })
+    return err
+}
+
+
 /// ==================
 /// Internal functions
 /// ==================
 
 
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
-func (p *EthClientProxy) runFunction(function clientFunction) (interface{}, error) {
+// Clients are tried in the order chosen by the balancer, which is list order until endpoint health has been probed.
+func (p *EthClientProxy) runFunction(ctx context.Context, method string, function clientFunction) (interface{}, error) {
+    result, _, err := p.observe(ctx, method, "", func() (interface{}, int, error) {
+        return p.runFunctionOn(ctx, p.getClientOrder(ctx), function)
+    })
+    return result, err
+}
+
 
-    // A cumulative error string as each client gets tried
-    errorString := ""
+// Runs a read function at a block, in quorum mode if the context requests it or otherwise as a normal proxied call.
+// Reads of old blocks are routed to archive clients, and reads failing with missing state are retried on them.
+// Reads of blocks an endpoint hasn't reached yet are retried on the other clients.
+func (p *EthClientProxy) runReadFunction(ctx context.Context, method string, blockNumber *big.Int, function readFunction) (interface{}, error) {
+    result, _, err := p.observe(ctx, method, BlockTag(blockNumber), func() (interface{}, int, error) {
+        if config, ok := getQuorum(ctx); ok {
+            result, err := p.runQuorum(ctx, config, blockNumber, function)
+            return result, -1, err
+        }
+        result, index, err := p.runFunctionOn(ctx, p.getReadClientOrder(ctx, blockNumber), func(client *ethclient.Client) (interface{}, error) {
+            return function(client, blockNumber)
+        })
+        if err != nil {
+            return p.retryRead(ctx, err, blockNumber, function)
+        }
+        return result, index, nil
+    })
+    return result, err
+}
 
-    for i := 0; i < len(p.clients); i++ {
-        client, clientErr := p.getClient(i)
-        if client != nil {
 
-            // This client is available, try running the function
-            result, err := function(client)
-            if err != nil {
+// Attempts to run a function through each client in the given order until one succeeds or they all fail.
+// Clients failing with retryable errors are skipped, and the round is retried with backoff if every client fails.
+// Stops trying further clients as soon as the context is cancelled or its deadline expires.
+// Returns the index of the client that succeeded.
+func (p *EthClientProxy) runFunctionOn(ctx context.Context, order []int, function clientFunction) (interface{}, int, error) {
 
-                // If it's disconnected, log it and try the next client
-                errorString += fmt.Sprintf("\nError with client %d: %s", i, err.Error())
-                if isDisconnected(err) {
-                    p.clients[i] = nil
-                    p.timeouts[i] = time.Now()
+    // The errors from each client as it gets tried
+    clientErrors := []*ClientError{}
 
-                // If it's a different error, just return it
+    config := p.getFailoverConfig()
+    for round := 1; ; round++ {
+        for _, i := range order {
+
+            // Don't fail over to the next client if the caller has given up
+            if err := ctx.Err(); err != nil {
+                return nil, -1, &ProxyError{
+                    ClientErrors: clientErrors,
+                    Err: fmt.Errorf("Context done before trying client %d: %w", i, err),
+                }
+            }
+
+            client, clientErr := p.getClient(ctx, i)
+            if client != nil {
+
+                // This client is available, try running the function
+                result, err := function(client)
+                if err != nil {
+
+                    // If the caller gave up during the call, don't hold it against the client
+                    if ctx.Err() != nil {
+                        p.releaseClient(i)
+                        clientErrors = append(clientErrors, &ClientError{Index: i, Err: err})
+
+                    // If the client failed to serve the request, log it and try the next client
+                    } else if ClassifyError(err) == ErrorClassRetryable {
+                        clientErrors = append(clientErrors, &ClientError{Index: i, Err: err})
+                        p.recordFailure(i, client, config)
+
+                    // If it's a terminal error, return it wrapped so callers can inspect it
+                    } else {
+                        p.recordSuccess(i)
+                        return nil, -1, &ProxyError{
+                            ClientErrors: clientErrors,
+                            Err: &ClientError{Index: i, Err: err},
+                        }
+                    }
+
+                // If there's no error, return the result
                 } else {
-                    return nil, fmt.Errorf(errorString)
+                    p.recordSuccess(i)
+                    p.setStickyClient(ctx, i)
+                    return result, i, nil
                 }
 
-            // If there's no error, return the result
+            // Note a client failure and try the next one
             } else {
-                return result, nil
+                clientErrors = append(clientErrors, &ClientError{Index: i, Err: clientErr})
             }
+        }
 
-        // Note a client failure and try the next one
-        } else {
-            errorString += fmt.Sprintf("\nError with client %d: %s", i, clientErr.Error())
+        // Stop once out of retries
+        if round >= config.RetryAttempts {
+            break
+        }
+
+        // Wait before retrying
+        timer := time.NewTimer(retryDelay(config, round))
+        select {
+        case <-timer.C:
+        case <-ctx.Done():
+            timer.Stop()
+            return nil, -1, &ProxyError{
+                ClientErrors: clientErrors,
+                Err: fmt.Errorf("Context done before retrying: %w", ctx.Err()),
+            }
         }
     }
     
-    // If none of the clients worked, return the aggregated error string
-    errorString += "\nNone of the clients were available."
-    return nil, fmt.Errorf(errorString)
+    // If none of the clients worked, return the aggregated errors
+    return nil, -1, &ProxyError{
+        ClientErrors: clientErrors,
+        Err: ErrNoClientsAvailable,
+    }
 
 }
 
 
-// Returns true if the error was a connection failure and a backup client is available
-func isDisconnected(err error) (bool) {
-    return strings.Contains(err.Error(), "dial tcp")
+// Get the client at the given index, trying a reconnect if it's disconnected
+// Returns an error without a client if the client's circuit breaker is open
+func (p *EthClientProxy) getClient(ctx context.Context, index int) (*ethclient.Client, error) {
+    e := p.endpoints[index]
+    e.lock.Lock()
+    defer e.lock.Unlock()
+
+    // Check the circuit breaker
+    if err := e.breaker.allow(time.Now()); err != nil {
+        return nil, err
+    }
+
+    // Try connecting to the client if it's dead
+    if e.client == nil {
+        rpcClient, err := p.dial(ctx, p.clientUrls[index])
+        if err != nil {
+            e.breaker.failure(time.Now(), p.getFailoverConfig())
+            return nil, err
+        }
+        e.rpcClient = rpcClient
+        e.client = ethclient.NewClient(rpcClient)
+    }
+    return e.client, nil
 }
 
 
-// Get the client at the given index, trying a reconnect if it's disconnected
-func (p *EthClientProxy) getClient(index int) (*ethclient.Client, error) {
-    // Try connecting to the client if it's dead
-    var err error
-    if p.clients[index] == nil {
-
-        // Check if enough time has passed
-        if time.Since(p.timeouts[index]) > p.reconnectDelay {
-            p.clients[index], err = ethclient.Dial(p.clientUrls[index])
-        
-            // If the connection failed, reset the timer
-            if err != nil {
-                p.timeouts[index] = time.Now()
-            }
+// Connect to a client, through the proxy's transport if it has one
+func (p *EthClientProxy) dial(ctx context.Context, url string) (*rpc.Client, error) {
+    if p.transport != nil {
+        return rpc.DialHTTPWithClient(url, &http.Client{Transport: p.transport})
+    }
+    return rpc.DialContext(ctx, url)
+}
 
-        } else {
-            err = fmt.Errorf("Connection failure, waiting %s to reconnect", p.reconnectDelay)
-        }
+
+// Record a successful request to a client, closing its circuit breaker
+func (p *EthClientProxy) recordSuccess(index int) {
+    e := p.endpoints[index]
+    e.lock.Lock()
+    defer e.lock.Unlock()
+    e.breaker.success()
+}
+
+
+// Record a failed request to a client
+// The client is dropped and reconnected once its circuit breaker trips, unless it has already been replaced by a reconnect
+func (p *EthClientProxy) recordFailure(index int, client *ethclient.Client, config FailoverConfig) {
+    e := p.endpoints[index]
+    e.lock.Lock()
+    defer e.lock.Unlock()
+    e.breaker.failure(time.Now(), config)
+    if e.breaker.state == BreakerOpen && e.client == client {
+        e.client = nil
+        e.rpcClient = nil
     }
+}
+
+
+// Release a client's trial request without recording a result
+func (p *EthClientProxy) releaseClient(index int) {
+    e := p.endpoints[index]
+    e.lock.Lock()
+    defer e.lock.Unlock()
+    e.breaker.release()
+}
 
-    // Return the client regardless of its state
-    return p.clients[index], err
+
+// Get the failover settings
+func (p *EthClientProxy) getFailoverConfig() FailoverConfig {
+    p.failoverLock.RLock()
+    defer p.failoverLock.RUnlock()
+    return p.failover
+}
+
+
+// Get the raw RPC client underlying a connected client
+func (p *EthClientProxy) getRPCClient(client *ethclient.Client) (*rpc.Client) {
+    for _, e := range p.endpoints {
+        e.lock.Lock()
+        rpcClient := e.rpcClient
+        match := e.client == client
+        e.lock.Unlock()
+        if match {
+            return rpcClient
+        }
+    }
+    return nil
 }
 
@@ -0,0 +1,275 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Returned when too few endpoints agree on the result of a quorum read
+var ErrQuorumNotReached = errors.New("Quorum not reached")
+
+// Quorum read settings: a read is run against Endpoints endpoints, and its result is only returned if at least Agree of them return the same value
+// If Agree is at most half of Endpoints, a read fails when more than one value reaches Agree
+type QuorumConfig struct {
+	Endpoints int
+	Agree     int
+}
+
+// The response from an endpoint to a quorum read
+type QuorumResponse struct {
+	Index int
+	URL   string
+	Value string
+	Err   error
+}
+
+// A quorum read which did not reach agreement
+// Outliers are the endpoints which errored or disagreed with the most common value, or every endpoint if several values were each returned by enough of them
+type QuorumError struct {
+	Block     *big.Int
+	Required  int
+	Responses []QuorumResponse
+	Outliers  []QuorumResponse
+	Err       error
+}
+
+func (e *QuorumError) Error() string {
+	var message strings.Builder
+	if e.Block != nil {
+		message.WriteString(fmt.Sprintf("%s at block %s: %d matching responses required", ErrQuorumNotReached.Error(), e.Block.String(), e.Required))
+	} else {
+		message.WriteString(fmt.Sprintf("%s: %d matching responses required", ErrQuorumNotReached.Error(), e.Required))
+	}
+	if e.Err != nil {
+		message.WriteString(": ")
+		message.WriteString(e.Err.Error())
+	}
+	for _, outlier := range e.Outliers {
+		message.WriteString(fmt.Sprintf("\nOutlier client %d (%s): ", outlier.Index, outlier.URL))
+		if outlier.Err != nil {
+			message.WriteString(outlier.Err.Error())
+		} else {
+			message.WriteString(outlier.Value)
+		}
+	}
+	return message.String()
+}
+
+func (e *QuorumError) Unwrap() error {
+	return e.Err
+}
+
+func (e *QuorumError) Is(target error) bool {
+	return target == ErrQuorumNotReached
+}
+
+type quorumKey struct{}
+
+// Get a context whose reads are run in quorum mode
+// Reads supporting a block number (CallContract, CodeAt, HeaderByNumber, BalanceAt & NonceAt) are pinned to a common block and run against several endpoints
+// A read without a block number is pinned to the highest block which at least config.Agree of the endpoints have reached
+func WithQuorum(ctx context.Context, config QuorumConfig) context.Context {
+	return context.WithValue(ctx, quorumKey{}, config)
+}
+
+// Get the quorum settings from a context
+func getQuorum(ctx context.Context) (QuorumConfig, bool) {
+	config, ok := ctx.Value(quorumKey{}).(QuorumConfig)
+	return config, ok
+}
+
+// Run a read function against several endpoints at the same block, returning the result once enough of them agree
+func (p *EthClientProxy) runQuorum(ctx context.Context, config QuorumConfig, blockNumber *big.Int, function readFunction) (interface{}, error) {
+
+	// Check settings
+	if config.Agree < 1 || config.Endpoints < config.Agree {
+		return nil, fmt.Errorf("Invalid quorum settings: %d of %d endpoints", config.Agree, config.Endpoints)
+	}
+	if config.Endpoints > len(p.endpoints) {
+		return nil, fmt.Errorf("Quorum requires %d endpoints, but only %d are configured", config.Endpoints, len(p.endpoints))
+	}
+
+	// Select available clients in balancer order
+	indexes := []int{}
+	clients := []*ethclient.Client{}
+	clientErrors := []*ClientError{}
+	for _, i := range p.getReadClientOrder(ctx, blockNumber) {
+		if len(clients) == config.Endpoints {
+			break
+		}
+		client, err := p.getClient(ctx, i)
+		if client == nil {
+			clientErrors = append(clientErrors, &ClientError{Index: i, Err: err})
+			continue
+		}
+		indexes = append(indexes, i)
+		clients = append(clients, client)
+	}
+	if len(clients) < config.Agree {
+		for _, i := range indexes {
+			p.releaseClient(i)
+		}
+		return nil, &QuorumError{
+			Block:    blockNumber,
+			Required: config.Agree,
+			Err:      &ProxyError{ClientErrors: clientErrors, Err: ErrNoClientsAvailable},
+		}
+	}
+
+	// Pin the block
+	if blockNumber == nil {
+		responses := p.runOnClients(ctx, indexes, clients, func(client *ethclient.Client) (interface{}, error) {
+			return client.BlockNumber(ctx)
+		})
+		heads := []uint64{}
+		for _, response := range responses {
+			if response.err == nil {
+				heads = append(heads, response.result.(uint64))
+			}
+		}
+		if len(heads) < config.Agree {
+			return nil, &QuorumError{
+				Required:  config.Agree,
+				Responses: quorumResponses(p.clientUrls, responses),
+				Outliers:  quorumResponses(p.clientUrls, responses),
+				Err:       fmt.Errorf("Could not get the latest block from enough clients"),
+			}
+		}
+		sort.Slice(heads, func(i, j int) bool { return heads[i] > heads[j] })
+		blockNumber = new(big.Int).SetUint64(heads[config.Agree-1])
+	}
+
+	// Run the read & group the results by value
+	responses := p.runOnClients(ctx, indexes, clients, func(client *ethclient.Client) (interface{}, error) {
+		return function(client, blockNumber)
+	})
+	groups := map[string][]int{}
+	var mostCommon string
+	for ri, response := range responses {
+		if response.err != nil {
+			continue
+		}
+		groups[response.key] = append(groups[response.key], ri)
+		if len(groups[response.key]) > len(groups[mostCommon]) {
+			mostCommon = response.key
+		}
+	}
+
+	// Reject conflicting values which were each returned by enough clients, e.g. when Agree is at most half of Endpoints
+	all := quorumResponses(p.clientUrls, responses)
+	agreedGroups := 0
+	for _, group := range groups {
+		if len(group) >= config.Agree {
+			agreedGroups++
+		}
+	}
+	if agreedGroups > 1 {
+		return nil, &QuorumError{
+			Block:     blockNumber,
+			Required:  config.Agree,
+			Responses: all,
+			Outliers:  all,
+			Err:       fmt.Errorf("%d different results were each returned by at least %d clients", agreedGroups, config.Agree),
+		}
+	}
+
+	// Return the agreed value
+	if agreed, ok := groups[mostCommon]; ok && len(agreed) >= config.Agree {
+		return responses[agreed[0]].result, nil
+	}
+
+	// Report the outliers
+	outliers := []QuorumResponse{}
+	for ri, response := range responses {
+		if response.err != nil || response.key != mostCommon {
+			outliers = append(outliers, all[ri])
+		}
+	}
+	return nil, &QuorumError{
+		Block:     blockNumber,
+		Required:  config.Agree,
+		Responses: all,
+		Outliers:  outliers,
+	}
+
+}
+
+// The result of a function run on one client
+type clientResponse struct {
+	index  int
+	result interface{}
+	key    string
+	err    error
+}
+
+// Run a function on several clients concurrently, recording the outcome against each client's circuit breaker
+func (p *EthClientProxy) runOnClients(ctx context.Context, indexes []int, clients []*ethclient.Client, function clientFunction) []clientResponse {
+	config := p.getFailoverConfig()
+	responses := make([]clientResponse, len(clients))
+	var wg sync.WaitGroup
+	for ci := range clients {
+		wg.Add(1)
+		go func(ci int) {
+			defer wg.Done()
+			index := indexes[ci]
+			result, err := function(clients[ci])
+			responses[ci] = clientResponse{index: index, result: result, err: err}
+			if err != nil {
+				if ctx.Err() != nil {
+					p.releaseClient(index)
+				} else if ClassifyError(err) == ErrorClassRetryable {
+					p.recordFailure(index, clients[ci], config)
+				} else {
+					p.recordSuccess(index)
+				}
+				return
+			}
+			p.recordSuccess(index)
+			responses[ci].key = resultKey(result)
+		}(ci)
+	}
+	wg.Wait()
+	return responses
+}
+
+// Get the responses to report in a quorum error
+func quorumResponses(urls []string, responses []clientResponse) []QuorumResponse {
+	quorumResponses := make([]QuorumResponse, len(responses))
+	for ri, response := range responses {
+		quorumResponses[ri] = QuorumResponse{
+			Index: response.index,
+			URL:   urls[response.index],
+			Value: response.key,
+			Err:   response.err,
+		}
+	}
+	return quorumResponses
+}
+
+// Get a comparable representation of a read result
+func resultKey(result interface{}) string {
+	switch r := result.(type) {
+	case []byte:
+		return hexutil.Encode(r)
+	case *big.Int:
+		if r == nil {
+			return "<nil>"
+		}
+		return r.String()
+	case *types.Header:
+		if r == nil {
+			return "<nil>"
+		}
+		return r.Hash().Hex()
+	}
+	return fmt.Sprintf("%v", result)
+}
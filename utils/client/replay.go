@@ -0,0 +1,330 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// RPC fixture settings
+const (
+	RPCFixtureVersion = 1
+	replayErrorCode   = -32099
+)
+
+// A set of recorded JSON-RPC interactions
+type RPCFixture struct {
+	Version      int              `json:"version"`
+	Interactions []RPCInteraction `json:"interactions"`
+}
+
+// A recorded JSON-RPC call and its response
+// Endpoint URLs are not recorded, as they often contain API keys
+type RPCInteraction struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// An HTTP transport which records the JSON-RPC calls made through it
+type RPCRecorder struct {
+	transport    http.RoundTripper
+	interactions []RPCInteraction
+	lock         sync.Mutex
+}
+
+// An HTTP transport which replays recorded JSON-RPC calls without a network
+// Identical calls are answered in recorded order, with the last response repeated once they run out
+// Calls with no recording are answered with a JSON-RPC error, and listed by Misses
+type RPCReplayer struct {
+	responses map[string][]RPCInteraction
+	served    map[string]int
+	misses    []string
+	lock      sync.Mutex
+}
+
+// A JSON-RPC request message
+type rpcRequest struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// A JSON-RPC response message
+type rpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+// Create a new recorder, sending calls through the given transport or the default transport if nil
+func NewRPCRecorder(transport http.RoundTripper) *RPCRecorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &RPCRecorder{
+		transport:    transport,
+		interactions: []RPCInteraction{},
+	}
+}
+
+func (r *RPCRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	// Read the request
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send it
+	res, err := r.transport.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusOK {
+		return res, err
+	}
+
+	// Read the response, restoring it for the caller
+	responseBody, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Could not read RPC response: %w", err)
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	// Record the calls
+	requests, _, err := parseRPCRequests(requestBody)
+	if err != nil {
+		return res, nil
+	}
+	responses, err := parseRPCResponses(responseBody)
+	if err != nil {
+		return res, nil
+	}
+	responsesByID := make(map[string]rpcResponse, len(responses))
+	for _, response := range responses {
+		responsesByID[string(response.ID)] = response
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, request := range requests {
+		response, ok := responsesByID[string(request.ID)]
+		if !ok {
+			continue
+		}
+		r.interactions = append(r.interactions, RPCInteraction{
+			Method: request.Method,
+			Params: compactJSON(request.Params),
+			Result: response.Result,
+			Error:  response.Error,
+		})
+	}
+	return res, nil
+
+}
+
+// Get the recorded calls
+func (r *RPCRecorder) Fixture() *RPCFixture {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	interactions := make([]RPCInteraction, len(r.interactions))
+	copy(interactions, r.interactions)
+	return &RPCFixture{
+		Version:      RPCFixtureVersion,
+		Interactions: interactions,
+	}
+}
+
+// Write the recorded calls to a fixture file
+func (r *RPCRecorder) Save(path string) error {
+	bytes, err := json.MarshalIndent(r.Fixture(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("Could not encode RPC fixture: %w", err)
+	}
+	if err := ioutil.WriteFile(path, bytes, 0644); err != nil {
+		return fmt.Errorf("Could not write RPC fixture file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Load a replayer from a fixture file
+func LoadRPCReplayer(path string) (*RPCReplayer, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read RPC fixture file %s: %w", path, err)
+	}
+	fixture := &RPCFixture{}
+	if err := json.Unmarshal(bytes, fixture); err != nil {
+		return nil, fmt.Errorf("Could not decode RPC fixture file %s: %w", path, err)
+	}
+	if fixture.Version != RPCFixtureVersion {
+		return nil, fmt.Errorf("Unsupported RPC fixture version %d", fixture.Version)
+	}
+	return NewRPCReplayer(fixture), nil
+}
+
+// Create a new replayer for recorded calls
+func NewRPCReplayer(fixture *RPCFixture) *RPCReplayer {
+	responses := make(map[string][]RPCInteraction)
+	for _, interaction := range fixture.Interactions {
+		key := interactionKey(interaction.Method, compactJSON(interaction.Params))
+		responses[key] = append(responses[key], interaction)
+	}
+	return &RPCReplayer{
+		responses: responses,
+		served:    make(map[string]int),
+		misses:    []string{},
+	}
+}
+
+func (r *RPCReplayer) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	// Read the request
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	requests, batch, err := parseRPCRequests(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("Could not decode RPC request: %w", err)
+	}
+
+	// Answer each call from the recording
+	responses := make([]rpcResponse, len(requests))
+	for ri, request := range requests {
+		responses[ri] = r.replay(request)
+	}
+
+	// Encode the response
+	var responseBody []byte
+	if batch {
+		responseBody, err = json.Marshal(responses)
+	} else {
+		responseBody, err = json.Marshal(responses[0])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode RPC response: %w", err)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(responseBody)),
+		ContentLength: int64(len(responseBody)),
+		Request:       req,
+	}, nil
+
+}
+
+// Get the calls which had no recording
+func (r *RPCReplayer) Misses() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	misses := make([]string, len(r.misses))
+	copy(misses, r.misses)
+	return misses
+}
+
+// Answer a call from the recording
+func (r *RPCReplayer) replay(request rpcRequest) rpcResponse {
+	response := rpcResponse{Version: "2.0", ID: request.ID}
+	key := interactionKey(request.Method, compactJSON(request.Params))
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	recorded := r.responses[key]
+	if len(recorded) == 0 {
+		r.misses = append(r.misses, key)
+		response.Error, _ = json.Marshal(map[string]interface{}{
+			"code":    replayErrorCode,
+			"message": fmt.Sprintf("No recorded response for %s", key),
+		})
+		return response
+	}
+	index := r.served[key]
+	if index >= len(recorded) {
+		index = len(recorded) - 1
+	} else {
+		r.served[key]++
+	}
+	response.Result = recorded[index].Result
+	response.Error = recorded[index].Error
+	if response.Result == nil && response.Error == nil {
+		response.Result = json.RawMessage("null")
+	}
+	return response
+}
+
+// Read a request body, restoring it for the transport
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return []byte{}, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Could not read RPC request: %w", err)
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// Parse a single or batch JSON-RPC request, returning whether it was a batch
+func parseRPCRequests(data []byte) ([]rpcRequest, bool, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		requests := []rpcRequest{}
+		if err := json.Unmarshal(data, &requests); err != nil {
+			return nil, true, err
+		}
+		if len(requests) == 0 {
+			return nil, true, fmt.Errorf("Empty batch request")
+		}
+		return requests, true, nil
+	}
+	request := rpcRequest{}
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, false, err
+	}
+	return []rpcRequest{request}, false, nil
+}
+
+// Parse a single or batch JSON-RPC response
+func parseRPCResponses(data []byte) ([]rpcResponse, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		responses := []rpcResponse{}
+		err := json.Unmarshal(data, &responses)
+		return responses, err
+	}
+	response := rpcResponse{}
+	err := json.Unmarshal(data, &response)
+	return []rpcResponse{response}, err
+}
+
+// Get the replay key of a call
+func interactionKey(method string, params json.RawMessage) string {
+	if len(params) == 0 {
+		return method
+	}
+	return fmt.Sprintf("%s %s", method, string(params))
+}
+
+// Compact a JSON value, so equal calls have equal keys
+func compactJSON(data json.RawMessage) json.RawMessage {
+	if len(data) == 0 {
+		return data
+	}
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, data); err != nil {
+		return data
+	}
+	return compacted.Bytes()
+}
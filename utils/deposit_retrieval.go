@@ -2,6 +2,7 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"math/big"
 	"sort"
@@ -38,9 +39,12 @@ type DepositData struct {
 
 // Gets all of the deposit contract's deposit events for the provided pubkeys
 func GetDeposits(ggp *gogopool.GoGoPool, pubkeys map[ggptypes.ValidatorPubkey]bool, startBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) (map[ggptypes.ValidatorPubkey][]DepositData, error) {
+	return GetDepositsContext(gogopool.CallOptsContext(opts), ggp, pubkeys, startBlock, intervalSize, opts)
+}
+func GetDepositsContext(ctx context.Context, ggp *gogopool.GoGoPool, pubkeys map[ggptypes.ValidatorPubkey]bool, startBlock *big.Int, intervalSize *big.Int, opts *bind.CallOpts) (map[ggptypes.ValidatorPubkey][]DepositData, error) {
 
 	// Get the deposit contract wrapper
-	casperDeposit, err := getCasperDeposit(ggp)
+	casperDeposit, err := getCasperDeposit(ctx, ggp)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +55,7 @@ func GetDeposits(ggp *gogopool.GoGoPool, pubkeys map[ggptypes.ValidatorPubkey]bo
 	// Get the deposit events
 	addressFilter := []common.Address{*casperDeposit.Address}
 	topicFilter := [][]common.Hash{{casperDeposit.ABI.Events["DepositEvent"].ID}}
-	logs, err := avax.GetLogs(ggp, addressFilter, topicFilter, intervalSize, startBlock, nil, nil)
+	logs, err := avax.GetLogsContext(ctx, ggp, addressFilter, topicFilter, intervalSize, startBlock, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -116,8 +120,8 @@ func sortDepositData(data []DepositData) {
 // Get contracts
 var casperDepositLock sync.Mutex
 
-func getCasperDeposit(ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
+func getCasperDeposit(ctx context.Context, ggp *gogopool.GoGoPool) (*gogopool.Contract, error) {
 	casperDepositLock.Lock()
 	defer casperDepositLock.Unlock()
-	return ggp.GetContract("casperDeposit")
+	return ggp.GetContractContext(ctx, "casperDeposit")
 }
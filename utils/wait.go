@@ -6,14 +6,19 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multisig-labs/gogopool-go/gogopool"
 	"github.com/multisig-labs/gogopool-go/utils/client"
 )
 
 // Wait for a transaction to get mined
 func WaitForTransaction(client *client.EthClientProxy, hash common.Hash) (*types.Receipt, error) {
+	return WaitForTransactionContext(context.Background(), client, hash)
+}
+func WaitForTransactionContext(ctx context.Context, client *client.EthClientProxy, hash common.Hash) (*types.Receipt, error) {
 
 	var tx *types.Transaction
 	var err error
@@ -21,14 +26,18 @@ func WaitForTransaction(client *client.EthClientProxy, hash common.Hash) (*types
 	// Get the transaction from its hash, retrying for 30 sec if it wasn't found
 	for i := 0; i < 30; i++ {
 		if i == 29 {
-			return nil, fmt.Errorf("Transaction not found after 30 seconds.")
+			return nil, fmt.Errorf("Transaction not found after 30 seconds: %w", ethereum.NotFound)
 		}
 
-		tx, _, err = client.TransactionByHash(context.Background(), hash)
+		tx, _, err = client.TransactionByHash(ctx, hash)
 		if err != nil {
-			if err.Error() == "not found" {
-				time.Sleep(1 * time.Second)
-				continue
+			if errors.Is(err, ethereum.NotFound) {
+				select {
+				case <-time.After(1 * time.Second):
+					continue
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
 			}
 			return nil, err
 		} else {
@@ -37,14 +46,14 @@ func WaitForTransaction(client *client.EthClientProxy, hash common.Hash) (*types
 	}
 
 	// Wait for transaction to be mined
-	txReceipt, err := bind.WaitMined(context.Background(), client, tx)
+	txReceipt, err := bind.WaitMined(ctx, client, tx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check transaction status
 	if txReceipt.Status == 0 {
-		return txReceipt, errors.New("Transaction failed with status 0")
+		return txReceipt, &gogopool.TransactionFailedError{Hash: hash}
 	}
 
 	// Return